@@ -0,0 +1,63 @@
+package gridder
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// ExportAltText generates a textual description of the grid and the labels and states of its cells
+func (g *Gridder) ExportAltText() string {
+	var builder strings.Builder
+	builder.WriteString(g.describeGrid())
+	builder.WriteString(".")
+	for _, cell := range g.Cells() {
+		description := g.describeCell(cell)
+		if description == "" {
+			continue
+		}
+		fmt.Fprintf(&builder, " Row %d, column %d: %s.", cell.Row+1, cell.Column+1, description)
+	}
+	return builder.String()
+}
+
+// ExportARIA generates HTML markup using the ARIA grid pattern that mirrors the cells of the grid
+func (g *Gridder) ExportARIA() string {
+	rows, columns := g.gridConfig.GetRows(), g.gridConfig.GetColumns()
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "<div role=\"grid\" aria-label=\"%s\" aria-rowcount=\"%d\" aria-colcount=\"%d\">\n",
+		html.EscapeString(g.describeGrid()), rows, columns)
+	for row := 0; row < rows; row++ {
+		fmt.Fprintf(&builder, "  <div role=\"row\" aria-rowindex=\"%d\">\n", row+1)
+		for column := 0; column < columns; column++ {
+			description := g.describeCell(Cell{Row: row, Column: column})
+			fmt.Fprintf(&builder, "    <div role=\"gridcell\" aria-colindex=\"%d\">%s</div>\n",
+				column+1, html.EscapeString(description))
+		}
+		builder.WriteString("  </div>\n")
+	}
+	builder.WriteString("</div>\n")
+	return builder.String()
+}
+
+func (g *Gridder) describeGrid() string {
+	return fmt.Sprintf("Grid of %d rows and %d columns, %d by %d pixels",
+		g.gridConfig.GetRows(), g.gridConfig.GetColumns(), g.imageConfig.GetWidth(), g.imageConfig.GetHeight())
+}
+
+func (g *Gridder) describeCell(cell Cell) string {
+	parts := append([]string{}, g.labels[cell]...)
+	if state := g.states[cell]; state != "" {
+		parts = append(parts, state)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (g *Gridder) addLabel(row int, column int, text string) {
+	if g.labels == nil {
+		g.labels = make(map[Cell][]string)
+	}
+	cell := Cell{Row: row, Column: column}
+	g.labels[cell] = append(g.labels[cell], text)
+}
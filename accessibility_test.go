@@ -0,0 +1,45 @@
+package gridder
+
+import (
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestExportAltText(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 1, Columns: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ExportAltText(), "Grid of 1 rows and 2 columns, 200 by 100 pixels.")
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 24})
+
+	err = gridder.DrawString(0, 0, "Start", fontFace)
+	assert.Nil(t, err)
+
+	err = gridder.DrawStrings([][]string{{"", "End"}}, fontFace)
+	assert.Nil(t, err)
+
+	err = gridder.SetState(0, 1, "goal")
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ExportAltText(), "Grid of 1 rows and 2 columns, 200 by 100 pixels. Row 1, column 1: Start. Row 1, column 2: End; goal.")
+}
+
+func TestExportARIA(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 1, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.SetState(0, 0, "<wall>")
+	assert.Nil(t, err)
+
+	expected := `<div role="grid" aria-label="Grid of 1 rows and 2 columns, 200 by 100 pixels" aria-rowcount="1" aria-colcount="2">
+  <div role="row" aria-rowindex="1">
+    <div role="gridcell" aria-colindex="1">&lt;wall&gt;</div>
+    <div role="gridcell" aria-colindex="2"></div>
+  </div>
+</div>
+`
+	assert.Equal(t, gridder.ExportARIA(), expected)
+}
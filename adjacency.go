@@ -0,0 +1,178 @@
+package gridder
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/image/font"
+)
+
+// RenderAdjacencyMatrix creates an adjacency matrix of nodes, such as the dependencies between the modules of a
+// system, with a row per source and a column per target. The edges function gets the weight of the edge from node i
+// to node j in the order of the labels, 0 without an edge and 1 for unweighted graphs. Cells of edges are colored by
+// weight, with a colorbar when weights differ, and carry a tooltip naming the edge. Labels are drawn when a font is
+// configured, and the image is sized for square cells unless its dimensions are set
+func RenderAdjacencyMatrix(labels []string, edges func(i int, j int) float64, adjacencyMatrixConfigs ...AdjacencyMatrixConfig) (*Gridder, error) {
+	adjacencyMatrixConfig := getFirstAdjacencyMatrixConfig(adjacencyMatrixConfigs...)
+
+	size := len(labels)
+	if size == 0 {
+		return nil, errNoRows
+	}
+
+	weights := make([][]float64, size)
+	var minWeight, maxWeight float64
+	for i := range weights {
+		weights[i] = make([]float64, size)
+		for j := range weights[i] {
+			weight := edges(i, j)
+			err := nonNegative(fmt.Sprintf("weight of %s to %s", labels[i], labels[j]), weight)
+			if err != nil {
+				return nil, err
+			}
+			weights[i][j] = weight
+			if weight > 0 && (minWeight == 0 || weight < minWeight) {
+				minWeight = weight
+			}
+			if weight > maxWeight {
+				maxWeight = weight
+			}
+		}
+	}
+
+	order := make([]int, size)
+	for i := range order {
+		order[i] = i
+	}
+	if adjacencyMatrixConfig.Order == OrderCluster {
+		order = clusterOrder(weights)
+	}
+
+	g, err := newMatrixGridder(size, adjacencyMatrixConfig.ImageConfig, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if adjacencyMatrixConfig.Font != nil {
+		fontFace = g.FontFace(adjacencyMatrixConfig.Font, adjacencyMatrixConfig.GetFontFraction())
+	}
+
+	numberFormat := NumberFormat{Precision: -1}
+	err = g.retain(func() error {
+		for row, i := range order {
+			for column, j := range order {
+				weight := weights[i][j]
+				if weight == 0 {
+					err := g.paintCell(row, column+1, adjacencyMatrixConfig.GetEmptyColor())
+					if err != nil {
+						return err
+					}
+					continue
+				}
+
+				err := g.paintCell(row, column+1, adjacencyMatrixConfig.GetColor(weight/maxWeight))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if minWeight < maxWeight {
+			err := g.drawColorbar(0, size+1, size-1, size+1, adjacencyMatrixConfig.GetColor, 0, maxWeight, []float64{0, maxWeight}, ColorbarConfig{
+				FontFace:     fontFace,
+				NumberFormat: &numberFormat,
+				TextColor:    adjacencyMatrixConfig.GetTextColor(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if fontFace == nil {
+			return nil
+		}
+
+		g.ctx.Push()
+		g.ctx.SetFontFace(fontFace)
+		g.ctx.SetColor(adjacencyMatrixConfig.GetTextColor())
+		for position, i := range order {
+			g.drawAnchoredString(position, 0, labels[i], 1, 0.35)
+			g.drawRotatedColumnLabel(size, position+1, labels[i], adjacencyMatrixConfig.GetLabelRotation())
+		}
+		g.ctx.Pop()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for row, i := range order {
+		for column, j := range order {
+			if weights[i][j] == 0 {
+				continue
+			}
+			err = g.SetTooltip(row, column+1, fmt.Sprintf("%s → %s: %s", labels[i], labels[j], g.formatNumber(numberFormat, weights[i][j])))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return g, nil
+}
+
+// clusterOrder orders the nodes by the reverse Cuthill-McKee algorithm, ignoring edge directions and weights. Every
+// connected component is walked breadth first from a node of the lowest degree, visiting neighbors from the lowest
+// degree, which keeps connected nodes close together
+func clusterOrder(weights [][]float64) []int {
+	size := len(weights)
+	neighbors := make([][]int, size)
+	for i := range weights {
+		for j := range weights[i] {
+			if i != j && (weights[i][j] > 0 || weights[j][i] > 0) {
+				neighbors[i] = append(neighbors[i], j)
+			}
+		}
+	}
+	byDegree := func(nodes []int) {
+		sort.SliceStable(nodes, func(a, b int) bool {
+			return len(neighbors[nodes[a]]) < len(neighbors[nodes[b]])
+		})
+	}
+
+	starts := make([]int, size)
+	for i := range starts {
+		starts[i] = i
+	}
+	byDegree(starts)
+
+	order := make([]int, 0, size)
+	visited := make([]bool, size)
+	for _, start := range starts {
+		if visited[start] {
+			continue
+		}
+
+		visited[start] = true
+		queue := []int{start}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			order = append(order, node)
+
+			var next []int
+			for _, neighbor := range neighbors[node] {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					next = append(next, neighbor)
+				}
+			}
+			byDegree(next)
+			queue = append(queue, next...)
+		}
+	}
+
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+	return order
+}
@@ -0,0 +1,84 @@
+package gridder
+
+import (
+	"bytes"
+	"errors"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestRenderAdjacencyMatrix(t *testing.T) {
+	labels := []string{"api", "db", "ui"}
+	weights := [][]float64{{0, 2, 0}, {0, 0, 0}, {1, 0, 0}}
+	gridder, err := RenderAdjacencyMatrix(labels, func(i int, j int) float64 { return weights[i][j] })
+	assert.Nil(t, err)
+
+	img := gridder.image()
+	at := func(row int, column int) color.Color {
+		center := gridder.getCellCenter(row, column)
+		return color.NRGBAModel.Convert(img.At(int(center.X), int(center.Y)))
+	}
+	assert.Equal(t, at(0, 2), color.NRGBAModel.Convert(defaultAdjacencyMatrixHighColor))
+	assert.Equal(t, at(2, 1), LinearColormap(defaultAdjacencyMatrixLowColor, defaultAdjacencyMatrixHighColor)(0.5))
+	assert.Equal(t, at(1, 1), color.NRGBAModel.Convert(defaultAdjacencyMatrixEmptyColor))
+
+	tooltip, err := gridder.GetTooltip(0, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, tooltip, "api → db: 2")
+	tooltip, _ = gridder.GetTooltip(1, 1)
+	assert.Equal(t, tooltip, "")
+
+	var buffer bytes.Buffer
+	assert.Nil(t, gridder.ExportTooltips(&buffer))
+	assert.True(t, bytes.Contains(buffer.Bytes(), []byte(`"text":"ui → api: 1"`)))
+}
+
+func TestRenderAdjacencyMatrixCluster(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	labels := []string{"a", "x", "b", "y"}
+	connected := map[[2]int]bool{{0, 2}: true, {1, 3}: true}
+	edges := func(i int, j int) float64 {
+		if connected[[2]int{i, j}] {
+			return 1
+		}
+		return 0
+	}
+
+	gridder, err := RenderAdjacencyMatrix(labels, edges, AdjacencyMatrixConfig{Order: OrderCluster, Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 0}], []string{"y"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 0}], []string{"x"})
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 0}], []string{"b"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 0}], []string{"a"})
+	assert.Equal(t, gridder.labels[Cell{Row: 4, Column: 4}], []string{"a"})
+
+	tooltip, _ := gridder.GetTooltip(3, 3)
+	assert.Equal(t, tooltip, "a → b: 1")
+	tooltip, _ = gridder.GetTooltip(1, 1)
+	assert.Equal(t, tooltip, "x → y: 1")
+}
+
+func TestClusterOrder(t *testing.T) {
+	// a path 0-2-4 and a pair 1-3
+	weights := [][]float64{
+		{0, 0, 1, 0, 0},
+		{0, 0, 0, 1, 0},
+		{0, 0, 0, 0, 1},
+		{0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0},
+	}
+	assert.Equal(t, clusterOrder(weights), []int{3, 1, 4, 2, 0})
+}
+
+func TestRenderAdjacencyMatrixErrors(t *testing.T) {
+	_, err := RenderAdjacencyMatrix(nil, func(int, int) float64 { return 0 })
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = RenderAdjacencyMatrix([]string{"a"}, func(int, int) float64 { return math.NaN() })
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
@@ -0,0 +1,93 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// Easing maps the progress of an animation between 0 and 1 to eased progress
+type Easing func(t float64) float64
+
+// EaseLinear progresses at a constant speed
+func EaseLinear(t float64) float64 {
+	return t
+}
+
+// EaseIn accelerates from the start
+func EaseIn(t float64) float64 {
+	return t * t
+}
+
+// EaseOut decelerates to the end
+func EaseOut(t float64) float64 {
+	return t * (2 - t)
+}
+
+// EaseInOut accelerates from the start and decelerates to the end
+func EaseInOut(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+// AnimateMove moves an entity from a cell to another and returns a frame for every step of the move.
+// The entity is left in the destination cell. A nil easing moves at a constant speed
+func (g *Gridder) AnimateMove(id string, from Cell, to Cell, frames int, easing Easing, moveConfigs ...MoveConfig) ([]image.Image, error) {
+	if frames < 1 {
+		return nil, fmt.Errorf("%w: frames %d", errInvalidValue, frames)
+	}
+
+	err := g.verifyInBounds(from.Row, from.Column)
+	if err != nil {
+		return nil, err
+	}
+
+	err = g.verifyInBounds(to.Row, to.Column)
+	if err != nil {
+		return nil, err
+	}
+
+	moveConfig := getFirstMoveConfig(moveConfigs...)
+	err = finite("arc", moveConfig.GetArc())
+	if err != nil {
+		return nil, err
+	}
+
+	if easing == nil {
+		easing = EaseLinear
+	}
+
+	images := make([]image.Image, 0, frames)
+	for frame := 0; frame < frames; frame++ {
+		progress := 1.0
+		if frames > 1 {
+			progress = float64(frame) / float64(frames-1)
+		}
+
+		row, column := interpolateMove(from, to, easing(progress), moveConfig.GetArc())
+		err = g.setEntityPosition(id, row, column)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, g.image())
+	}
+	err = g.setEntityPosition(id, float64(to.Row), float64(to.Column))
+	if err != nil {
+		return nil, err
+	}
+	return images, g.recordReplay(nil, func() replayCommand { return placeCommand(id, to.Row, to.Column) })
+}
+
+// interpolateMove interpolates a position between two cells, lifting it along a parabolic arc
+func interpolateMove(from Cell, to Cell, t float64, arc float64) (float64, float64) {
+	rowDelta, columnDelta := float64(to.Row-from.Row), float64(to.Column-from.Column)
+	row := float64(from.Row) + rowDelta*t
+	column := float64(from.Column) + columnDelta*t
+
+	length := math.Hypot(rowDelta, columnDelta)
+	if arc == 0 || length == 0 {
+		return row, column
+	}
+
+	lift := arc * 4 * t * (1 - t)
+	return row - columnDelta/length*lift, column + rowDelta/length*lift
+}
@@ -0,0 +1,64 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnimateMove(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.RegisterEntity("piece", Entity{Circle: &CircleConfig{Radius: 5, Color: color.Black}}))
+
+	_, err = gridder.AnimateMove("piece", Cell{}, Cell{Row: 0, Column: 1}, 0, nil)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = gridder.AnimateMove("piece", Cell{}, Cell{Row: 2, Column: 1}, 3, nil)
+	assert.Equal(t, err, errOutOfBounds)
+
+	_, err = gridder.AnimateMove("unknown", Cell{}, Cell{Row: 0, Column: 1}, 3, nil)
+	assert.True(t, errors.Is(err, errUnknownEntity))
+
+	frames, err := gridder.AnimateMove("piece", Cell{}, Cell{Row: 0, Column: 1}, 3, EaseInOut)
+	assert.Nil(t, err)
+	assert.Equal(t, len(frames), 3)
+	assert.Equal(t, color.GrayModel.Convert(frames[0].At(25, 25)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(frames[1].At(50, 25)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(frames[1].At(25, 25)), color.Gray{Y: 255})
+	assert.Equal(t, color.GrayModel.Convert(frames[2].At(75, 25)), color.Gray{})
+
+	cell, err := gridder.GetEntityCell("piece")
+	assert.Nil(t, err)
+	assert.Equal(t, cell, Cell{Row: 0, Column: 1})
+
+	frames, err = gridder.AnimateMove("piece", Cell{Row: 1, Column: 0}, Cell{Row: 1, Column: 1}, 3, nil, MoveConfig{Arc: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, color.GrayModel.Convert(frames[1].At(50, 25)), color.Gray{})
+}
+
+func TestInterpolateMove(t *testing.T) {
+	row, column := interpolateMove(Cell{}, Cell{Row: 2, Column: 0}, 0.5, 0)
+	assert.Equal(t, row, 1.0)
+	assert.Equal(t, column, 0.0)
+
+	row, column = interpolateMove(Cell{}, Cell{Row: 0, Column: 2}, 0.5, 1)
+	assert.Equal(t, row, -1.0)
+	assert.Equal(t, column, 1.0)
+
+	row, column = interpolateMove(Cell{}, Cell{}, 0.5, 1)
+	assert.Equal(t, row, 0.0)
+	assert.Equal(t, column, 0.0)
+}
+
+func TestEasing(t *testing.T) {
+	assert.Equal(t, EaseLinear(0.25), 0.25)
+	assert.Equal(t, EaseIn(0.5), 0.25)
+	assert.Equal(t, EaseOut(0.5), 0.75)
+	assert.Equal(t, EaseInOut(0), 0.0)
+	assert.Equal(t, EaseInOut(0.5), 0.5)
+	assert.Equal(t, EaseInOut(1), 1.0)
+	assert.True(t, EaseInOut(0.25) < 0.25)
+}
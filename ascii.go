@@ -0,0 +1,77 @@
+package gridder
+
+import (
+	"strings"
+)
+
+const (
+	asciiDensityRamp = " .:-=+*#%@"
+	brailleBase      = 0x2800
+)
+
+// brailleDots maps a position within a 2x4 Braille cell, indexed by [row][column], to its dot bit
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// ExportASCII renders the cell states as ASCII density art, one character per cell.
+// The density reflects the darkness of the state color in the style map; cells without a state are blank
+func (g *Gridder) ExportASCII() string {
+	rows, columns := g.gridConfig.GetRows(), g.gridConfig.GetColumns()
+	ramp := []rune(asciiDensityRamp)
+
+	var builder strings.Builder
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			darkness := g.stateDarkness(row, column)
+			builder.WriteRune(ramp[int(darkness*float64(len(ramp)-1)+0.5)])
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// ExportBraille renders the cell states as Unicode Braille art, where each character covers 2 columns and 4 rows.
+// A dot is raised for cells whose state color is at least half dark
+func (g *Gridder) ExportBraille() string {
+	rows, columns := g.gridConfig.GetRows(), g.gridConfig.GetColumns()
+
+	var builder strings.Builder
+	for row := 0; row < rows; row += 4 {
+		for column := 0; column < columns; column += 2 {
+			char := rune(brailleBase)
+			for dotRow := 0; dotRow < 4 && row+dotRow < rows; dotRow++ {
+				for dotColumn := 0; dotColumn < 2 && column+dotColumn < columns; dotColumn++ {
+					if g.stateDarkness(row+dotRow, column+dotColumn) >= 0.5 {
+						char |= brailleDots[dotRow][dotColumn]
+					}
+				}
+			}
+			builder.WriteRune(char)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// stateDarkness gets the darkness of a cell state color composited over white, between 0 and 1.
+// States without a color are fully dark
+func (g *Gridder) stateDarkness(row int, column int) float64 {
+	state, ok := g.states[Cell{Row: row, Column: column}]
+	if !ok {
+		return 0
+	}
+
+	stateColor := g.styleMap[state].Color
+	if stateColor == nil {
+		return 1
+	}
+
+	r, gr, b, a := stateColor.RGBA()
+	white := float64(0xffff - a)
+	luminance := (0.299*(float64(r)+white) + 0.587*(float64(gr)+white) + 0.114*(float64(b)+white)) / 0xffff
+	return 1 - luminance
+}
@@ -0,0 +1,36 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportASCII(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 3})
+	assert.Nil(t, err)
+
+	gridder.SetStyleMap(StyleMap{
+		"wall":  {Color: color.Black},
+		"floor": {Color: color.White},
+		"mud":   {Color: color.Gray{Y: 128}},
+	})
+	assert.Nil(t, gridder.SetState(0, 0, "wall"))
+	assert.Nil(t, gridder.SetState(0, 1, "floor"))
+	assert.Nil(t, gridder.SetState(0, 2, "mud"))
+	assert.Nil(t, gridder.SetState(1, 2, "unstyled"))
+
+	assert.Equal(t, gridder.ExportASCII(), "@ =\n  @\n")
+}
+
+func TestExportBraille(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 5, Columns: 3})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.SetState(0, 0, "on"))
+	assert.Nil(t, gridder.SetState(3, 1, "on"))
+	assert.Nil(t, gridder.SetState(4, 2, "on"))
+
+	assert.Equal(t, gridder.ExportBraille(), "⢁⠀\n⠀⠁\n")
+}
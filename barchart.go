@@ -0,0 +1,156 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"golang.org/x/image/font"
+)
+
+// BarSeries is a series of a bar chart with a value per category, series are stacked in order from the bottom
+type BarSeries struct {
+	Name   string
+	Values []float64
+	// Color defaults to a color of the default palette, picked by the index of the series
+	Color color.Color
+}
+
+// BarChart is a grid based bar chart, with a column of cells per category
+type BarChart struct {
+	Categories []string
+	Series     []BarSeries
+}
+
+// Render creates the chart image, stacking a cell per unit of value of every series from the bottom of each
+// category column over a background of empty cells. Value labels run up an axis column at the left, category names
+// sit below the columns and a legend of the named series follows. Texts are drawn when a font is configured, and
+// the image is sized for square cells unless its dimensions are set
+func (c BarChart) Render(imageConfig ImageConfig, barChartConfigs ...BarChartConfig) (*Gridder, error) {
+	barChartConfig := getFirstBarChartConfig(barChartConfigs...)
+	if len(c.Categories) == 0 {
+		return nil, errNoColumns
+	}
+	if len(c.Series) == 0 {
+		return nil, errNoRows
+	}
+
+	unit := barChartConfig.GetUnit()
+	cells := make([][]int, len(c.Series))
+	stacks := make([]int, len(c.Categories))
+	var names []string
+	var colors []color.Color
+	for i, series := range c.Series {
+		if len(series.Values) != len(c.Categories) {
+			return nil, fmt.Errorf("%w: %d values of series %d for %d categories", errMatrixDimensions, len(series.Values), i, len(c.Categories))
+		}
+
+		cells[i] = make([]int, len(series.Values))
+		for category, value := range series.Values {
+			err := nonNegative("bar value", value)
+			if err != nil {
+				return nil, err
+			}
+			cells[i][category] = int(math.Round(value / unit))
+			stacks[category] += cells[i][category]
+		}
+
+		if series.Name != "" {
+			names = append(names, series.Name)
+			colors = append(colors, c.seriesColor(i))
+		}
+	}
+
+	plotRows := 1
+	for _, stack := range stacks {
+		plotRows = maxInt(plotRows, stack)
+	}
+
+	// the plot, a row of category names, and a spacing row and the legend when series are named
+	rows, columns := plotRows+1, len(c.Categories)+1
+	if len(names) > 0 {
+		rows += 2
+	}
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultBarChartCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = rows * defaultBarChartCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            rows,
+		Columns:         columns,
+		LineStrokeWidth: defaultBarChartGap,
+		LineColor:       barChartConfig.GetBackgroundColor(),
+		BorderColor:     barChartConfig.GetBackgroundColor(),
+		BackgroundColor: barChartConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if barChartConfig.Font != nil {
+		fontFace = g.FontFace(barChartConfig.Font, barChartConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		for category := range c.Categories {
+			row := plotRows - 1
+			for i := range c.Series {
+				for n := 0; n < cells[i][category]; n++ {
+					err := g.paintCell(row, category+1, c.seriesColor(i))
+					if err != nil {
+						return err
+					}
+					row--
+				}
+			}
+			for ; row >= 0; row-- {
+				err := g.paintCell(row, category+1, barChartConfig.GetEmptyColor())
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if fontFace == nil {
+			return nil
+		}
+
+		stringConfig := StringConfig{Color: barChartConfig.GetTextColor()}
+		for category, name := range c.Categories {
+			err := g.drawString(plotRows, category+1, name, fontFace, stringConfig)
+			if err != nil {
+				return err
+			}
+		}
+
+		g.ctx.Push()
+		defer g.ctx.Pop()
+		g.ctx.SetFontFace(fontFace)
+		g.ctx.SetColor(barChartConfig.GetTextColor())
+		numberFormat := NumberFormat{Precision: -1}
+		for level := barChartConfig.GetTickInterval(); level <= plotRows; level += barChartConfig.GetTickInterval() {
+			g.drawAnchoredString(plotRows-level, 0, g.formatNumber(numberFormat, float64(level)*unit), 1, 0.35)
+		}
+
+		if len(names) > 0 {
+			g.drawLegend(rows-1, 1, names, colors)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// seriesColor gets the color of a series
+func (c BarChart) seriesColor(i int) color.Color {
+	if c.Series[i].Color != nil {
+		return c.Series[i].Color
+	}
+	return defaultChartColors[i%len(defaultChartColors)]
+}
@@ -0,0 +1,74 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestBarChartRender(t *testing.T) {
+	red := color.NRGBA{R: 255, A: 255}
+	chart := BarChart{
+		Categories: []string{"A", "B", "C"},
+		Series: []BarSeries{
+			{Values: []float64{4, 2.4, 0}, Color: red},
+			{Values: []float64{2, 0, 0}},
+		},
+	}
+	gridder, err := chart.Render(ImageConfig{}, BarChartConfig{Unit: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 80)
+	assert.Equal(t, gridder.ctx.Height(), 80)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 50)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 30)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 10)), defaultChartColors[1])
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 50)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 30)), defaultBarChartEmptyColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(70, 50)), defaultBarChartEmptyColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 70)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestBarChartRenderLabels(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	chart := BarChart{
+		Categories: []string{"Mon", "Tue"},
+		Series: []BarSeries{
+			{Name: "done", Values: []float64{3, 6}},
+			{Name: "open", Values: []float64{1, 4}},
+		},
+	}
+	gridder, err := chart.Render(ImageConfig{}, BarChartConfig{Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Height(), 13*20)
+
+	assert.Equal(t, gridder.labels[Cell{Row: 10, Column: 1}], []string{"Mon"})
+	assert.Equal(t, gridder.labels[Cell{Row: 10, Column: 2}], []string{"Tue"})
+	assert.Equal(t, gridder.labels[Cell{Row: 5, Column: 0}], []string{"5"})
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 0}], []string{"10"})
+	assert.Equal(t, gridder.labels[Cell{Row: 12, Column: 1}], []string{"done", "open"})
+}
+
+func TestBarChartRenderErrors(t *testing.T) {
+	_, err := BarChart{}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errNoColumns))
+
+	_, err = BarChart{Categories: []string{"A"}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = BarChart{Categories: []string{"A"}, Series: []BarSeries{{Values: []float64{1, 2}}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+
+	_, err = BarChart{Categories: []string{"A"}, Series: []BarSeries{{Values: []float64{-1}}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = BarChart{Categories: []string{"A"}, Series: []BarSeries{{Values: []float64{math.NaN()}}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
@@ -0,0 +1,100 @@
+package gridder
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// BatchJob is a scene rendered by a BatchRenderer. Font faces are not safe for concurrent use, so scenes drawing
+// text should load their own
+type BatchJob struct {
+	// ID identifies the job in its result
+	ID string
+	// Scene builds the grid to render
+	Scene func() (*Gridder, error)
+	// Path saves the rendered grid to a file with the encoder registered for its extension, when set
+	Path string
+	// Output receives the rendered grid when set, after it is saved to Path
+	Output func(g *Gridder) error
+}
+
+// BatchResult is the outcome of a BatchJob
+type BatchResult struct {
+	ID  string
+	Err error
+}
+
+// BatchRenderer renders scene jobs across a bounded pool of workers. The zero value uses one worker per CPU
+type BatchRenderer struct {
+	workers int
+}
+
+// NewBatchRenderer creates a batch renderer with a number of workers, one per CPU when it is not positive
+func NewBatchRenderer(workers int) *BatchRenderer {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return &BatchRenderer{workers: workers}
+}
+
+// Render renders jobs and returns their results in the order of the jobs. A failing job does not stop the others,
+// jobs that did not start when ctx is done fail with its error
+func (b *BatchRenderer) Render(ctx context.Context, jobs []BatchJob) []BatchResult {
+	results := make([]BatchResult, len(jobs))
+	indexes := make(chan int)
+
+	workers := b.workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers && i < len(jobs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				results[index] = BatchResult{ID: jobs[index].ID, Err: runBatchJob(ctx, jobs[index])}
+			}
+		}()
+	}
+
+	for i := range jobs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+func runBatchJob(ctx context.Context, job BatchJob) (err error) {
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job %q panicked: %v", job.ID, r)
+		}
+	}()
+
+	g, err := job.Scene()
+	if err != nil {
+		return err
+	}
+
+	if job.Path != "" {
+		err = g.Save(job.Path)
+		if err != nil {
+			return err
+		}
+	}
+
+	if job.Output != nil {
+		return job.Output(g)
+	}
+	return nil
+}
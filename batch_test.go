@@ -0,0 +1,72 @@
+package gridder
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchRenderer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gridder")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	scene := func() (*Gridder, error) {
+		gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2})
+		if err != nil {
+			return nil, err
+		}
+		return gridder, gridder.PaintCell(0, 0, color.Black)
+	}
+
+	var outputs int32
+	errScene := errors.New("scene")
+	jobs := []BatchJob{
+		{ID: "file", Scene: scene, Path: filepath.Join(dir, "file.png")},
+		{ID: "failing", Scene: func() (*Gridder, error) { return nil, errScene }},
+		{ID: "output", Scene: scene, Output: func(g *Gridder) error {
+			atomic.AddInt32(&outputs, 1)
+			return g.EncodePNG(new(bytes.Buffer))
+		}},
+		{ID: "format", Scene: scene, Path: filepath.Join(dir, "file.avif")},
+		{ID: "panic", Scene: func() (*Gridder, error) { panic("boom") }},
+	}
+
+	results := NewBatchRenderer(2).Render(context.Background(), jobs)
+	assert.Equal(t, len(results), 5)
+	assert.Equal(t, results[0], BatchResult{ID: "file"})
+	assert.Equal(t, results[1], BatchResult{ID: "failing", Err: errScene})
+	assert.Equal(t, results[2], BatchResult{ID: "output"})
+	assert.True(t, errors.Is(results[3].Err, errUnknownFormat))
+	assert.Equal(t, results[4].Err.Error(), `job "panic" panicked: boom`)
+	assert.Equal(t, outputs, int32(1))
+
+	_, err = os.Stat(filepath.Join(dir, "file.png"))
+	assert.Nil(t, err)
+}
+
+func TestBatchRendererCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := NewBatchRenderer(0).Render(ctx, []BatchJob{{ID: "a"}, {ID: "b"}})
+	assert.Equal(t, results, []BatchResult{{ID: "a", Err: context.Canceled}, {ID: "b", Err: context.Canceled}})
+	assert.Equal(t, len(NewBatchRenderer(1).Render(ctx, nil)), 0)
+}
+
+func TestBatchRendererZeroValue(t *testing.T) {
+	scene := func() (*Gridder, error) {
+		return New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2})
+	}
+
+	results := (&BatchRenderer{}).Render(context.Background(), []BatchJob{{ID: "a", Scene: scene}, {ID: "b", Scene: scene}})
+	assert.Equal(t, results, []BatchResult{{ID: "a"}, {ID: "b"}})
+}
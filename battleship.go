@@ -0,0 +1,225 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+
+	"golang.org/x/image/font"
+)
+
+// Ship is a ship of a battleship board, covering Length cells from its bow to the right or downwards
+type Ship struct {
+	Row      int
+	Column   int
+	Length   int
+	Vertical bool
+}
+
+// cells gets the cells covered by the ship
+func (s Ship) cells() []Cell {
+	cells := make([]Cell, s.Length)
+	for i := range cells {
+		if s.Vertical {
+			cells[i] = Cell{Row: s.Row + i, Column: s.Column}
+		} else {
+			cells[i] = Cell{Row: s.Row, Column: s.Column + i}
+		}
+	}
+	return cells
+}
+
+// BattleshipBoard is the state of a battleship player, with cells counted from 0 on both panels
+type BattleshipBoard struct {
+	// Ships are the ships of the player's own fleet
+	Ships []Ship
+	// IncomingShots are the opponent's shots at the fleet, hits are the shots on ships
+	IncomingShots []Cell
+	// OutgoingShots are the player's shots at the opponent, tracked as hit or miss
+	OutgoingShots map[Cell]bool
+}
+
+// Render creates a two panel image of the board, the fleet with the incoming shots on the left and the tracking
+// board of the outgoing shots on the right. Rows are lettered from A and columns numbered from 1. Titles and
+// coordinates are drawn when a font is configured, and the image is sized for square cells unless its dimensions
+// are set
+func (b BattleshipBoard) Render(imageConfig ImageConfig, battleshipConfigs ...BattleshipConfig) (*Gridder, error) {
+	battleshipConfig := getFirstBattleshipConfig(battleshipConfigs...)
+	size := battleshipConfig.GetSize()
+
+	occupied, err := b.fleet(size)
+	if err != nil {
+		return nil, err
+	}
+	for _, shot := range b.IncomingShots {
+		if !battleshipCellInBounds(shot, size) {
+			return nil, fmt.Errorf("%w: incoming shot at %d,%d", errOutOfBounds, shot.Row, shot.Column)
+		}
+	}
+	for shot := range b.OutgoingShots {
+		if !battleshipCellInBounds(shot, size) {
+			return nil, fmt.Errorf("%w: outgoing shot at %d,%d", errOutOfBounds, shot.Row, shot.Column)
+		}
+	}
+
+	// a title row and a row of column numbers above the boards, a column of row letters left of each board and a
+	// column between the panels
+	rows, columns := size+2, size*2+3
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultBattleshipCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = rows * defaultBattleshipCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            rows,
+		Columns:         columns,
+		LineStrokeWidth: defaultBattleshipGap,
+		LineColor:       color.Transparent,
+		BorderColor:     color.Transparent,
+		BackgroundColor: battleshipConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if battleshipConfig.Font != nil {
+		fontFace = g.FontFace(battleshipConfig.Font, battleshipConfig.GetFontFraction())
+	}
+
+	fleetColumn, targetColumn := 1, size+3
+	err = g.retain(func() error {
+		for row := 0; row < size; row++ {
+			for column := 0; column < size; column++ {
+				err := g.paintCell(row+2, column+fleetColumn, battleshipConfig.GetWaterColor())
+				if err != nil {
+					return err
+				}
+				err = g.paintCell(row+2, column+targetColumn, battleshipConfig.GetWaterColor())
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, ship := range b.Ships {
+			g.drawShip(ship, 2, fleetColumn, battleshipConfig.GetShipColor())
+		}
+
+		for _, shot := range b.IncomingShots {
+			err := g.drawShot(shot.Row+2, shot.Column+fleetColumn, occupied[shot], battleshipConfig)
+			if err != nil {
+				return err
+			}
+		}
+		for row := 0; row < size; row++ {
+			for column := 0; column < size; column++ {
+				shot := Cell{Row: row, Column: column}
+				hit, ok := b.OutgoingShots[shot]
+				if !ok {
+					continue
+				}
+				err := g.drawShot(row+2, column+targetColumn, hit, battleshipConfig)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if fontFace == nil {
+			return nil
+		}
+		return g.drawBattleshipLabels(size, []int{fleetColumn, targetColumn}, []string{battleshipConfig.GetFleetTitle(), battleshipConfig.GetTargetTitle()}, fontFace, battleshipConfig.GetTextColor())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// fleet verifies that the ships fit the board without overlapping and gets the cells they cover
+func (b BattleshipBoard) fleet(size int) (map[Cell]bool, error) {
+	occupied := make(map[Cell]bool)
+	for i, ship := range b.Ships {
+		if ship.Length <= 0 {
+			return nil, fmt.Errorf("%w: ship %d of length %d", errInvalidValue, i, ship.Length)
+		}
+		for _, cell := range ship.cells() {
+			if !battleshipCellInBounds(cell, size) {
+				return nil, fmt.Errorf("%w: ship %d at %d,%d", errOutOfBounds, i, cell.Row, cell.Column)
+			}
+			if occupied[cell] {
+				return nil, fmt.Errorf("%w: ship %d overlaps at %d,%d", errInvalidValue, i, cell.Row, cell.Column)
+			}
+			occupied[cell] = true
+		}
+	}
+	return occupied, nil
+}
+
+func battleshipCellInBounds(cell Cell, size int) bool {
+	return cell.Row >= 0 && cell.Row < size && cell.Column >= 0 && cell.Column < size
+}
+
+// drawShip draws a ship as a rounded hull over its cells, offset by the position of the board in the grid
+func (g *Gridder) drawShip(ship Ship, rowOffset int, columnOffset int, shipColor color.Color) {
+	cells := ship.cells()
+	first := g.getCellCenter(cells[0].Row+rowOffset, cells[0].Column+columnOffset)
+	last := g.getCellCenter(cells[len(cells)-1].Row+rowOffset, cells[len(cells)-1].Column+columnOffset)
+	cellWidth, cellHeight := g.getCellDimensions(cells[0].Row+rowOffset, cells[0].Column+columnOffset)
+	width, height := cellWidth*defaultBattleshipHullFraction, cellHeight*defaultBattleshipHullFraction
+
+	g.ctx.Push()
+	g.ctx.DrawRoundedRectangle(first.X-width/2, first.Y-height/2, last.X-first.X+width, last.Y-first.Y+height, math.Min(width, height)/2)
+	g.ctx.SetColor(shipColor)
+	g.ctx.Fill()
+	g.ctx.Pop()
+}
+
+// drawShot draws a cross on hits and a peg on misses
+func (g *Gridder) drawShot(row int, column int, hit bool, battleshipConfig BattleshipConfig) error {
+	cellWidth, cellHeight := g.getCellDimensions(row, column)
+	size := math.Min(cellWidth, cellHeight)
+	if !hit {
+		return g.drawCircle(row, column, CircleConfig{Radius: size * 0.2, Color: battleshipConfig.GetMissColor()})
+	}
+
+	for _, rotate := range []float64{45, -45} {
+		err := g.drawLine(row, column, LineConfig{Length: size * 0.7, Rotate: rotate, StrokeWidth: size * 0.12, Color: battleshipConfig.GetHitColor()})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawBattleshipLabels draws the titles, the column numbers and the row letters of the boards starting at
+// columns
+func (g *Gridder) drawBattleshipLabels(size int, columns []int, titles []string, fontFace font.Face, textColor color.Color) error {
+	stringConfig := StringConfig{Color: textColor}
+	for i, column := range columns {
+		first := g.getCellCenter(0, column)
+		last := g.getCellCenter(0, column+size-1)
+		g.ctx.Push()
+		g.ctx.SetFontFace(fontFace)
+		g.ctx.SetColor(textColor)
+		g.ctx.DrawStringAnchored(titles[i], (first.X+last.X)/2, first.Y, 0.5, 0.35)
+		g.ctx.Pop()
+		g.addLabel(0, column, titles[i])
+
+		for j := 0; j < size; j++ {
+			err := g.drawString(1, column+j, strconv.Itoa(j+1), fontFace, stringConfig)
+			if err != nil {
+				return err
+			}
+			err = g.drawString(j+2, column-1, columnLetters(j), fontFace, stringConfig)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+var testBattleshipBoard = BattleshipBoard{
+	Ships:         []Ship{{Row: 0, Column: 0, Length: 3}, {Row: 2, Column: 4, Length: 2, Vertical: true}},
+	IncomingShots: []Cell{{Row: 0, Column: 1}, {Row: 4, Column: 4}},
+	OutgoingShots: map[Cell]bool{{Row: 2, Column: 2}: true, {Row: 0, Column: 0}: false},
+}
+
+func TestBattleshipBoardRender(t *testing.T) {
+	gridder, err := testBattleshipBoard.Render(ImageConfig{}, BattleshipConfig{Size: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 390)
+	assert.Equal(t, gridder.ctx.Height(), 210)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(45, 75)), defaultBattleshipShipColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(60, 75)), defaultBattleshipShipColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(165, 150)), defaultBattleshipShipColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 75)), defaultBattleshipHitColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(165, 195)), color.NRGBAModel.Convert(defaultBattleshipMissColor))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(45, 165)), defaultBattleshipWaterColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(315, 135)), defaultBattleshipHitColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(255, 75)), color.NRGBAModel.Convert(defaultBattleshipMissColor))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(285, 75)), defaultBattleshipWaterColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(195, 75)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestBattleshipBoardRenderLabels(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	gridder, err := testBattleshipBoard.Render(ImageConfig{}, BattleshipConfig{Size: 5, Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"Fleet"})
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 8}], []string{"Target"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 1}], []string{"1"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 12}], []string{"5"})
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 0}], []string{"A"})
+	assert.Equal(t, gridder.labels[Cell{Row: 6, Column: 7}], []string{"E"})
+}
+
+func TestBattleshipBoardRenderErrors(t *testing.T) {
+	battleshipConfig := BattleshipConfig{Size: 5}
+	_, err := BattleshipBoard{Ships: []Ship{{Row: 0, Column: 3, Length: 3}}}.Render(ImageConfig{}, battleshipConfig)
+	assert.True(t, errors.Is(err, errOutOfBounds))
+
+	_, err = BattleshipBoard{Ships: []Ship{{Length: 2}, {Length: 2, Vertical: true}}}.Render(ImageConfig{}, battleshipConfig)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = BattleshipBoard{Ships: []Ship{{Length: 0}}}.Render(ImageConfig{}, battleshipConfig)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = BattleshipBoard{IncomingShots: []Cell{{Row: 5}}}.Render(ImageConfig{}, battleshipConfig)
+	assert.True(t, errors.Is(err, errOutOfBounds))
+
+	_, err = BattleshipBoard{OutgoingShots: map[Cell]bool{{Column: -1}: true}}.Render(ImageConfig{}, battleshipConfig)
+	assert.True(t, errors.Is(err, errOutOfBounds))
+}
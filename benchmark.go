@@ -0,0 +1,144 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"io/ioutil"
+	"runtime"
+	"strconv"
+	"time"
+
+	"golang.org/x/image/font"
+)
+
+// BenchmarkScenario is a rendering workload measured by RunBenchmarks
+type BenchmarkScenario struct {
+	Name string
+	// Run renders the workload once from scratch
+	Run func() error
+}
+
+// BenchmarkResult holds the timings of a scenario over its iterations, and the memory it allocated per iteration
+type BenchmarkResult struct {
+	Name       string
+	Iterations int
+	Total      time.Duration
+	Mean       time.Duration
+	Min        time.Duration
+	Max        time.Duration
+	// Allocations is the mean number of heap allocations per iteration
+	Allocations uint64
+	// AllocatedBytes is the mean number of heap bytes allocated per iteration
+	AllocatedBytes uint64
+}
+
+// BenchmarkScenarios gets the built-in scenarios: a heatmap of 500 by 500 cells encoded to PNG, 10000 strings drawn
+// with a font face and 1000000 painted cells
+func BenchmarkScenarios(fontFace font.Face) []BenchmarkScenario {
+	return []BenchmarkScenario{
+		{Name: "heatmap", Run: benchmarkHeatmap},
+		{Name: "strings", Run: func() error { return benchmarkStrings(fontFace) }},
+		{Name: "paint-cells", Run: benchmarkPaintCells},
+	}
+}
+
+// RunBenchmarks runs every scenario for a number of iterations, after a warm up run, and gets their results in the
+// order of the scenarios. It stops at the first scenario failing
+func RunBenchmarks(scenarios []BenchmarkScenario, benchmarkConfigs ...BenchmarkConfig) ([]BenchmarkResult, error) {
+	benchmarkConfig := getFirstBenchmarkConfig(benchmarkConfigs...)
+	iterations := benchmarkConfig.GetIterations()
+
+	results := make([]BenchmarkResult, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		err := scenario.Run()
+		if err != nil {
+			return nil, fmt.Errorf("benchmark %s: %w", scenario.Name, err)
+		}
+
+		result := BenchmarkResult{Name: scenario.Name, Iterations: iterations}
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		for i := 0; i < iterations; i++ {
+			start := time.Now()
+			err = scenario.Run()
+			elapsed := time.Since(start)
+			if err != nil {
+				return nil, fmt.Errorf("benchmark %s: %w", scenario.Name, err)
+			}
+
+			result.Total += elapsed
+			if i == 0 || elapsed < result.Min {
+				result.Min = elapsed
+			}
+			if elapsed > result.Max {
+				result.Max = elapsed
+			}
+		}
+		runtime.ReadMemStats(&after)
+
+		result.Mean = result.Total / time.Duration(iterations)
+		result.Allocations = (after.Mallocs - before.Mallocs) / uint64(iterations)
+		result.AllocatedBytes = (after.TotalAlloc - before.TotalAlloc) / uint64(iterations)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// benchmarkHeatmap paints a gradient over 500 by 500 cells and encodes it to PNG
+func benchmarkHeatmap() error {
+	const size = 500
+	g, err := New(ImageConfig{Width: size * 2, Height: size * 2}, GridConfig{Rows: size, Columns: size})
+	if err != nil {
+		return err
+	}
+
+	colormap := LinearColormap(color.NRGBA{B: 255, A: 255}, color.NRGBA{R: 255, A: 255})
+	for row := 0; row < size; row++ {
+		for column := 0; column < size; column++ {
+			err = g.PaintCell(row, column, colormap(float64(row+column)/(2*size-2)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return g.EncodePNG(ioutil.Discard)
+}
+
+// benchmarkStrings draws 10000 numbers into 100 by 100 cells
+func benchmarkStrings(fontFace font.Face) error {
+	const size = 100
+	g, err := New(ImageConfig{Width: size * 30, Height: size * 20}, GridConfig{Rows: size, Columns: size})
+	if err != nil {
+		return err
+	}
+
+	for row := 0; row < size; row++ {
+		for column := 0; column < size; column++ {
+			err = g.DrawString(row, column, strconv.Itoa(row*size+column), fontFace)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// benchmarkPaintCells paints 1000 by 1000 cells
+func benchmarkPaintCells() error {
+	const size = 1000
+	g, err := New(ImageConfig{Width: size, Height: size}, GridConfig{Rows: size, Columns: size})
+	if err != nil {
+		return err
+	}
+
+	for row := 0; row < size; row++ {
+		for column := 0; column < size; column++ {
+			err = g.PaintCell(row, column, color.Black)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package gridder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func benchmarkFontFace(t testing.TB) font.Face {
+	parsed, err := truetype.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return truetype.NewFace(parsed, &truetype.Options{Size: 10})
+}
+
+func TestRunBenchmarks(t *testing.T) {
+	runs := 0
+	scenarios := []BenchmarkScenario{{Name: "sleep", Run: func() error {
+		runs++
+		time.Sleep(time.Millisecond)
+		return nil
+	}}}
+
+	results, err := RunBenchmarks(scenarios, BenchmarkConfig{Iterations: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, runs, 3)
+	assert.Equal(t, len(results), 1)
+	assert.Equal(t, results[0].Name, "sleep")
+	assert.Equal(t, results[0].Iterations, 2)
+	assert.Equal(t, results[0].Mean, results[0].Total/2)
+	assert.True(t, results[0].Min >= time.Millisecond)
+	assert.True(t, results[0].Min <= results[0].Mean && results[0].Mean <= results[0].Max)
+
+	failure := errors.New("failure")
+	_, err = RunBenchmarks([]BenchmarkScenario{{Name: "fail", Run: func() error { return failure }}})
+	assert.True(t, errors.Is(err, failure))
+	assert.Equal(t, err.Error(), "benchmark fail: failure")
+}
+
+func TestBenchmarkScenarios(t *testing.T) {
+	var names []string
+	scenarios := BenchmarkScenarios(benchmarkFontFace(t))
+	for _, scenario := range scenarios {
+		names = append(names, scenario.Name)
+	}
+	assert.Equal(t, names, []string{"heatmap", "strings", "paint-cells"})
+
+	results, err := RunBenchmarks(scenarios[1:2], BenchmarkConfig{Iterations: 1})
+	assert.Nil(t, err)
+	assert.True(t, results[0].Allocations > 0)
+}
+
+func BenchmarkBuiltinScenarios(b *testing.B) {
+	for _, scenario := range BenchmarkScenarios(benchmarkFontFace(b)) {
+		scenario := scenario
+		b.Run(scenario.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				err := scenario.Run()
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package gridder
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"golang.org/x/image/font"
+)
+
+// BingoFree is the number of the free space of a bingo card
+const BingoFree = 0
+
+// BingoRange is the inclusive range of the numbers of a bingo card column
+type BingoRange struct {
+	Min int
+	Max int
+}
+
+// BingoCard holds the numbers of a bingo card by row and column, BingoFree marks the free space
+type BingoCard [][]int
+
+// NewBingoCard generates a bingo card with distinct random numbers in every column, drawn from the range of the
+// column
+func NewBingoCard(bingoConfigs ...BingoConfig) (BingoCard, error) {
+	cards, err := NewBingoCards(1, bingoConfigs...)
+	if err != nil {
+		return nil, err
+	}
+	return cards[0], nil
+}
+
+// NewBingoCards generates a batch of distinct bingo cards, failing when the ranges do not allow as many cards
+func NewBingoCards(count int, bingoConfigs ...BingoConfig) ([]BingoCard, error) {
+	bingoConfig := getFirstBingoConfig(bingoConfigs...)
+	if count <= 0 {
+		return nil, fmt.Errorf("%w: %d cards", errInvalidValue, count)
+	}
+
+	rows, ranges := bingoConfig.GetRows(), bingoConfig.GetRanges()
+	free := bingoFreeCell(rows, len(ranges), bingoConfig.FreeCenter)
+	capacity := 1
+	for column, bingoRange := range ranges {
+		if bingoRange.Min <= BingoFree || bingoRange.Max < bingoRange.Min+rows-1 {
+			return nil, fmt.Errorf("%w: range %d to %d of column %d for %d rows", errInvalidValue, bingoRange.Min, bingoRange.Max, column, rows)
+		}
+
+		numbers := rows
+		if column == free.Column {
+			numbers--
+		}
+		for i := 0; i < numbers && capacity < count; i++ {
+			capacity *= bingoRange.Max - bingoRange.Min + 1 - i
+		}
+	}
+	if capacity < count {
+		return nil, fmt.Errorf("%w: %d cards out of %d distinct cards", errInvalidValue, count, capacity)
+	}
+
+	random := bingoConfig.Rand
+	if random == nil {
+		random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	cards := make([]BingoCard, 0, count)
+	seen := make(map[string]bool, count)
+	for len(cards) < count {
+		card := newBingoCard(random, rows, ranges, free)
+		key := fmt.Sprint(card)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// newBingoCard draws the numbers of a card column by column
+func newBingoCard(random *rand.Rand, rows int, ranges []BingoRange, free Cell) BingoCard {
+	card := make(BingoCard, rows)
+	for row := range card {
+		card[row] = make([]int, len(ranges))
+	}
+
+	for column, bingoRange := range ranges {
+		numbers := random.Perm(bingoRange.Max - bingoRange.Min + 1)
+		for row := 0; row < rows; row++ {
+			card[row][column] = bingoRange.Min + numbers[row]
+		}
+	}
+
+	if free.Row >= 0 {
+		card[free.Row][free.Column] = BingoFree
+	}
+	return card
+}
+
+// bingoFreeCell gets the free space of a card, at row -1 without one
+func bingoFreeCell(rows int, columns int, freeCenter bool) Cell {
+	if !freeCenter {
+		return Cell{Row: -1, Column: -1}
+	}
+	return Cell{Row: rows / 2, Column: columns / 2}
+}
+
+// Render creates the bingo card image with a header row above the numbers. The header defaults to the letters of
+// BINGO on cards of 5 columns and is left out on other cards. Texts are drawn when a font is configured, and the
+// image is sized for square cells unless its dimensions are set
+func (c BingoCard) Render(imageConfig ImageConfig, bingoCardConfigs ...BingoCardConfig) (*Gridder, error) {
+	bingoCardConfig := getFirstBingoCardConfig(bingoCardConfigs...)
+
+	rows := len(c)
+	if rows == 0 {
+		return nil, errNoRows
+	}
+	columns := len(c[0])
+	if columns == 0 {
+		return nil, errNoColumns
+	}
+	for row := range c {
+		if len(c[row]) != columns {
+			return nil, errMatrixDimensions
+		}
+	}
+
+	header := bingoCardConfig.Header
+	if header == nil && columns == len(defaultBingoHeader) {
+		header = defaultBingoHeader
+	}
+	if len(header) > 0 && len(header) != columns {
+		return nil, fmt.Errorf("%w: header of %d columns for %d columns", errMatrixDimensions, len(header), columns)
+	}
+
+	headerRows := 0
+	if len(header) > 0 {
+		headerRows = 1
+	}
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultBingoCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = (rows + headerRows) * defaultBingoCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            rows + headerRows,
+		Columns:         columns,
+		LineStrokeWidth: defaultBingoLineStrokeWidth,
+		LineColor:       bingoCardConfig.GetLineColor(),
+		BorderColor:     bingoCardConfig.GetLineColor(),
+		BackgroundColor: bingoCardConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if bingoCardConfig.Font != nil {
+		fontFace = g.FontFace(bingoCardConfig.Font, bingoCardConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		for column, text := range header {
+			err := g.paintCell(0, column, bingoCardConfig.GetHeaderColor())
+			if err != nil {
+				return err
+			}
+
+			if fontFace != nil {
+				err = g.drawString(0, column, text, fontFace, StringConfig{Color: contrastColor(bingoCardConfig.GetHeaderColor())})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		for row := range c {
+			for column, number := range c[row] {
+				text := strconv.Itoa(number)
+				if number == BingoFree {
+					err := g.paintCell(row+headerRows, column, bingoCardConfig.GetFreeColor())
+					if err != nil {
+						return err
+					}
+					text = bingoCardConfig.GetFreeText()
+				}
+
+				if fontFace == nil {
+					continue
+				}
+				err := g.drawString(row+headerRows, column, text, fontFace, StringConfig{Color: bingoCardConfig.GetTextColor()})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
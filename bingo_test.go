@@ -0,0 +1,105 @@
+package gridder
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestNewBingoCard(t *testing.T) {
+	card, err := NewBingoCard(BingoConfig{FreeCenter: true, Rand: rand.New(rand.NewSource(1))})
+	assert.Nil(t, err)
+	assert.Equal(t, len(card), 5)
+	assert.Equal(t, card[2][2], BingoFree)
+
+	for column, bingoRange := range defaultBingoRanges {
+		numbers := make(map[int]bool)
+		for row := range card {
+			if row == 2 && column == 2 {
+				continue
+			}
+			number := card[row][column]
+			assert.True(t, number >= bingoRange.Min && number <= bingoRange.Max)
+			assert.False(t, numbers[number])
+			numbers[number] = true
+		}
+	}
+
+	again, err := NewBingoCard(BingoConfig{FreeCenter: true, Rand: rand.New(rand.NewSource(1))})
+	assert.Nil(t, err)
+	assert.Equal(t, again, card)
+}
+
+func TestNewBingoCards(t *testing.T) {
+	bingoConfig := BingoConfig{Ranges: []BingoRange{{Min: 1, Max: 3}, {Min: 4, Max: 5}}, Rows: 2, Rand: rand.New(rand.NewSource(1))}
+	cards, err := NewBingoCards(12, bingoConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, len(cards), 12)
+
+	seen := make(map[string]bool)
+	for _, card := range cards {
+		assert.Equal(t, len(card), 2)
+		assert.Equal(t, len(card[0]), 2)
+		seen[fmt.Sprint(card)] = true
+	}
+	assert.Equal(t, len(seen), 12)
+
+	_, err = NewBingoCards(13, bingoConfig)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestNewBingoCardsErrors(t *testing.T) {
+	_, err := NewBingoCards(0)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = NewBingoCard(BingoConfig{Ranges: []BingoRange{{Min: 1, Max: 4}}})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = NewBingoCard(BingoConfig{Ranges: []BingoRange{{Min: 0, Max: 10}}})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestBingoCardRender(t *testing.T) {
+	card, err := NewBingoCard(BingoConfig{FreeCenter: true, Rand: rand.New(rand.NewSource(1))})
+	assert.Nil(t, err)
+
+	ttf, _ := truetype.Parse(goregular.TTF)
+	gridder, err := card.Render(ImageConfig{}, BingoCardConfig{Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 400)
+	assert.Equal(t, gridder.ctx.Height(), 480)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(5, 5)), defaultBingoHeaderColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(165, 265)), defaultBingoFreeColor)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"I"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 2}], []string{"FREE"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 0}], []string{fmt.Sprint(card[0][0])})
+}
+
+func TestBingoCardRenderHeader(t *testing.T) {
+	card := BingoCard{{1, 4}, {2, 5}}
+	gridder, err := card.Render(ImageConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Height(), 160)
+
+	gridder, err = card.Render(ImageConfig{}, BingoCardConfig{Header: []string{"X", "Y"}})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Height(), 240)
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(5, 5)), defaultBingoHeaderColor)
+
+	_, err = card.Render(ImageConfig{}, BingoCardConfig{Header: []string{"X"}})
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+
+	_, err = BingoCard{}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = BingoCard{{1, 2}, {3}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+}
@@ -0,0 +1,64 @@
+package gridder
+
+// DrawCellBorder draws an outline inside a cell, such as to highlight a selected cell without painting over it. Sides
+// can be left out and styled apart, and each side is drawn inside the cell, so that the borders of neighboring cells
+// do not overlap
+func (g *Gridder) DrawCellBorder(row int, column int, borderConfig BorderConfig) error {
+	return g.retainDescribed("DrawCellBorder", []interface{}{row, column, borderConfig}, func() error {
+		return g.retainCells([]Cell{{Row: row, Column: column}}, func(cells []Cell) error {
+			return g.drawCellBorder(cells[0].Row, cells[0].Column, borderConfig)
+		})
+	})
+}
+
+func (g *Gridder) drawCellBorder(row int, column int, borderConfig BorderConfig) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	borderConfig = borderConfig.withStyle(g.getCellStyle(row, column))
+	err = borderConfig.validate(g.maxStrokeWidth())
+	if err != nil {
+		return err
+	}
+
+	area := g.getCellArea(row, column)
+	left, top := area.center.X-area.width/2, area.center.Y-area.height/2
+	right, bottom := area.center.X+area.width/2, area.center.Y+area.height/2
+	for _, side := range borderConfig.GetSides() {
+		sideConfig := borderConfig.GetSideConfig(side)
+		strokeWidth, ok, err := g.fitStroke(row, column, area, sideConfig.GetStrokeWidth())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		inset := strokeWidth / 2
+		x1, y1, x2, y2 := left, top+inset, right, top+inset
+		switch side {
+		case SideRight:
+			x1, y1, x2, y2 = right-inset, top, right-inset, bottom
+		case SideBottom:
+			x1, y1, x2, y2 = left, bottom-inset, right, bottom-inset
+		case SideLeft:
+			x1, y1, x2, y2 = left+inset, top, left+inset, bottom
+		}
+
+		g.ctx.Push()
+		dashes := sideConfig.GetDashes()
+		if dashes > 0 {
+			g.ctx.SetDash(dashes)
+		} else {
+			g.ctx.SetDash()
+		}
+		g.ctx.SetColor(sideConfig.GetColor())
+		g.stroke(strokeWidth, func() {
+			g.ctx.DrawLine(x1, y1, x2, y2)
+		})
+		g.ctx.Pop()
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawCellBorder(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	assert.Nil(t, gridder.DrawCellBorder(1, 1, BorderConfig{StrokeWidth: 4, Color: red, Left: &EdgeConfig{Color: blue}}))
+	assert.Equal(t, gridder.operations.cellsAt(0), []Cell{{Row: 1, Column: 1}})
+
+	img := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(37, 26)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(48, 37)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(37, 48)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(26, 37)), blue)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(37, 23)), white)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(37, 37)), white)
+
+	assert.Nil(t, gridder.DrawCellBorder(2, 2, BorderConfig{StrokeWidth: 4, Color: red, Sides: []Side{SideBottom}}))
+	img = gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(62, 73)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(62, 51)), white)
+
+	assert.ErrorIs(t, gridder.DrawCellBorder(4, 0, BorderConfig{}), errOutOfBounds)
+	assert.ErrorIs(t, gridder.DrawCellBorder(0, 0, BorderConfig{Sides: []Side{Side(4)}}), errInvalidValue)
+	assert.ErrorIs(t, gridder.DrawCellBorder(0, 0, BorderConfig{Top: &EdgeConfig{StrokeWidth: 1000}}), errInvalidValue)
+}
@@ -0,0 +1,340 @@
+package gridder
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"io"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/image/font"
+)
+
+// CacheStore stores encoded images by scene hash
+type CacheStore interface {
+	// Get gets the data stored for a key, reporting false when there is none
+	Get(key string) ([]byte, bool, error)
+	// Set stores the data for a key
+	Set(key string, data []byte) error
+}
+
+// EncodeCached writes the grid encoded in a format to w, reusing the bytes stored in cache for an identical scene.
+// Scenes are identified by the format, the image and grid configuration, the cell styles, the metadata and the retained
+// operations, so a hit neither renders nor encodes the grid. Font faces are identified by instance and images by their
+// pixels. Drawing on Context directly is not part of the scene. Scenes with filters, an overlay or operations that
+// cannot be described, such as charts and generators taking functions, are identified by their rendered pixels and
+// metadata instead, which only saves encoding. Encoders replaced with RegisterEncoder should use a fresh cache
+func (g *Gridder) EncodeCached(w io.Writer, format string, cache CacheStore) error {
+	encoder, err := getEncoder(format)
+	if err != nil {
+		return err
+	}
+
+	var img image.Image
+	key, ok := g.sceneKey(normalizeFormat(format))
+	if !ok {
+		pixels := toNRGBA(g.image())
+		key = sceneHash(normalizeFormat(format), g.Metadata(), pixels.Pix, pixels.Rect.Dx(), pixels.Rect.Dy())
+		img = pixels
+	}
+
+	data, ok, err := cache.Get(key)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		if img == nil {
+			img = g.image()
+		}
+
+		buffer := new(bytes.Buffer)
+		err = g.encodeWithMetadata(buffer, format, encoder, img)
+		if err != nil {
+			return err
+		}
+
+		data = buffer.Bytes()
+		err = cache.Set(key, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+func sceneHash(format string, metadata map[string]string, pix []byte, width int, height int) string {
+	hash := sha256.New()
+	io.WriteString(hash, format)
+	for _, key := range sortedMetadataKeys(metadata) {
+		io.WriteString(hash, "\x00"+key+"\x00"+metadata[key])
+	}
+	hash.Write([]byte{0, byte(width >> 24), byte(width >> 16), byte(width >> 8), byte(width)})
+	hash.Write([]byte{byte(height >> 24), byte(height >> 16), byte(height >> 8), byte(height)})
+	hash.Write(pix)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// sceneKey hashes the configuration and the retained operations of the grid, reporting false when the scene cannot
+// be identified without rendering it
+func (g *Gridder) sceneKey(format string) (string, bool) {
+	if g.parent != nil || g.overlay != nil || len(g.filters) > 0 {
+		return "", false
+	}
+
+	hash := sha256.New()
+	io.WriteString(hash, "scene\x00"+format+"\x00")
+	state := []interface{}{
+		g.imageConfig, g.gridConfig, g.flipHorizontal, g.flipVertical, g.cellStyles, g.locale.String(), g.labels,
+		g.mask, g.spriteSheet, g.entities, g.entityOrder, g.labelDeclutter, g.pathRouting, g.Metadata(),
+	}
+	if !writeKey(hash, reflect.ValueOf(state), 0) {
+		return "", false
+	}
+
+	var buffer [8]byte
+	for _, ref := range g.operations.order {
+		if ref.kind == paintOperationKind {
+			paint := g.operations.paints[ref.index]
+			writeCells(hash, paint.cell)
+			binary.BigEndian.PutUint64(buffer[:], uint64(paint.fill.R)<<48|uint64(paint.fill.G)<<32|uint64(paint.fill.B)<<16|uint64(paint.fill.A))
+			hash.Write(buffer[:])
+			if paint.styled {
+				hash.Write([]byte{1})
+			} else {
+				hash.Write([]byte{0})
+			}
+			continue
+		}
+
+		op := g.operations.operation(ref)
+		if op.key == "" {
+			return "", false
+		}
+		io.WriteString(hash, op.key)
+		binary.BigEndian.PutUint64(buffer[:], uint64(len(op.cells)))
+		hash.Write(buffer[:])
+		writeCells(hash, op.cells...)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), true
+}
+
+func writeCells(w io.Writer, cells ...Cell) {
+	var buffer [16]byte
+	for _, cell := range cells {
+		binary.BigEndian.PutUint64(buffer[:8], uint64(cell.Row))
+		binary.BigEndian.PutUint64(buffer[8:], uint64(cell.Column))
+		w.Write(buffer[:])
+	}
+}
+
+// describeCall hashes a drawing call and its arguments into an operation key, reporting false when an argument
+// cannot be described
+func describeCall(call string, args []interface{}) (string, bool) {
+	hash := sha256.New()
+	io.WriteString(hash, call)
+	if !writeKey(hash, reflect.ValueOf(args), 0) {
+		return "", false
+	}
+	return string(hash.Sum(nil)), true
+}
+
+var fontFaceType = reflect.TypeOf((*font.Face)(nil)).Elem()
+
+// maxKeyDepth bounds the nesting of described values, so that cyclic values are not described
+const maxKeyDepth = 16
+
+// writeKey writes a description of a value that is equal for equal values, following pointers and sorting map
+// entries. Font faces are described by instance, as their glyph caches change as they are used. It reports false
+// for functions, channels and values nested too deep
+func writeKey(w io.Writer, v reflect.Value, depth int) bool {
+	if depth > maxKeyDepth {
+		return false
+	}
+	if !v.IsValid() {
+		io.WriteString(w, "<nil>")
+		return true
+	}
+
+	io.WriteString(w, v.Type().String())
+	switch v.Kind() {
+	case reflect.Bool:
+		fmt.Fprintf(w, "(%t)", v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		fmt.Fprintf(w, "(%d)", v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		fmt.Fprintf(w, "(%d)", v.Uint())
+	case reflect.Float32, reflect.Float64:
+		fmt.Fprintf(w, "(%b)", v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		fmt.Fprintf(w, "(%b)", v.Complex())
+	case reflect.String:
+		fmt.Fprintf(w, "(%q)", v.String())
+	case reflect.Ptr:
+		if v.IsNil() {
+			io.WriteString(w, "(nil)")
+			return true
+		}
+		if v.Type().Implements(fontFaceType) {
+			fmt.Fprintf(w, "(%x)", v.Pointer())
+			return true
+		}
+		return writeKey(w, v.Elem(), depth+1)
+	case reflect.Interface:
+		return writeKey(w, v.Elem(), depth+1)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !writeKey(w, v.Field(i), depth+1) {
+				return false
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			io.WriteString(w, "(nil)")
+			return true
+		}
+		fmt.Fprintf(w, "[%d]", v.Len())
+		if v.Type().Elem().Kind() == reflect.Uint8 && v.Kind() == reflect.Slice {
+			w.Write(v.Bytes())
+			return true
+		}
+		for i := 0; i < v.Len(); i++ {
+			if !writeKey(w, v.Index(i), depth+1) {
+				return false
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			io.WriteString(w, "(nil)")
+			return true
+		}
+		entries := make([][2][]byte, 0, v.Len())
+		iterator := v.MapRange()
+		for iterator.Next() {
+			key, value := new(bytes.Buffer), new(bytes.Buffer)
+			if !writeKey(key, iterator.Key(), depth+1) || !writeKey(value, iterator.Value(), depth+1) {
+				return false
+			}
+			entries = append(entries, [2][]byte{key.Bytes(), value.Bytes()})
+		}
+		sort.Slice(entries, func(i int, j int) bool {
+			return bytes.Compare(entries[i][0], entries[j][0]) < 0
+		})
+		fmt.Fprintf(w, "{%d}", len(entries))
+		for _, entry := range entries {
+			w.Write(entry[0])
+			w.Write(entry[1])
+		}
+	default:
+		if v.Kind() == reflect.Func && v.IsNil() {
+			io.WriteString(w, "(nil)")
+			return true
+		}
+		return false
+	}
+	return true
+}
+
+// LRUCache is an in-memory CacheStore evicting the least recently used entries. It is safe for concurrent use
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type lruEntry struct {
+	key  string
+	data []byte
+}
+
+// NewLRUCache creates an in-memory cache holding up to capacity encoded images
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get gets the data stored for a key
+func (c *LRUCache) Get(key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*lruEntry).data, true, nil
+}
+
+// Set stores the data for a key, evicting the least recently used entry when the cache is full
+func (c *LRUCache) Set(key string, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*lruEntry).data = data
+		c.order.MoveToFront(element)
+		return nil
+	}
+
+	if c.capacity <= 0 {
+		return nil
+	}
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, data: data})
+	return nil
+}
+
+// Len gets the number of entries in the cache
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// RedisClient is the subset of a Redis client used by RedisCache, so that any client library can be wrapped.
+// Get returns nil data and no error for missing keys
+type RedisClient interface {
+	Get(key string) ([]byte, error)
+	Set(key string, data []byte, ttl time.Duration) error
+}
+
+// RedisCache is a CacheStore backed by Redis, sharing encoded images between service instances
+type RedisCache struct {
+	Client RedisClient
+	// Prefix is prepended to the scene hash to build the Redis key
+	Prefix string
+	// TTL is the expiration of stored images, zero keeps them until evicted by Redis
+	TTL time.Duration
+}
+
+// Get gets the data stored for a key
+func (c RedisCache) Get(key string) ([]byte, bool, error) {
+	data, err := c.Client.Get(c.Prefix + key)
+	if err != nil || data == nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// Set stores the data for a key
+func (c RedisCache) Set(key string, data []byte) error {
+	return c.Client.Set(c.Prefix+key, data, c.TTL)
+}
@@ -0,0 +1,215 @@
+package gridder
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRedis struct {
+	data map[string][]byte
+	ttl  time.Duration
+	err  error
+}
+
+func (r *fakeRedis) Get(key string) ([]byte, error) {
+	return r.data[key], r.err
+}
+
+func (r *fakeRedis) Set(key string, data []byte, ttl time.Duration) error {
+	r.data[key] = data
+	r.ttl = ttl
+	return nil
+}
+
+func TestEncodeCached(t *testing.T) {
+	var calls int
+	RegisterEncoder("counting", func(w io.Writer, img image.Image) error {
+		calls++
+		return encodePNG(w, img)
+	})
+
+	gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	cache := NewLRUCache(1)
+	first := new(bytes.Buffer)
+	assert.Nil(t, gridder.EncodeCached(first, "counting", cache))
+	second := new(bytes.Buffer)
+	assert.Nil(t, gridder.EncodeCached(second, ".COUNTING", cache))
+	assert.Equal(t, calls, 1)
+	assert.Equal(t, second.Bytes(), first.Bytes())
+
+	expected := new(bytes.Buffer)
+	assert.Nil(t, gridder.EncodePNG(expected))
+	assert.Equal(t, first.Bytes(), expected.Bytes())
+
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	assert.Nil(t, gridder.EncodeCached(new(bytes.Buffer), "counting", cache))
+	assert.Equal(t, calls, 2)
+	assert.Equal(t, cache.Len(), 1)
+
+	err = gridder.EncodeCached(new(bytes.Buffer), "avif", cache)
+	assert.True(t, errors.Is(err, errUnknownFormat))
+}
+
+func TestEncodeCachedSkipsRendering(t *testing.T) {
+	newGridder := func() *Gridder {
+		gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2, LineStrokeWidth: 2})
+		assert.Nil(t, err)
+		assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+		assert.Nil(t, gridder.DrawCircle(1, 1, CircleConfig{Radius: 3, Color: color.White}))
+		assert.Nil(t, gridder.DrawCellBorder(0, 1, BorderConfig{StrokeWidth: 1}))
+		return gridder
+	}
+
+	cache := NewLRUCache(4)
+	gridder := newGridder()
+	first := new(bytes.Buffer)
+	assert.Nil(t, gridder.EncodeCached(first, "png", cache))
+
+	// drawing on the context is not part of the scene, so a hit returns the bytes encoded before it
+	gridder.Context().SetColor(color.White)
+	gridder.Context().DrawRectangle(0, 0, 20, 20)
+	gridder.Context().Fill()
+	drawn := new(bytes.Buffer)
+	assert.Nil(t, gridder.EncodePNG(drawn))
+	assert.NotEqual(t, drawn.Bytes(), first.Bytes())
+
+	second := new(bytes.Buffer)
+	assert.Nil(t, newGridder().EncodeCached(second, "png", cache))
+	assert.Nil(t, gridder.EncodeCached(second, "png", cache))
+	assert.Equal(t, second.Bytes(), append(first.Bytes(), first.Bytes()...))
+	assert.Equal(t, cache.Len(), 1)
+
+	assert.Nil(t, gridder.InsertRow(0))
+	assert.Nil(t, gridder.EncodeCached(new(bytes.Buffer), "png", cache))
+	assert.Equal(t, cache.Len(), 2)
+
+	other := newGridder()
+	assert.Nil(t, other.DrawCircle(1, 1, CircleConfig{Radius: 2, Color: color.White}))
+	assert.Nil(t, other.EncodeCached(new(bytes.Buffer), "png", cache))
+	assert.Equal(t, cache.Len(), 3)
+}
+
+func TestEncodeCachedUndescribedScene(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.White))
+	gridder.AddFilter(Grayscale())
+
+	_, ok := gridder.sceneKey("png")
+	assert.False(t, ok)
+
+	cache := NewLRUCache(2)
+	assert.Nil(t, gridder.EncodeCached(new(bytes.Buffer), "png", cache))
+	gridder.Context().SetColor(color.Black)
+	gridder.Context().DrawRectangle(0, 0, 20, 20)
+	gridder.Context().Fill()
+	assert.Nil(t, gridder.EncodeCached(new(bytes.Buffer), "png", cache))
+	assert.Equal(t, cache.Len(), 2)
+}
+
+func TestEncodeCachedMetadata(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetMetadata("Author", "gridder"))
+
+	cache := NewLRUCache(4)
+	for _, format := range []string{"png", "jpg"} {
+		cached := new(bytes.Buffer)
+		assert.Nil(t, gridder.EncodeCached(cached, format, cache))
+		expected := new(bytes.Buffer)
+		assert.Nil(t, gridder.Encode(expected, format))
+		assert.Equal(t, cached.Bytes(), expected.Bytes())
+	}
+
+	assert.Nil(t, gridder.SetMetadata("Author", "someone"))
+	cached := new(bytes.Buffer)
+	assert.Nil(t, gridder.EncodeCached(cached, "png", cache))
+	types, chunks := readPNGChunks(t, cached.Bytes())
+	assert.Equal(t, types[1], "tEXt")
+	assert.Equal(t, chunks[1], []byte("Author\x00someone"))
+	assert.Equal(t, cache.Len(), 3)
+
+	gridder.AddFilter(Grayscale())
+	assert.Nil(t, gridder.EncodeCached(new(bytes.Buffer), "png", cache))
+	assert.Nil(t, gridder.SetMetadata("Author", "gridder"))
+	assert.Nil(t, gridder.EncodeCached(cached, "png", cache))
+	assert.Equal(t, cache.Len(), 4)
+}
+
+func TestDescribeCall(t *testing.T) {
+	key1, ok := describeCall("call", []interface{}{map[string]int{"a": 1, "b": 2, "c": 3}, &RectangleConfig{Width: 1}})
+	assert.True(t, ok)
+	key2, ok := describeCall("call", []interface{}{map[string]int{"c": 3, "b": 2, "a": 1}, &RectangleConfig{Width: 1}})
+	assert.True(t, ok)
+	assert.Equal(t, key1, key2)
+
+	key3, ok := describeCall("call", []interface{}{map[string]int{"a": 1, "b": 2, "c": 3}, &RectangleConfig{Width: 2}})
+	assert.True(t, ok)
+	assert.NotEqual(t, key3, key1)
+
+	_, ok = describeCall("call", []interface{}{func() {}})
+	assert.False(t, ok)
+	_, ok = describeCall("call", []interface{}{(func())(nil)})
+	assert.True(t, ok)
+}
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2)
+	assert.Nil(t, cache.Set("a", []byte("1")))
+	assert.Nil(t, cache.Set("b", []byte("2")))
+	_, ok, _ := cache.Get("a")
+	assert.True(t, ok)
+	assert.Nil(t, cache.Set("c", []byte("3")))
+
+	_, ok, _ = cache.Get("b")
+	assert.False(t, ok)
+	data, ok, err := cache.Get("a")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, data, []byte("1"))
+
+	assert.Nil(t, cache.Set("a", []byte("4")))
+	data, _, _ = cache.Get("a")
+	assert.Equal(t, data, []byte("4"))
+	assert.Equal(t, cache.Len(), 2)
+
+	empty := NewLRUCache(0)
+	assert.Nil(t, empty.Set("a", []byte("1")))
+	assert.Equal(t, empty.Len(), 0)
+}
+
+func TestRedisCache(t *testing.T) {
+	client := &fakeRedis{data: map[string][]byte{}}
+	cache := RedisCache{Client: client, Prefix: "grid:", TTL: time.Minute}
+
+	_, ok, err := cache.Get("a")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	assert.Nil(t, cache.Set("a", []byte("1")))
+	assert.Equal(t, client.data["grid:a"], []byte("1"))
+	assert.Equal(t, client.ttl, time.Minute)
+
+	data, ok, err := cache.Get("a")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, data, []byte("1"))
+
+	client.err = errors.New("connection refused")
+	_, ok, err = cache.Get("a")
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+
+	gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.NotNil(t, gridder.EncodeCached(new(bytes.Buffer), "png", cache))
+}
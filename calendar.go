@@ -0,0 +1,192 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"time"
+
+	"golang.org/x/image/font"
+)
+
+// calendar layout: a row of month labels, a row per weekday and a legend row, a label column and a column per week
+const (
+	calendarWeeks          = 53
+	calendarRows           = 9
+	calendarColumns        = calendarWeeks + 1
+	calendarLabelColumns   = 3
+	calendarLegendSwatches = 5
+	calendarMinMonthGap    = 3
+)
+
+// NewCalendarHeatmap creates a contributions-style calendar of the 53 weeks ending with the configured end day,
+// with a column per week and a row per weekday. Days are painted by their value relative to the value range,
+// values of the same day are summed. Month and weekday labels and the legend texts are drawn when a font is
+// configured. The image is sized for square cells unless its dimensions are set
+func NewCalendarHeatmap(values map[time.Time]float64, imageConfig ImageConfig, calendarConfigs ...CalendarConfig) (*Gridder, error) {
+	calendarConfig := getFirstCalendarConfig(calendarConfigs...)
+
+	days := make(map[time.Time]float64, len(values))
+	var end time.Time
+	for t, value := range values {
+		err := finite("value", value)
+		if err != nil {
+			return nil, err
+		}
+
+		day := calendarDay(t)
+		days[day] += value
+		if day.After(end) {
+			end = day
+		}
+	}
+
+	if !calendarConfig.End.IsZero() {
+		end = calendarDay(calendarConfig.End)
+	}
+	if end.IsZero() {
+		return nil, fmt.Errorf("%w: calendar without values or end day", errInvalidValue)
+	}
+
+	lastWeek := end.AddDate(0, 0, -int((end.Weekday()-calendarConfig.WeekStart+7)%7))
+	start := lastWeek.AddDate(0, 0, -7*(calendarWeeks-1))
+
+	low, high := math.Inf(1), math.Inf(-1)
+	for day, value := range days {
+		if day.Before(start) || day.After(end) {
+			continue
+		}
+		low, high = math.Min(low, value), math.Max(high, value)
+	}
+	low = math.Min(low, 0)
+
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = (calendarColumns + calendarLabelColumns - 1) * defaultCalendarCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = calendarRows * defaultCalendarCellSize
+	}
+
+	labelOffset := float64(imageConfig.Width) * (calendarLabelColumns - 1) / (calendarColumns + calendarLabelColumns - 1)
+	g, err := New(imageConfig, GridConfig{
+		Rows:               calendarRows,
+		Columns:            calendarColumns,
+		ColumnsWidthOffset: []*ColumnWidthOffset{{Column: 0, Offset: labelOffset}},
+		LineStrokeWidth:    defaultCalendarGap,
+		LineColor:          color.Transparent,
+		BorderColor:        color.Transparent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.SetLocale(calendarConfig.Locale)
+
+	var fontFace font.Face
+	if calendarConfig.Font != nil {
+		fontFace = g.FontFace(calendarConfig.Font, calendarConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		for week := 0; week < calendarWeeks; week++ {
+			for weekday := 0; weekday < 7; weekday++ {
+				day := start.AddDate(0, 0, week*7+weekday)
+				if day.After(end) {
+					break
+				}
+
+				fill := calendarConfig.GetEmptyColor()
+				if value, ok := days[day]; ok {
+					var fraction float64
+					if high > low {
+						fraction = (value - low) / (high - low)
+					}
+					fill = calendarConfig.GetColor(fraction)
+				}
+
+				err := g.paintCell(weekday+1, week+1, fill)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		for i := 0; i < calendarLegendSwatches; i++ {
+			fraction := float64(i) / (calendarLegendSwatches - 1)
+			err := g.paintCell(calendarRows-1, calendarColumns-calendarLegendSwatches-2+i, calendarConfig.GetColor(fraction))
+			if err != nil {
+				return err
+			}
+		}
+
+		if fontFace != nil {
+			g.drawCalendarLabels(start, end, fontFace, calendarConfig)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// drawCalendarLabels draws month names over the weeks where months begin, every other weekday name and the
+// legend texts. Month names too close to the previous one are skipped so that they do not overlap
+func (g *Gridder) drawCalendarLabels(start time.Time, end time.Time, fontFace font.Face, calendarConfig CalendarConfig) {
+	g.ctx.Push()
+	defer g.ctx.Pop()
+	g.ctx.SetFontFace(fontFace)
+	g.ctx.SetColor(calendarConfig.GetTextColor())
+
+	lastLabel := -calendarMinMonthGap
+	for week := 0; week < calendarWeeks; week++ {
+		weekStart := start.AddDate(0, 0, week*7)
+		var month time.Time
+		for weekday := 0; weekday < 7; weekday++ {
+			day := weekStart.AddDate(0, 0, weekday)
+			if day.Day() == 1 && !day.After(end) || week == 0 && weekday == 0 {
+				month = day
+			}
+		}
+		if month.IsZero() || week-lastLabel < calendarMinMonthGap {
+			continue
+		}
+
+		text := FormatDate(g.locale, month, "Jan")
+		g.drawAnchoredString(0, week+1, text, 0, 0.35)
+		lastLabel = week
+	}
+
+	for weekday := 1; weekday < 7; weekday += 2 {
+		day := start.AddDate(0, 0, weekday)
+		g.drawAnchoredString(weekday+1, 0, FormatDate(g.locale, day, "Mon"), 1, 0.35)
+	}
+
+	firstSwatch := calendarColumns - calendarLegendSwatches - 2
+	g.drawAnchoredString(calendarRows-1, firstSwatch-1, calendarConfig.GetLegendLow(), 1, 0.35)
+	g.drawAnchoredString(calendarRows-1, firstSwatch+calendarLegendSwatches, calendarConfig.GetLegendHigh(), 0, 0.35)
+}
+
+// drawAnchoredString draws a text anchored horizontally to a cell, 0 aligns it to the left edge of the cell and 1
+// to its right edge, so that texts wider than their cell can overflow to one side
+func (g *Gridder) drawAnchoredString(row int, column int, text string, ax float64, ay float64) {
+	center := g.getCellCenter(row, column)
+	cellWidth, _ := g.getCellDimensions(row, column)
+	x := center.X + (ax-0.5)*cellWidth
+	g.ctx.DrawStringAnchored(text, x, center.Y, ax, ay)
+	g.addLabel(row, column, text)
+}
+
+// calendarDay truncates a time to its day, keeping the calendar date of its location
+func calendarDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// calendarColormap is the default colormap, values above zero are split into four shades of green
+func calendarColormap(fraction float64) color.Color {
+	if fraction <= 0 {
+		return defaultCalendarEmptyColor
+	}
+	level := minInt(int(math.Ceil(fraction*float64(len(defaultCalendarColors))))-1, len(defaultCalendarColors)-1)
+	return defaultCalendarColors[level]
+}
@@ -0,0 +1,78 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/text/language"
+)
+
+func TestNewCalendarHeatmap(t *testing.T) {
+	zone := time.FixedZone("test", -5*60*60)
+	values := map[time.Time]float64{
+		time.Date(2024, 12, 31, 9, 0, 0, 0, zone):  2,
+		time.Date(2024, 12, 31, 22, 0, 0, 0, zone): 2,
+		time.Date(2023, 12, 31, 0, 0, 0, 0, zone):  1,
+		time.Date(2020, 1, 1, 0, 0, 0, 0, zone):    100,
+	}
+
+	gridder, err := NewCalendarHeatmap(values, ImageConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 672)
+	assert.Equal(t, gridder.ctx.Height(), 108)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(666, 42)), defaultCalendarColors[3])
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(42, 18)), defaultCalendarColors[0])
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(42, 30)), defaultCalendarEmptyColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(666, 54)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(642, 102)), defaultCalendarColors[3])
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(594, 102)), defaultCalendarEmptyColor)
+	assert.Equal(t, gridder.labels, map[Cell][]string(nil))
+}
+
+func TestCalendarHeatmapLabels(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	values := map[time.Time]float64{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC): 1}
+	calendarConfig := CalendarConfig{
+		End:       time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		WeekStart: time.Monday,
+		Colormap:  func(fraction float64) color.Color { return color.Gray{Y: uint8(255 - fraction*255)} },
+		Font:      ttf,
+		Locale:    language.German,
+	}
+
+	gridder, err := NewCalendarHeatmap(values, ImageConfig{Width: 560, Height: 90}, calendarConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"Jan"})
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 9}], []string{"Mär"})
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 0}], []string{"Di"})
+	assert.Equal(t, gridder.labels[Cell{Row: 8, Column: 46}], []string{"Less"})
+	assert.Equal(t, gridder.labels[Cell{Row: 8, Column: 52}], []string{"More"})
+	assert.Equal(t, len(gridder.labels[Cell{Row: 0, Column: 2}]), 0)
+}
+
+func TestCalendarHeatmapErrors(t *testing.T) {
+	_, err := NewCalendarHeatmap(nil, ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = NewCalendarHeatmap(map[time.Time]float64{time.Now(): math.NaN()}, ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	gridder, err := NewCalendarHeatmap(nil, ImageConfig{}, CalendarConfig{End: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	assert.Nil(t, err)
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(666, 30)), defaultCalendarEmptyColor)
+}
+
+func TestCalendarColormap(t *testing.T) {
+	assert.Equal(t, calendarColormap(0), defaultCalendarEmptyColor)
+	assert.Equal(t, calendarColormap(0.1), defaultCalendarColors[0])
+	assert.Equal(t, calendarColormap(0.5), defaultCalendarColors[1])
+	assert.Equal(t, calendarColormap(1), defaultCalendarColors[3])
+}
@@ -0,0 +1,102 @@
+package gridder
+
+import (
+	"image/color"
+)
+
+// Cell identifies a cell by its row and column
+type Cell struct {
+	Row    int
+	Column int
+}
+
+// CellSet is a list of cells in selection order
+type CellSet []Cell
+
+// Where filters the set down to the cells satisfying the predicate
+func (s CellSet) Where(match func(row int, column int) bool) CellSet {
+	var cells CellSet
+	for _, cell := range s {
+		if match(cell.Row, cell.Column) {
+			cells = append(cells, cell)
+		}
+	}
+	return cells
+}
+
+// Cells selects all cells in row-major order, leaving out the cells masked by the cell mask
+func (g *Gridder) Cells() CellSet {
+	rows, columns := g.gridConfig.GetRows(), g.gridConfig.GetColumns()
+	cells := make(CellSet, 0, rows*columns)
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			if g.gridConfig.IsMasked(row, column) {
+				continue
+			}
+			cells = append(cells, Cell{Row: row, Column: column})
+		}
+	}
+	return cells
+}
+
+// Where selects the cells satisfying the predicate, leaving out masked cells
+func (g *Gridder) Where(match func(row int, column int) bool) CellSet {
+	return g.Cells().Where(match)
+}
+
+// EveryNth selects every nth cell in row-major order, starting with the cell at offset
+func (g *Gridder) EveryNth(n int, offset int) CellSet {
+	if n <= 0 {
+		return nil
+	}
+
+	columns := g.gridConfig.GetColumns()
+	return g.Where(func(row int, column int) bool {
+		index := row*columns + column - offset
+		return index >= 0 && index%n == 0
+	})
+}
+
+// Checker selects cells in a checkerboard pattern. The top left cell is selected unless odd is true
+func (g *Gridder) Checker(odd bool) CellSet {
+	return g.Where(func(row int, column int) bool {
+		return ((row+column)%2 == 1) == odd
+	})
+}
+
+// RowRange selects all cells in rows from (inclusive) to (exclusive)
+func (g *Gridder) RowRange(from int, to int) CellSet {
+	return g.Where(func(row int, column int) bool {
+		return row >= from && row < to
+	})
+}
+
+// ColumnRange selects all cells in columns from (inclusive) to (exclusive)
+func (g *Gridder) ColumnRange(from int, to int) CellSet {
+	return g.Where(func(row int, column int) bool {
+		return column >= from && column < to
+	})
+}
+
+// PaintCells paints every cell in the set
+func (g *Gridder) PaintCells(cells CellSet, color color.Color) error {
+	return g.DrawInCells(cells, func(row int, column int) error {
+		return g.PaintCell(row, column, color)
+	})
+}
+
+// DrawInCells calls draw for every cell in the set, stopping at the first error
+func (g *Gridder) DrawInCells(cells CellSet, draw func(row int, column int) error) error {
+	for _, cell := range cells {
+		err := g.verifyInBounds(cell.Row, cell.Column)
+		if err != nil {
+			return err
+		}
+
+		err = draw(cell.Row, cell.Column)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCells(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 3})
+	assert.Nil(t, err)
+
+	cells := gridder.Cells()
+	assert.Equal(t, len(cells), 6)
+	assert.Equal(t, cells[0], Cell{Row: 0, Column: 0})
+	assert.Equal(t, cells[5], Cell{Row: 1, Column: 2})
+}
+
+func TestSelectors(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 3})
+	assert.Nil(t, err)
+
+	assert.Equal(t, gridder.EveryNth(0, 0), CellSet(nil))
+	assert.Equal(t, gridder.EveryNth(2, 1), CellSet{{Row: 0, Column: 1}, {Row: 1, Column: 0}, {Row: 1, Column: 2}})
+	assert.Equal(t, gridder.Checker(false), CellSet{{Row: 0, Column: 0}, {Row: 0, Column: 2}, {Row: 1, Column: 1}})
+	assert.Equal(t, gridder.Checker(true), CellSet{{Row: 0, Column: 1}, {Row: 1, Column: 0}, {Row: 1, Column: 2}})
+	assert.Equal(t, gridder.RowRange(1, 2), CellSet{{Row: 1, Column: 0}, {Row: 1, Column: 1}, {Row: 1, Column: 2}})
+	assert.Equal(t, gridder.ColumnRange(2, 5), CellSet{{Row: 0, Column: 2}, {Row: 1, Column: 2}})
+
+	cells := gridder.RowRange(0, 1).Where(func(row int, column int) bool {
+		return column > 0
+	})
+	assert.Equal(t, cells, CellSet{{Row: 0, Column: 1}, {Row: 0, Column: 2}})
+}
+
+func TestPaintCells(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.PaintCells(CellSet{{Row: 2, Column: 2}}, color.Black)
+	assert.NotNil(t, err)
+
+	err = gridder.PaintCells(gridder.Checker(false), color.Black)
+	assert.Nil(t, err)
+
+	var visited int
+	err = gridder.DrawInCells(gridder.Cells(), func(row int, column int) error {
+		visited++
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, visited, 4)
+}
+
+func TestCellsMasked(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 2, CellMask: [][]bool{{true, false}, {true, true}}})
+	assert.Nil(t, err)
+
+	assert.Equal(t, gridder.Cells(), CellSet{{Row: 0, Column: 0}, {Row: 1, Column: 0}, {Row: 1, Column: 1}})
+	assert.Equal(t, gridder.Checker(true), CellSet{{Row: 1, Column: 0}})
+	assert.Equal(t, gridder.EveryNth(1, 0), gridder.Cells())
+	assert.Nil(t, gridder.PaintCells(gridder.Cells(), color.Black))
+}
@@ -0,0 +1,80 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/fogleman/gg"
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/math/f64"
+)
+
+// DrawImage draws an image in a cell, such as a sprite or an icon, scaled to the cell as the fit sets
+func (g *Gridder) DrawImage(row int, column int, img image.Image, imageDrawConfigs ...ImageDrawConfig) error {
+	return g.retainDescribed("DrawImage", []interface{}{row, column, img, imageDrawConfigs}, func() error {
+		return g.retainCells([]Cell{{Row: row, Column: column}}, func(cells []Cell) error {
+			return g.drawImage(cells[0].Row, cells[0].Column, img, imageDrawConfigs...)
+		})
+	})
+}
+
+func (g *Gridder) drawImage(row int, column int, img image.Image, imageDrawConfigs ...ImageDrawConfig) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	imageDrawConfig := getFirstImageDrawConfig(imageDrawConfigs...)
+	err = imageDrawConfig.validate()
+	if err != nil {
+		return err
+	}
+
+	img = flipImage(img, imageDrawConfig.IsFlipHorizontal(), imageDrawConfig.IsFlipVertical())
+	cellWidth, cellHeight := g.getCellDimensions(row, column)
+	paintCellImage(g.ctx, g.getCellCenter(row, column), img, cellWidth, cellHeight, imageDrawConfig)
+	return nil
+}
+
+// paintCellImage draws an image centered on a point, fitted to a width and height and rotated about the point
+func paintCellImage(ctx *gg.Context, center *gg.Point, img image.Image, width float64, height float64, imageDrawConfig ImageDrawConfig) {
+	src := img.Bounds()
+	if src.Empty() || width <= 0 || height <= 0 {
+		return
+	}
+
+	imageWidth, imageHeight := float64(src.Dx()), float64(src.Dy())
+	switch imageDrawConfig.GetFit() {
+	case FitContain:
+		scale := math.Min(width/imageWidth, height/imageHeight)
+		width, height = imageWidth*scale, imageHeight*scale
+	case FitCover:
+		// crops the source to the aspect ratio of the cell, which is then stretched over the cell
+		scale := math.Max(width/imageWidth, height/imageHeight)
+		cropWidth := int(math.Round(math.Min(imageWidth, width/scale)))
+		cropHeight := int(math.Round(math.Min(imageHeight, height/scale)))
+		x, y := src.Min.X+(src.Dx()-cropWidth)/2, src.Min.Y+(src.Dy()-cropHeight)/2
+		src = image.Rect(x, y, x+maxInt(cropWidth, 1), y+maxInt(cropHeight, 1))
+	}
+
+	// maps the center of the source onto the center point, scaled and rotated
+	scaleX, scaleY := width/float64(src.Dx()), height/float64(src.Dy())
+	sin, cos := math.Sincos(gg.Radians(imageDrawConfig.GetRotate()))
+	a, b := cos*scaleX, -sin*scaleY
+	d, e := sin*scaleX, cos*scaleY
+	srcX, srcY := float64(src.Min.X+src.Max.X)/2, float64(src.Min.Y+src.Max.Y)/2
+	x, y := ctx.TransformPoint(center.X, center.Y)
+	transform := f64.Aff3{a, b, x - a*srcX - b*srcY, d, e, y - d*srcX - e*srcY}
+
+	var interpolator xdraw.Interpolator = xdraw.BiLinear
+	if imageDrawConfig.GetFilter() == ScaleNearest {
+		interpolator = xdraw.NearestNeighbor
+	}
+	var options *xdraw.Options
+	if opacity := imageDrawConfig.GetOpacity(); opacity < 1 {
+		options = &xdraw.Options{SrcMask: image.NewUniform(color.Alpha16{A: uint16(opacity * 0xffff)})}
+	}
+	interpolator.Transform(ctx.Image().(draw.Image), transform, img, src, xdraw.Over, options)
+}
@@ -0,0 +1,88 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawImage(t *testing.T) {
+	red, blue, white := color.RGBA{R: 255, A: 255}, color.RGBA{B: 255, A: 255}, color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	img := image.NewRGBA(image.Rect(0, 0, 10, 20))
+	draw.Draw(img, image.Rect(0, 0, 5, 20), image.NewUniform(red), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(5, 0, 10, 20), image.NewUniform(blue), image.Point{}, draw.Src)
+
+	tests := []struct {
+		config   ImageDrawConfig
+		expected map[image.Point]color.RGBA
+	}{
+		{
+			config:   ImageDrawConfig{},
+			expected: map[image.Point]color.RGBA{{X: 10, Y: 50}: white, {X: 30, Y: 50}: red, {X: 70, Y: 50}: blue, {X: 90, Y: 50}: white},
+		},
+		{
+			config:   ImageDrawConfig{Fit: FitCover},
+			expected: map[image.Point]color.RGBA{{X: 10, Y: 2}: red, {X: 90, Y: 97}: blue},
+		},
+		{
+			config:   ImageDrawConfig{Fit: FitStretch},
+			expected: map[image.Point]color.RGBA{{X: 10, Y: 50}: red, {X: 90, Y: 50}: blue},
+		},
+		{
+			config:   ImageDrawConfig{Fit: FitStretch, Rotate: 180},
+			expected: map[image.Point]color.RGBA{{X: 10, Y: 50}: blue, {X: 90, Y: 50}: red},
+		},
+		{
+			config:   ImageDrawConfig{Fit: FitStretch, FlipHorizontal: true},
+			expected: map[image.Point]color.RGBA{{X: 10, Y: 50}: blue, {X: 90, Y: 50}: red},
+		},
+		{
+			config:   ImageDrawConfig{Fit: FitStretch, FlipHorizontal: true, FlipVertical: true, Rotate: 180},
+			expected: map[image.Point]color.RGBA{{X: 10, Y: 50}: red, {X: 90, Y: 50}: blue},
+		},
+		{
+			config:   ImageDrawConfig{Fit: FitStretch, Opacity: 0.5},
+			expected: map[image.Point]color.RGBA{{X: 10, Y: 50}: {R: 255, G: 128, B: 128, A: 255}},
+		},
+	}
+	for _, test := range tests {
+		gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+		assert.Nil(t, err)
+		assert.Nil(t, gridder.DrawImage(0, 0, img, test.config))
+
+		result := gridder.Image()
+		for point, expected := range test.expected {
+			assert.Equal(t, color.RGBAModel.Convert(result.At(point.X, point.Y)), expected, "%+v at %v", test.config, point)
+		}
+	}
+}
+
+func TestDrawImageNearest(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	img.Set(1, 0, color.RGBA{B: 255, A: 255})
+
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.DrawImage(0, 0, img, ImageDrawConfig{Fit: FitStretch, Filter: ScaleNearest}))
+
+	result := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(result.At(49, 50)), color.RGBA{R: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(result.At(50, 50)), color.RGBA{B: 255, A: 255})
+}
+
+func TestDrawImageErrors(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	assert.ErrorIs(t, gridder.DrawImage(2, 0, img), errOutOfBounds)
+	assert.ErrorIs(t, gridder.DrawImage(0, 0, img, ImageDrawConfig{Opacity: 1.5}), errInvalidValue)
+	assert.ErrorIs(t, gridder.DrawImage(0, 0, img, ImageDrawConfig{Fit: FitStretch + 1}), errInvalidValue)
+	assert.ErrorIs(t, gridder.DrawImage(0, 0, img, ImageDrawConfig{Rotate: math.Inf(1)}), errInvalidValue)
+	assert.Nil(t, gridder.DrawImage(1, 1, image.NewRGBA(image.Rectangle{})))
+}
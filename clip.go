@@ -0,0 +1,65 @@
+package gridder
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/fogleman/gg"
+)
+
+// SetMask limits the rendered image to a region, keeping the pixels by the alpha of the mask at the same position
+// and leaving the rest transparent. The mask covers the image from its top left corner after flipping, and a nil mask
+// removes it
+func (g *Gridder) SetMask(mask image.Image) {
+	if g.parent != nil {
+		g.parent.SetMask(mask)
+		return
+	}
+	g.mask = mask
+}
+
+// applyMask clips an image to the mask
+func (g *Gridder) applyMask(img image.Image) image.Image {
+	if g.mask == nil {
+		return img
+	}
+	bounds := img.Bounds()
+	masked := image.NewRGBA(bounds)
+	draw.DrawMask(masked, bounds, img, bounds.Min, g.mask, g.mask.Bounds().Min, draw.Src)
+	return masked
+}
+
+// CircleMask creates a mask of the largest circle centered in an image of a width and height, such as for avatars
+func CircleMask(width int, height int) image.Image {
+	return shapeMask(width, height, func(ctx *gg.Context) {
+		ctx.DrawCircle(float64(width)/2, float64(height)/2, float64(minInt(width, height))/2)
+	})
+}
+
+// RoundedRectangleMask creates a mask of a whole image of a width and height with corners rounded by a radius, such
+// as for badges
+func RoundedRectangleMask(width int, height int, radius float64) image.Image {
+	return shapeMask(width, height, func(ctx *gg.Context) {
+		ctx.DrawRoundedRectangle(0, 0, float64(width), float64(height), radius)
+	})
+}
+
+// PolygonMask creates a mask of a polygon through points in pixels in an image of a width and height, such as for
+// map insets
+func PolygonMask(width int, height int, points ...image.Point) image.Image {
+	return shapeMask(width, height, func(ctx *gg.Context) {
+		for _, point := range points {
+			ctx.LineTo(float64(point.X), float64(point.Y))
+		}
+		ctx.ClosePath()
+	})
+}
+
+// shapeMask creates a mask of the filled shape traced by trace
+func shapeMask(width int, height int, trace func(ctx *gg.Context)) image.Image {
+	ctx := gg.NewContext(maxInt(width, 1), maxInt(height, 1))
+	trace(ctx)
+	ctx.SetRGB(1, 1, 1)
+	ctx.Fill()
+	return ctx.Image()
+}
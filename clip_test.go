@@ -0,0 +1,39 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMask(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, err)
+
+	gridder.SetMask(CircleMask(100, 100))
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 30)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(2, 2)), color.NRGBA{})
+
+	gridder.SetMask(nil)
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(2, 2)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+}
+
+func TestRoundedRectangleMask(t *testing.T) {
+	mask := RoundedRectangleMask(100, 50, 10)
+	assert.Equal(t, mask.Bounds(), image.Rect(0, 0, 100, 50))
+	_, _, _, a := mask.At(0, 0).RGBA()
+	assert.Equal(t, a, uint32(0))
+	_, _, _, a = mask.At(50, 1).RGBA()
+	assert.Equal(t, a, uint32(0xffff))
+}
+
+func TestPolygonMask(t *testing.T) {
+	mask := PolygonMask(100, 100, image.Pt(0, 0), image.Pt(100, 0), image.Pt(0, 100))
+	_, _, _, a := mask.At(10, 10).RGBA()
+	assert.Equal(t, a, uint32(0xffff))
+	_, _, _, a = mask.At(90, 90).RGBA()
+	assert.Equal(t, a, uint32(0))
+}
@@ -0,0 +1,141 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/fogleman/gg"
+)
+
+// DrawColorbar draws the color scale of a colormap from min to max over the region spanning two cells. Regions at
+// least as tall as wide get a vertical bar with max at the top, others a horizontal bar with max at the right.
+// Tick marks are drawn at the tick values, labeled when a font face is configured or set in the cell style
+func (g *Gridder) DrawColorbar(row1 int, column1 int, row2 int, column2 int, colormap func(fraction float64) color.Color, min float64, max float64, ticks []float64, colorbarConfigs ...ColorbarConfig) error {
+	cells := []Cell{{Row: row1, Column: column1}, {Row: row2, Column: column2}}
+	return g.retainCells(cells, func(cells []Cell) error {
+		return g.drawColorbar(cells[0].Row, cells[0].Column, cells[1].Row, cells[1].Column, colormap, min, max, ticks, colorbarConfigs...)
+	})
+}
+
+func (g *Gridder) drawColorbar(row1 int, column1 int, row2 int, column2 int, colormap func(fraction float64) color.Color, min float64, max float64, ticks []float64, colorbarConfigs ...ColorbarConfig) error {
+	err := g.verifyInBounds(row1, column1)
+	if err != nil {
+		return err
+	}
+
+	err = g.verifyInBounds(row2, column2)
+	if err != nil {
+		return err
+	}
+
+	err = validateValues(finite("min", min), finite("max", max))
+	if err != nil {
+		return err
+	}
+	if max <= min {
+		return fmt.Errorf("%w: colorbar range %v to %v", errInvalidValue, min, max)
+	}
+	for _, tick := range ticks {
+		if math.IsNaN(tick) || tick < min || tick > max {
+			return fmt.Errorf("%w: tick %v outside of %v to %v", errInvalidValue, tick, min, max)
+		}
+	}
+
+	colorbarConfig := getFirstColorbarConfig(colorbarConfigs...)
+	err = colorbarConfig.validate()
+	if err != nil {
+		return err
+	}
+
+	minRow, maxRow := minInt(row1, row2), maxInt(row1, row2)
+	minColumn, maxColumn := minInt(column1, column2), maxInt(column1, column2)
+	topLeft := g.getCellCenter(minRow, minColumn)
+	width, height := g.getCellDimensions(minRow, minColumn)
+	x0, y0 := topLeft.X-width/2, topLeft.Y-height/2
+	bottomRight := g.getCellCenter(maxRow, maxColumn)
+	width, height = g.getCellDimensions(maxRow, maxColumn)
+	x1, y1 := bottomRight.X+width/2, bottomRight.Y+height/2
+
+	fontFace := colorbarConfig.FontFace
+	if fontFace == nil {
+		fontFace = g.getCellStyle(minRow, minColumn).FontFace
+	}
+
+	vertical := y1-y0 >= x1-x0
+	thickness := colorbarConfig.GetThickness()
+	tickLength := colorbarConfig.GetTickLength()
+	numberFormat := colorbarConfig.GetNumberFormat()
+
+	g.ctx.Push()
+	defer g.ctx.Pop()
+	g.ctx.SetColor(colorbarConfig.GetTextColor())
+	g.ctx.SetDash()
+	if fontFace != nil {
+		g.ctx.SetFontFace(fontFace)
+	}
+
+	if vertical {
+		barWidth := (x1 - x0) * thickness
+		barX := (x0 + x1 - barWidth) / 2
+		paintColorbar(g.ctx, barX, y0, barWidth, y1-y0, true, colormap)
+
+		for _, tick := range ticks {
+			fraction := (tick - min) / (max - min)
+			y := y1 - fraction*(y1-y0)
+			g.stroke(1, func() {
+				g.ctx.DrawLine(barX+barWidth, y, barX+barWidth+tickLength, y)
+			})
+			if fontFace == nil {
+				continue
+			}
+
+			text := g.formatNumber(numberFormat, tick)
+			g.ctx.DrawStringAnchored(text, barX+barWidth+tickLength*2, y, 0, fraction)
+			row := minRow + minInt(int((1-fraction)*float64(maxRow-minRow+1)), maxRow-minRow)
+			g.addLabel(row, maxColumn+1, text)
+		}
+		return nil
+	}
+
+	barHeight := (y1 - y0) * thickness
+	barY := (y0 + y1 - barHeight) / 2
+	paintColorbar(g.ctx, x0, barY, x1-x0, barHeight, false, colormap)
+
+	for _, tick := range ticks {
+		fraction := (tick - min) / (max - min)
+		x := x0 + fraction*(x1-x0)
+		g.stroke(1, func() {
+			g.ctx.DrawLine(x, barY+barHeight, x, barY+barHeight+tickLength)
+		})
+		if fontFace == nil {
+			continue
+		}
+
+		text := g.formatNumber(numberFormat, tick)
+		g.ctx.DrawStringAnchored(text, x, barY+barHeight+tickLength*2, fraction, 1)
+		column := minColumn + minInt(int(fraction*float64(maxColumn-minColumn+1)), maxColumn-minColumn)
+		g.addLabel(maxRow+1, column, text)
+	}
+	return nil
+}
+
+// paintColorbar paints a color scale with the top of the colormap at the top of vertical bars and at the right of
+// horizontal ones
+func paintColorbar(ctx *gg.Context, x float64, y float64, width float64, height float64, vertical bool, colormap func(fraction float64) color.Color) {
+	ctx.Push()
+	if vertical {
+		for offset := 0.0; offset < height; offset++ {
+			ctx.DrawRectangle(x, y+offset, width, math.Min(1, height-offset))
+			ctx.SetColor(colormap(1 - offset/height))
+			ctx.Fill()
+		}
+	} else {
+		for offset := 0.0; offset < width; offset++ {
+			ctx.DrawRectangle(x+offset, y, math.Min(1, width-offset), height)
+			ctx.SetColor(colormap(offset / width))
+			ctx.Fill()
+		}
+	}
+	ctx.Pop()
+}
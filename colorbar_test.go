@@ -0,0 +1,69 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestDrawColorbar(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 400, Height: 400}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+
+	ttf, _ := truetype.Parse(goregular.TTF)
+	colormap := LinearColormap(color.Black, color.White)
+	colorbarConfig := ColorbarConfig{FontFace: gridder.FontFace(ttf, 0.2)}
+	err = gridder.DrawColorbar(3, 0, 0, 0, colormap, 0, 10, []float64{0, 5, 10}, colorbarConfig)
+	assert.Nil(t, err)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 200)), colormap(0.5))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(20, 200)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"10"})
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 1}], []string{"5"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 1}], []string{"0"})
+
+	err = gridder.DrawColorbar(2, 1, 2, 3, colormap, -1, 1, []float64{-1, 0.5, 1}, colorbarConfig)
+	assert.Nil(t, err)
+	img = gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(250, 250)), colormap(0.5))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(250, 220)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 1}], []string{"0", "-1"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 3}], []string{"0.5", "1"})
+}
+
+func TestDrawColorbarWithoutFont(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 400, Height: 400}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+
+	err = gridder.DrawColorbar(0, 0, 3, 0, LinearColormap(color.Black, color.White), 0, 1, []float64{0.5}, ColorbarConfig{Thickness: 1, TickLength: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(5, 200)), LinearColormap(color.Black, color.White)(0.5))
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestDrawColorbarErrors(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 400, Height: 400}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+
+	colormap := LinearColormap(color.Black, color.White)
+	err = gridder.DrawColorbar(0, 0, 4, 0, colormap, 0, 1, nil)
+	assert.True(t, errors.Is(err, errOutOfBounds))
+
+	err = gridder.DrawColorbar(0, 0, 3, 0, colormap, 1, 1, nil)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawColorbar(0, 0, 3, 0, colormap, 0, math.Inf(1), nil)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawColorbar(0, 0, 3, 0, colormap, 0, 1, []float64{2})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawColorbar(0, 0, 3, 0, colormap, 0, 1, nil, ColorbarConfig{Thickness: 2})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
@@ -0,0 +1,89 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var namedColors = map[string]color.Color{
+	"black":       color.Black,
+	"white":       color.White,
+	"transparent": color.Transparent,
+	"gray":        color.RGBA{R: 128, G: 128, B: 128, A: 255},
+	"lightgray":   color.RGBA{R: 211, G: 211, B: 211, A: 255},
+	"darkgray":    color.RGBA{R: 169, G: 169, B: 169, A: 255},
+	"red":         color.RGBA{R: 255, A: 255},
+	"green":       color.RGBA{G: 128, A: 255},
+	"lime":        color.RGBA{G: 255, A: 255},
+	"blue":        color.RGBA{B: 255, A: 255},
+	"navy":        color.RGBA{B: 128, A: 255},
+	"yellow":      color.RGBA{R: 255, G: 255, A: 255},
+	"orange":      color.RGBA{R: 255, G: 165, A: 255},
+	"purple":      color.RGBA{R: 128, B: 128, A: 255},
+	"cyan":        color.RGBA{G: 255, B: 255, A: 255},
+	"magenta":     color.RGBA{R: 255, B: 255, A: 255},
+	"brown":       color.RGBA{R: 165, G: 42, B: 42, A: 255},
+	"pink":        color.RGBA{R: 255, G: 192, B: 203, A: 255},
+}
+
+// ParseColor parses a color name or a hex color in the #rgb, #rrggbb or #rrggbbaa forms
+func ParseColor(value string) (color.Color, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if namedColor, ok := namedColors[value]; ok {
+		return namedColor, nil
+	}
+
+	if !strings.HasPrefix(value, "#") {
+		return nil, fmt.Errorf("%w: %q", errInvalidColor, value)
+	}
+
+	hex := value[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	if len(hex) != 8 {
+		return nil, fmt.Errorf("%w: %q", errInvalidColor, value)
+	}
+
+	rgba, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", errInvalidColor, value)
+	}
+	return color.NRGBA{R: uint8(rgba >> 24), G: uint8(rgba >> 16), B: uint8(rgba >> 8), A: uint8(rgba)}, nil
+}
+
+// FormatColor formats a color as a #rrggbbaa hex string
+func FormatColor(c color.Color) string {
+	nrgba := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02x%02x%02x%02x", nrgba.R, nrgba.G, nrgba.B, nrgba.A)
+}
+
+// LinearColormap creates a colormap blending two colors, fractions are clamped between 0 and 1
+func LinearColormap(from color.Color, to color.Color) func(fraction float64) color.Color {
+	a := color.NRGBAModel.Convert(from).(color.NRGBA)
+	b := color.NRGBAModel.Convert(to).(color.NRGBA)
+	return func(fraction float64) color.Color {
+		fraction = math.Max(0, math.Min(1, fraction))
+		blend := func(x uint8, y uint8) uint8 {
+			return uint8(math.Round(float64(x) + (float64(y)-float64(x))*fraction))
+		}
+		return color.NRGBA{R: blend(a.R, b.R), G: blend(a.G, b.G), B: blend(a.B, b.B), A: blend(a.A, b.A)}
+	}
+}
+
+// DivergingColormap creates a colormap blending from the low color to the middle color at 0.5 and on to the high color
+func DivergingColormap(low color.Color, middle color.Color, high color.Color) func(fraction float64) color.Color {
+	lower, upper := LinearColormap(low, middle), LinearColormap(middle, high)
+	return func(fraction float64) color.Color {
+		if fraction < 0.5 {
+			return lower(fraction * 2)
+		}
+		return upper(fraction*2 - 1)
+	}
+}
@@ -0,0 +1,52 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseColor(t *testing.T) {
+	c, err := ParseColor("Red")
+	assert.Nil(t, err)
+	assert.Equal(t, c, color.RGBA{R: 255, A: 255})
+
+	c, err = ParseColor("#f00")
+	assert.Nil(t, err)
+	assert.Equal(t, c, color.NRGBA{R: 255, A: 255})
+
+	c, err = ParseColor("#00ff0080")
+	assert.Nil(t, err)
+	assert.Equal(t, c, color.NRGBA{G: 255, A: 128})
+
+	_, err = ParseColor("#12345")
+	assert.NotNil(t, err)
+
+	_, err = ParseColor("#gggggg")
+	assert.NotNil(t, err)
+
+	_, err = ParseColor("unknown")
+	assert.NotNil(t, err)
+}
+
+func TestFormatColor(t *testing.T) {
+	assert.Equal(t, FormatColor(color.Black), "#000000ff")
+	assert.Equal(t, FormatColor(color.NRGBA{R: 255, G: 128, A: 128}), "#ff800080")
+}
+
+func TestLinearColormap(t *testing.T) {
+	colormap := LinearColormap(color.Black, color.White)
+	assert.Equal(t, colormap(0), color.NRGBA{A: 255})
+	assert.Equal(t, colormap(0.5), color.NRGBA{R: 128, G: 128, B: 128, A: 255})
+	assert.Equal(t, colormap(2), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+}
+
+func TestDivergingColormap(t *testing.T) {
+	colormap := DivergingColormap(color.Black, color.White, color.NRGBA{R: 255, A: 255})
+	assert.Equal(t, colormap(0), color.NRGBA{A: 255})
+	assert.Equal(t, colormap(0.25), color.NRGBA{R: 128, G: 128, B: 128, A: 255})
+	assert.Equal(t, colormap(0.5), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, colormap(0.75), color.NRGBA{R: 255, G: 128, B: 128, A: 255})
+	assert.Equal(t, colormap(1), color.NRGBA{R: 255, A: 255})
+}
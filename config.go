@@ -2,11 +2,19 @@ package gridder
 
 import (
 	"image/color"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/text/language"
 )
 
 const (
 	defaultGridWidth             = 500 * 2
 	defaultGridHeight            = 500
+	defaultImageDPI              = 72.0
 	defaultGridMarginWidth       = 0
 	defaultGridLineStrokeWidth   = 2.0
 	defaultGridBorderStrokeWidth = 4.0
@@ -20,6 +28,143 @@ const (
 	defaultRectangleWidth       = 20.0
 	defaultRectangleHeight      = 20.0
 	defaultRectangleStrokeWidth = 1.0
+
+	defaultContourStrokeWidth = 1.0
+
+	defaultVectorFieldScale       = 0.9
+	defaultVectorFieldHeadSize    = 0.3
+	defaultVectorFieldStrokeWidth = 1.0
+
+	defaultGraphNodeScale       = 0.6
+	defaultGraphEdgeStrokeWidth = 1.0
+
+	defaultNineSliceScale = 1.0
+
+	defaultCalendarCellSize     = 12
+	defaultCalendarGap          = 3.0
+	defaultCalendarFontFraction = 0.8
+	defaultCalendarLegendLow    = "Less"
+	defaultCalendarLegendHigh   = "More"
+
+	defaultMatrixCellSize                     = 60
+	defaultMatrixGap                          = 1.0
+	defaultConfusionMatrixFontFraction        = 0.3
+	defaultConfusionMatrixDiagonalStrokeWidth = 3.0
+
+	defaultSeatingCellSize     = 30
+	defaultSeatingGap          = 4.0
+	defaultSeatingAisleWidth   = 1
+	defaultSeatingFontFraction = 0.35
+
+	defaultBingoRows            = 5
+	defaultBingoCellSize        = 80
+	defaultBingoLineStrokeWidth = 2.0
+	defaultBingoFontFraction    = 0.35
+	defaultBingoFreeText        = "FREE"
+
+	defaultNonogramCellSize             = 30
+	defaultNonogramMajorLineInterval    = 5
+	defaultNonogramLineStrokeWidth      = 1.0
+	defaultNonogramMajorLineStrokeWidth = 3.0
+	defaultNonogramFontFraction         = 0.5
+
+	defaultBattleshipSize         = 10
+	defaultBattleshipCellSize     = 30
+	defaultBattleshipGap          = 2.0
+	defaultBattleshipHullFraction = 0.7
+	defaultBattleshipFontFraction = 0.45
+	defaultBattleshipFleetTitle   = "Fleet"
+	defaultBattleshipTargetTitle  = "Target"
+
+	defaultBarChartCellSize     = 20
+	defaultBarChartGap          = 2.0
+	defaultBarChartUnit         = 1.0
+	defaultBarChartTickInterval = 5
+	defaultBarChartFontFraction = 0.5
+
+	defaultWaffleCells        = 100
+	defaultWaffleCellSize     = 20
+	defaultWaffleGap          = 2.0
+	defaultWaffleFontFraction = 0.5
+
+	defaultTreemapRows         = 10
+	defaultTreemapColumns      = 10
+	defaultTreemapCellSize     = 30
+	defaultTreemapGap          = 2.0
+	defaultTreemapFontFraction = 0.4
+
+	defaultAdjacencyMatrixFontFraction  = 0.25
+	defaultAdjacencyMatrixLabelRotation = 45.0
+
+	defaultTimetableStartTime    = 8 * time.Hour
+	defaultTimetableEndTime      = 18 * time.Hour
+	defaultTimetableSlotDuration = time.Hour
+	defaultTimetableColumnWidth  = 120
+	defaultTimetableRowHeight    = 40
+	defaultTimetableFontFraction = 0.35
+	defaultTimetablePadding      = 4.0
+
+	defaultKeyboardUnitSize     = 60
+	defaultKeyboardDivisions    = 4
+	defaultKeyboardGap          = 4.0
+	defaultKeyboardCornerRadius = 6.0
+	defaultKeyboardFontFraction = 0.3
+
+	defaultLEDMatrixCellSize      = 12
+	defaultLEDMatrixSize          = 0.8
+	defaultLEDMatrixOffBrightness = 0.12
+	defaultLEDMatrixGlow          = 0.6
+
+	defaultFromImageCellSize = 16
+
+	defaultCrossStitchCellSize             = 20
+	defaultCrossStitchSymbols              = "X+O#*/%@=S&V<>^T"
+	defaultCrossStitchMajorLineInterval    = 10
+	defaultCrossStitchLineStrokeWidth      = 1.0
+	defaultCrossStitchMajorLineStrokeWidth = 2.0
+	defaultCrossStitchFontFraction         = 0.7
+
+	defaultRoughness           = 2.0
+	defaultRoughPasses         = 2
+	defaultRoughWidthVariation = 0.3
+
+	defaultJitterWavelength = 24.0
+	defaultJitterStep       = 4.0
+
+	defaultDecorationPadding             = 10.0
+	defaultNorthArrowSize                = 40.0
+	defaultScaleBarSegments              = 4
+	defaultScaleBarHeight                = 6.0
+	defaultInsetLocatorSize              = 0.25
+	defaultInsetLocatorBorderStrokeWidth = 2.0
+
+	defaultBenchmarkIterations = 3
+
+	defaultLabelEllipsis = "…"
+
+	defaultGIFColors = 256
+
+	defaultStringLineSpacing = 1.0
+
+	defaultPathRoutingSpacing = 4.0
+	defaultPathJumpRadius     = 4.0
+
+	defaultEdgeStrokeWidth = 3.0
+	defaultCornerSize      = 6.0
+
+	defaultCellBorderStrokeWidth = 3.0
+
+	defaultPrintPageWidth      = 210.0
+	defaultPrintPageHeight     = 297.0
+	defaultPrintMargin         = 10.0
+	defaultPrintCropMarkLength = 5.0
+	defaultPrintCropMarkWidth  = 0.25
+
+	defaultColorbarThickness  = 0.5
+	defaultColorbarTickLength = 4.0
+
+	defaultCorrelationMatrixFontFraction  = 0.25
+	defaultCorrelationMatrixLabelRotation = 45.0
 )
 
 var (
@@ -27,17 +172,141 @@ var (
 	defaultGridBorderColor     = color.Black
 	defaultGridLineColor       = color.NRGBA{R: 0, G: 0, B: 0, A: 255 / 4}
 
-	defaultStringColor    = color.Gray{}
-	defaultLineColor      = color.Gray{}
-	defaultCircleColor    = color.Gray{}
-	defaultRectangleColor = color.NRGBA{R: 0, G: 0, B: 0, A: 255 / 2}
+	defaultStringColor     = color.Gray{}
+	defaultLineColor       = color.Gray{}
+	defaultCircleColor     = color.Gray{}
+	defaultRectangleColor  = color.NRGBA{R: 0, G: 0, B: 0, A: 255 / 2}
+	defaultContourColor    = color.Black
+	defaultVectorColor     = color.Black
+	defaultGraphNodeColor  = color.NRGBA{R: 200, G: 200, B: 200, A: 255}
+	defaultGraphEdgeColor  = color.Black
+	defaultGraphTextColor  = color.Black
+	defaultGeoJSONColor    = color.NRGBA{R: 200, G: 0, B: 0, A: 255}
+	defaultEdgeColor       = color.Black
+	defaultCornerColor     = color.Black
+	defaultCellBorderColor = color.Black
+
+	defaultCalendarEmptyColor = color.NRGBA{R: 235, G: 237, B: 240, A: 255}
+	defaultCalendarTextColor  = color.NRGBA{R: 87, G: 96, B: 106, A: 255}
+	defaultCalendarColors     = []color.Color{
+		color.NRGBA{R: 155, G: 233, B: 168, A: 255},
+		color.NRGBA{R: 64, G: 196, B: 99, A: 255},
+		color.NRGBA{R: 48, G: 161, B: 78, A: 255},
+		color.NRGBA{R: 33, G: 110, B: 57, A: 255},
+	}
+
+	defaultConfusionMatrixColor         = color.NRGBA{R: 8, G: 48, B: 107, A: 255}
+	defaultConfusionMatrixDiagonalColor = color.NRGBA{R: 255, G: 127, B: 14, A: 255}
+	defaultConfusionMatrixTextColor     = color.Black
+	defaultConfusionMatrixNumberFormat  = NumberFormat{Percent: true}
+
+	defaultSeatingTextColor       = color.Black
+	defaultSeatingBackgroundColor = color.White
+	defaultSeatingStates          = []SeatStateStyle{
+		{Name: "free", Color: color.NRGBA{R: 76, G: 175, B: 80, A: 255}},
+		{Name: "reserved", Color: color.NRGBA{R: 229, G: 57, B: 53, A: 255}},
+		{Name: "blocked", Color: color.NRGBA{R: 158, G: 158, B: 158, A: 255}},
+	}
+
+	defaultBingoRanges          = []BingoRange{{Min: 1, Max: 15}, {Min: 16, Max: 30}, {Min: 31, Max: 45}, {Min: 46, Max: 60}, {Min: 61, Max: 75}}
+	defaultBingoHeader          = []string{"B", "I", "N", "G", "O"}
+	defaultBingoHeaderColor     = color.NRGBA{R: 30, G: 90, B: 170, A: 255}
+	defaultBingoFreeColor       = color.NRGBA{R: 255, G: 224, B: 130, A: 255}
+	defaultBingoTextColor       = color.Black
+	defaultBingoLineColor       = color.Black
+	defaultBingoBackgroundColor = color.White
+
+	defaultNonogramLineColor       = color.NRGBA{R: 160, G: 160, B: 160, A: 255}
+	defaultNonogramMajorLineColor  = color.Black
+	defaultNonogramFillColor       = color.Black
+	defaultNonogramTextColor       = color.Black
+	defaultNonogramBackgroundColor = color.White
+
+	defaultBattleshipWaterColor      = color.NRGBA{R: 160, G: 200, B: 235, A: 255}
+	defaultBattleshipShipColor       = color.NRGBA{R: 90, G: 90, B: 100, A: 255}
+	defaultBattleshipHitColor        = color.NRGBA{R: 220, G: 30, B: 30, A: 255}
+	defaultBattleshipMissColor       = color.White
+	defaultBattleshipTextColor       = color.Black
+	defaultBattleshipBackgroundColor = color.White
+
+	defaultChartColors = []color.Color{
+		color.NRGBA{R: 31, G: 119, B: 180, A: 255},
+		color.NRGBA{R: 255, G: 127, B: 14, A: 255},
+		color.NRGBA{R: 44, G: 160, B: 44, A: 255},
+		color.NRGBA{R: 214, G: 39, B: 40, A: 255},
+		color.NRGBA{R: 148, G: 103, B: 189, A: 255},
+		color.NRGBA{R: 140, G: 86, B: 75, A: 255},
+	}
+
+	defaultBarChartEmptyColor      = color.NRGBA{R: 238, G: 238, B: 238, A: 255}
+	defaultBarChartTextColor       = color.Black
+	defaultBarChartBackgroundColor = color.White
+
+	defaultWaffleTextColor       = color.Black
+	defaultWaffleBackgroundColor = color.White
+
+	defaultTreemapLowColor        = color.NRGBA{R: 198, G: 219, B: 239, A: 255}
+	defaultTreemapHighColor       = color.NRGBA{R: 8, G: 81, B: 156, A: 255}
+	defaultTreemapBackgroundColor = color.White
+
+	defaultAdjacencyMatrixLowColor   = color.NRGBA{R: 158, G: 202, B: 225, A: 255}
+	defaultAdjacencyMatrixHighColor  = color.NRGBA{R: 8, G: 48, B: 107, A: 255}
+	defaultAdjacencyMatrixEmptyColor = color.NRGBA{R: 240, G: 240, B: 240, A: 255}
+	defaultAdjacencyMatrixTextColor  = color.Black
+
+	defaultTimetableDays            = []string{"Mon", "Tue", "Wed", "Thu", "Fri"}
+	defaultTimetableLineColor       = color.NRGBA{R: 220, G: 220, B: 220, A: 255}
+	defaultTimetableTextColor       = color.Black
+	defaultTimetableBackgroundColor = color.White
+
+	defaultKeyboardKeyColor        = color.NRGBA{R: 240, G: 240, B: 240, A: 255}
+	defaultKeyboardBackgroundColor = color.NRGBA{R: 60, G: 60, B: 60, A: 255}
+
+	defaultLEDMatrixOnColor         = color.NRGBA{R: 255, G: 60, B: 30, A: 255}
+	defaultLEDMatrixBackgroundColor = color.Black
+
+	defaultFromImageLineColor = color.Transparent
+
+	defaultCrossStitchLineColor       = color.NRGBA{R: 160, G: 160, B: 160, A: 255}
+	defaultCrossStitchMajorLineColor  = color.Black
+	defaultCrossStitchTextColor       = color.Black
+	defaultCrossStitchBackgroundColor = color.White
+
+	defaultRedactColor = color.Black
+
+	defaultDecorationColor         = color.Black
+	defaultInsetLocatorRegionColor = color.NRGBA{R: 220, G: 20, B: 60, A: 255}
+
+	defaultPrintCropMarkColor = color.Black
+
+	defaultColorbarTextColor    = color.Black
+	defaultColorbarNumberFormat = NumberFormat{Precision: -1}
+
+	defaultCorrelationMatrixLowColor     = color.NRGBA{R: 33, G: 102, B: 172, A: 255}
+	defaultCorrelationMatrixHighColor    = color.NRGBA{R: 178, G: 24, B: 43, A: 255}
+	defaultCorrelationMatrixTextColor    = color.Black
+	defaultCorrelationMatrixNumberFormat = NumberFormat{Precision: 2}
 )
 
 // ImageConfig Grid Configuration
 type ImageConfig struct {
 	Width  int
 	Height int
-	Name   string
+	// Name is the path SavePNG writes to.
+	//
+	// Deprecated: pass the path to Save, so that one grid can be saved to several destinations and formats
+	Name string
+	DPI  float64
+
+	// Deterministic pins pixel formats, encoder settings and geometry rounding so that output is byte-identical
+	// across runs and platforms
+	Deterministic bool
+
+	// Limits bounds the resources a Gridder may use, for services rendering untrusted scenes
+	Limits RenderLimits
+
+	// Rough draws every stroke in a hand drawn style when set, for whiteboard style diagrams
+	Rough *RoughStyle
 }
 
 // GetWidth gets image width
@@ -57,10 +326,110 @@ func (g *ImageConfig) GetHeight() int {
 }
 
 // GetName gets image name
+//
+// Deprecated: pass the path to Save instead of naming the image
 func (g *ImageConfig) GetName() string {
 	return g.Name
 }
 
+// IsDeterministic gets image deterministic flag
+func (g *ImageConfig) IsDeterministic() bool {
+	return g.Deterministic
+}
+
+// GetDPI gets image DPI
+func (g *ImageConfig) GetDPI() float64 {
+	if g.DPI <= 0 {
+		return defaultImageDPI
+	}
+	return g.DPI
+}
+
+// GetLimits gets image render limits
+func (g *ImageConfig) GetLimits() RenderLimits {
+	return g.Limits
+}
+
+// GetRough gets the hand drawn stroke style, nil for plain strokes
+func (g *ImageConfig) GetRough() *RoughStyle {
+	return g.Rough
+}
+
+// RoughStyle draws strokes as several slightly offset passes of varying width. Combined with a grid jitter, it gives
+// grid lines a sketched look
+type RoughStyle struct {
+	// Roughness is the largest offset of a pass in pixels
+	Roughness float64
+	// Passes is the number of passes of every stroke
+	Passes int
+	// WidthVariation is the largest change of the stroke width of a pass, as a fraction of the width
+	WidthVariation float64
+	// Seed picks the offsets and widths, which are the same for the same stroke and seed
+	Seed int64
+}
+
+// GetRoughness gets the largest offset of a pass
+func (g *RoughStyle) GetRoughness() float64 {
+	if g.Roughness <= 0 {
+		return defaultRoughness
+	}
+	return g.Roughness
+}
+
+// GetPasses gets the number of passes of every stroke
+func (g *RoughStyle) GetPasses() int {
+	if g.Passes <= 0 {
+		return defaultRoughPasses
+	}
+	return g.Passes
+}
+
+// GetWidthVariation gets the largest change of the stroke width of a pass
+func (g *RoughStyle) GetWidthVariation() float64 {
+	if g.WidthVariation <= 0 {
+		return defaultRoughWidthVariation
+	}
+	return math.Min(g.WidthVariation, 1)
+}
+
+// GetSeed gets the seed of the offsets and widths
+func (g *RoughStyle) GetSeed() int64 {
+	return g.Seed
+}
+
+// RenderLimits bounds the resources a Gridder may use. Zero values mean no limit
+type RenderLimits struct {
+	// MaxPixels bounds the image width times height
+	MaxPixels int
+	// MaxOperations bounds the retained drawing operations of the grid and its overlay. An operation retains about 40
+	// bytes, and other operations than painted cells 16 bytes per cell and what their drawing closure captures
+	MaxOperations int
+	// MaxTextLength bounds the length in bytes of a drawn string
+	MaxTextLength int
+	// MaxCells bounds the rows times columns of the grid, and of a sheet read with FromXLSX
+	MaxCells int
+}
+
+// GetMaxPixels gets max image pixels
+func (g *RenderLimits) GetMaxPixels() int {
+	return g.MaxPixels
+}
+
+// GetMaxOperations gets max retained operations
+func (g *RenderLimits) GetMaxOperations() int {
+	return g.MaxOperations
+}
+
+// GetMaxTextLength gets max text length
+func (g *RenderLimits) GetMaxTextLength() int {
+	return g.MaxTextLength
+}
+
+// GetMaxCells gets max grid cells
+func (g *RenderLimits) GetMaxCells() int {
+	return g.MaxCells
+}
+
 // GridConfig Grid Configuration
 type GridConfig struct {
 	Rows               int
@@ -75,6 +444,49 @@ type GridConfig struct {
 	LineColor          color.Color
 	BorderColor        color.Color
 	BackgroundColor    color.Color
+	CellStyle          *CellStyle
+	RowStyles          []*RowStyle
+	ColumnStyles       []*ColumnStyle
+	// CellMask marks the valid cells by row and column, drawing into other cells fails. Every cell is valid
+	// without a mask
+	CellMask [][]bool
+	// HideMaskedCells leaves the background, the lines and the border out of masked cells
+	HideMaskedCells bool
+	// Jitter is the largest distance in pixels the lines and the border wobble away from their place, for a hand
+	// drawn look. Cells keep their place, and the same seed wobbles the lines the same way
+	Jitter     float64
+	JitterSeed int64
+	// MinCellSize is the smallest width and height of cells in pixels, creating grids with smaller cells fails
+	MinCellSize float64
+	// ThinCells is the policy for lines and content that do not fit their cells, drawn anyway by default
+	ThinCells ThinCellPolicy
+	// OuterLines is how the lines after the last row and column meet the border
+	OuterLines OuterLineMode
+	// Intersections anchors rectangles, circles, lines, paths and strings at the intersections of the lines instead
+	// of the centers of cells, as the stones of Go boards. Intersections count from 0 to Rows and Columns
+	Intersections bool
+}
+
+// CellStyle default drawing style of cells. Unset fields are inherited from the enclosing level
+type CellStyle struct {
+	Fill        color.Color
+	Color       color.Color
+	StrokeWidth float64
+	Dashes      float64
+	Rotate      float64
+	FontFace    font.Face
+}
+
+// RowStyle overrides the cell style for a row
+type RowStyle struct {
+	Row   int
+	Style CellStyle
+}
+
+// ColumnStyle overrides the cell style for a column
+type ColumnStyle struct {
+	Column int
+	Style  CellStyle
 }
 
 // RowHeightOffset add positive or negative offset in pixels for row height
@@ -109,6 +521,42 @@ func (g GridConfig) ColumnOffset(column int) float64 {
 	return 0
 }
 
+// IsMasked determines if a cell is masked out of the grid
+func (g GridConfig) IsMasked(row int, column int) bool {
+	if g.CellMask == nil || row < 0 || row >= len(g.CellMask) || column < 0 || column >= len(g.CellMask[row]) {
+		return false
+	}
+	return !g.CellMask[row][column]
+}
+
+// GetCellStyle gets the grid-wide cell style
+func (g *GridConfig) GetCellStyle() CellStyle {
+	if g.CellStyle == nil {
+		return CellStyle{}
+	}
+	return *g.CellStyle
+}
+
+// GetRowStyle gets the style override for a row
+func (g *GridConfig) GetRowStyle(row int) CellStyle {
+	for _, v := range g.RowStyles {
+		if v.Row == row {
+			return v.Style
+		}
+	}
+	return CellStyle{}
+}
+
+// GetColumnStyle gets the style override for a column
+func (g *GridConfig) GetColumnStyle(column int) CellStyle {
+	for _, v := range g.ColumnStyles {
+		if v.Column == column {
+			return v.Style
+		}
+	}
+	return CellStyle{}
+}
+
 // GetWidth gets grid width
 func (g *GridConfig) GetWidth(imageWidth int) int {
 	return imageWidth - g.GetMarginWidth()*2
@@ -132,6 +580,16 @@ func (g *GridConfig) GetLineDashes() float64 {
 	return g.LineDashes
 }
 
+// GetJitter gets the largest distance lines wobble away from their place
+func (g *GridConfig) GetJitter() float64 {
+	return g.Jitter
+}
+
+// GetJitterSeed gets the seed of the line wobble
+func (g *GridConfig) GetJitterSeed() int64 {
+	return g.JitterSeed
+}
+
 // GetLineStrokeWidth gets line stroke width
 func (g *GridConfig) GetLineStrokeWidth() float64 {
 	if g.LineStrokeWidth < 0 {
@@ -198,6 +656,9 @@ type PathConfig struct {
 	StrokeWidth float64
 	Dashes      float64
 	Color       color.Color
+	// StartPort and EndPort attach the path to sides of its first and second cell, unset ports attach it to the centers
+	StartPort *Port
+	EndPort   *Port
 }
 
 // GetStrokeWidth gets stroke width
@@ -221,6 +682,16 @@ func (g *PathConfig) GetDashes() float64 {
 	return g.Dashes
 }
 
+// GetStartPort gets the port of the first cell
+func (g *PathConfig) GetStartPort() *Port {
+	return g.StartPort
+}
+
+// GetEndPort gets the port of the second cell
+func (g *PathConfig) GetEndPort() *Port {
+	return g.EndPort
+}
+
 // LineConfig Line Configuration
 type LineConfig struct {
 	Length      float64
@@ -316,6 +787,8 @@ type RectangleConfig struct {
 	Color       color.Color
 	Stroke      bool
 	StrokeWidth float64
+	// Span merges the cells of a number of rows and columns from the cell into the area of the rectangle
+	Span Span
 }
 
 // GetWidth gets width
@@ -365,10 +838,49 @@ func (g *RectangleConfig) GetStrokeWidth() float64 {
 	return g.StrokeWidth
 }
 
+// GetSpan gets span
+func (g *RectangleConfig) GetSpan() Span {
+	return g.Span
+}
+
+// HorizontalAlign is where the lines of a string are placed across their cell
+type HorizontalAlign int
+
+const (
+	// AlignCenter centers lines on the cell
+	AlignCenter HorizontalAlign = iota
+	// AlignLeft places lines at the left side of the cell
+	AlignLeft
+	// AlignRight places lines at the right side of the cell
+	AlignRight
+)
+
+// VerticalAlign is where the lines of a string are placed down their cell
+type VerticalAlign int
+
+const (
+	// AlignMiddle centers lines on the cell
+	AlignMiddle VerticalAlign = iota
+	// AlignTop places lines at the top of the cell
+	AlignTop
+	// AlignBottom places lines at the bottom of the cell
+	AlignBottom
+)
+
 // StringConfig Grid String Configuration
 type StringConfig struct {
 	Rotate float64
 	Color  color.Color
+	// Span merges the cells of a number of rows and columns from the cell into the area of the string
+	Span Span
+	// Wrap breaks lines between words to fit the width of the cell, lines are also broken at new lines
+	Wrap bool
+	// LineSpacing is the distance between the lines of a string as a multiple of the font height
+	LineSpacing     float64
+	HorizontalAlign HorizontalAlign
+	VerticalAlign   VerticalAlign
+	// Padding is the space in pixels kept from the sides of the cell by wrapped and aligned lines
+	Padding float64
 }
 
 // GetRotate gets rotatio
@@ -384,37 +896,2199 @@ func (g *StringConfig) GetColor() color.Color {
 	return g.Color
 }
 
-func getFirstRectangleConfig(configs ...RectangleConfig) RectangleConfig {
-	if len(configs) == 0 {
-		return RectangleConfig{}
+// GetSpan gets span
+func (g *StringConfig) GetSpan() Span {
+	return g.Span
+}
+
+// IsWrap determines if lines are broken to fit the cell
+func (g *StringConfig) IsWrap() bool {
+	return g.Wrap
+}
+
+// GetLineSpacing gets the distance between lines relative to the font height
+func (g *StringConfig) GetLineSpacing() float64 {
+	if g.LineSpacing <= 0 {
+		return defaultStringLineSpacing
 	}
-	return configs[0]
+	return g.LineSpacing
 }
 
-func getFirstCircleConfig(configs ...CircleConfig) CircleConfig {
-	if len(configs) == 0 {
-		return CircleConfig{}
+// GetHorizontalAlign gets horizontal alignment
+func (g *StringConfig) GetHorizontalAlign() HorizontalAlign {
+	return g.HorizontalAlign
+}
+
+// GetVerticalAlign gets vertical alignment
+func (g *StringConfig) GetVerticalAlign() VerticalAlign {
+	return g.VerticalAlign
+}
+
+// GetPadding gets the space kept from the sides of the cell
+func (g *StringConfig) GetPadding() float64 {
+	return g.Padding
+}
+
+// ContourConfig Contour Configuration
+type ContourConfig struct {
+	StrokeWidth float64
+	Dashes      float64
+	Color       color.Color
+}
+
+// GetStrokeWidth gets stroke width
+func (g *ContourConfig) GetStrokeWidth() float64 {
+	if g.StrokeWidth <= 0 {
+		return defaultContourStrokeWidth
 	}
-	return configs[0]
+	return g.StrokeWidth
 }
 
-func getFirstPathConfig(configs ...PathConfig) PathConfig {
-	if len(configs) == 0 {
-		return PathConfig{}
+// GetDashes gets dashes
+func (g *ContourConfig) GetDashes() float64 {
+	return g.Dashes
+}
+
+// GetColor gets color
+func (g *ContourConfig) GetColor() color.Color {
+	if g.Color == nil {
+		return defaultContourColor
 	}
-	return configs[0]
+	return g.Color
 }
 
-func getFirstLineConfig(configs ...LineConfig) LineConfig {
-	if len(configs) == 0 {
-		return LineConfig{}
+// VectorFieldConfig Vector Field Configuration
+type VectorFieldConfig struct {
+	Scale       float64
+	HeadSize    float64
+	StrokeWidth float64
+	Color       color.Color
+	ColorFunc   func(magnitude float64) color.Color
+}
+
+// GetScale gets the arrow length of the largest vector relative to the cell size
+func (g *VectorFieldConfig) GetScale() float64 {
+	if g.Scale <= 0 {
+		return defaultVectorFieldScale
 	}
-	return configs[0]
+	return g.Scale
 }
 
-func getFirstStringConfig(configs ...StringConfig) StringConfig {
-	if len(configs) == 0 {
-		return StringConfig{}
+// GetHeadSize gets the arrow head length relative to the arrow length
+func (g *VectorFieldConfig) GetHeadSize() float64 {
+	if g.HeadSize <= 0 {
+		return defaultVectorFieldHeadSize
+	}
+	return g.HeadSize
+}
+
+// GetStrokeWidth gets stroke width
+func (g *VectorFieldConfig) GetStrokeWidth() float64 {
+	if g.StrokeWidth <= 0 {
+		return defaultVectorFieldStrokeWidth
+	}
+	return g.StrokeWidth
+}
+
+// GetColor gets color for a magnitude normalized between 0 and 1
+func (g *VectorFieldConfig) GetColor(magnitude float64) color.Color {
+	if g.ColorFunc != nil {
+		return g.ColorFunc(magnitude)
+	}
+	if g.Color == nil {
+		return defaultVectorColor
+	}
+	return g.Color
+}
+
+// GeoJSONConfig GeoJSON Configuration
+type GeoJSONConfig struct {
+	// Property names the feature property holding the category of a feature, cells are painted by count when empty
+	Property   string
+	Categories map[string]color.Color
+	Color      color.Color
+	ColorFunc  func(fraction float64) color.Color
+}
+
+// GetColor gets the color of a cell count as a fraction of the largest count, by default the opacity of the color
+// grows with the count
+func (g *GeoJSONConfig) GetColor(fraction float64) color.Color {
+	if g.ColorFunc != nil {
+		return g.ColorFunc(fraction)
+	}
+
+	fill := g.Color
+	if fill == nil {
+		fill = defaultGeoJSONColor
+	}
+	nrgba := color.NRGBAModel.Convert(fill).(color.NRGBA)
+	nrgba.A = uint8(math.Round(float64(nrgba.A) * fraction))
+	return nrgba
+}
+
+// GetCategoryColor gets the color of a category, cells of categories without a color are not painted
+func (g *GeoJSONConfig) GetCategoryColor(category string) color.Color {
+	return g.Categories[category]
+}
+
+// CalendarConfig Calendar Heatmap Configuration
+type CalendarConfig struct {
+	// End is the last day of the calendar, the latest day with a value by default
+	End       time.Time
+	WeekStart time.Weekday
+	// Colormap maps a value relative to the value range, between 0 and 1, to a color
+	Colormap     func(fraction float64) color.Color
+	EmptyColor   color.Color
+	TextColor    color.Color
+	Font         *truetype.Font
+	FontFraction float64
+	Locale       language.Tag
+	LegendLow    string
+	LegendHigh   string
+}
+
+// GetColor gets the color of a value relative to the value range
+func (g *CalendarConfig) GetColor(fraction float64) color.Color {
+	if g.Colormap == nil {
+		return calendarColormap(fraction)
+	}
+	return g.Colormap(fraction)
+}
+
+// GetEmptyColor gets the color of days without a value
+func (g *CalendarConfig) GetEmptyColor() color.Color {
+	if g.EmptyColor == nil {
+		return defaultCalendarEmptyColor
+	}
+	return g.EmptyColor
+}
+
+// GetTextColor gets the color of labels
+func (g *CalendarConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultCalendarTextColor
+	}
+	return g.TextColor
+}
+
+// GetFontFraction gets the label size relative to the cell height
+func (g *CalendarConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultCalendarFontFraction
+	}
+	return g.FontFraction
+}
+
+// GetLegendLow gets the legend text before the lowest color
+func (g *CalendarConfig) GetLegendLow() string {
+	if g.LegendLow == "" {
+		return defaultCalendarLegendLow
+	}
+	return g.LegendLow
+}
+
+// GetLegendHigh gets the legend text after the highest color
+func (g *CalendarConfig) GetLegendHigh() string {
+	if g.LegendHigh == "" {
+		return defaultCalendarLegendHigh
+	}
+	return g.LegendHigh
+}
+
+// Normalization selects how the counts of a confusion matrix are scaled
+type Normalization int
+
+const (
+	// NormalizeNone colors counts relative to the largest count
+	NormalizeNone Normalization = iota
+	// NormalizeRows shows every count as a share of its true class
+	NormalizeRows
+	// NormalizeColumns shows every count as a share of its predicted class
+	NormalizeColumns
+)
+
+// ConfusionMatrixConfig Confusion Matrix Configuration
+type ConfusionMatrixConfig struct {
+	ImageConfig   ImageConfig
+	Normalization Normalization
+	// Colormap maps a count relative to the color scale, between 0 and 1, to a color
+	Colormap func(fraction float64) color.Color
+	// NumberFormat formats normalized shares, counts are always formatted as integers
+	NumberFormat        *NumberFormat
+	EmphasizeDiagonal   bool
+	DiagonalColor       color.Color
+	DiagonalStrokeWidth float64
+	TextColor           color.Color
+	Font                *truetype.Font
+	FontFraction        float64
+}
+
+// GetColor gets the color of a count relative to the color scale
+func (g *ConfusionMatrixConfig) GetColor(fraction float64) color.Color {
+	if g.Colormap == nil {
+		return LinearColormap(color.White, defaultConfusionMatrixColor)(fraction)
+	}
+	return g.Colormap(fraction)
+}
+
+// GetNumberFormat gets the format of normalized shares
+func (g *ConfusionMatrixConfig) GetNumberFormat() NumberFormat {
+	if g.NumberFormat == nil {
+		return defaultConfusionMatrixNumberFormat
+	}
+	return *g.NumberFormat
+}
+
+// GetDiagonalColor gets the outline color of diagonal cells
+func (g *ConfusionMatrixConfig) GetDiagonalColor() color.Color {
+	if g.DiagonalColor == nil {
+		return defaultConfusionMatrixDiagonalColor
+	}
+	return g.DiagonalColor
+}
+
+// GetDiagonalStrokeWidth gets the outline width of diagonal cells
+func (g *ConfusionMatrixConfig) GetDiagonalStrokeWidth() float64 {
+	if g.DiagonalStrokeWidth <= 0 {
+		return defaultConfusionMatrixDiagonalStrokeWidth
+	}
+	return g.DiagonalStrokeWidth
+}
+
+// GetTextColor gets the color of class labels and colorbar ticks
+func (g *ConfusionMatrixConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultConfusionMatrixTextColor
+	}
+	return g.TextColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *ConfusionMatrixConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultConfusionMatrixFontFraction
+	}
+	return g.FontFraction
+}
+
+// SeatStateStyle names a seat state and its color
+type SeatStateStyle struct {
+	Name  string
+	Color color.Color
+}
+
+// SeatingConfig Seating Chart Configuration
+type SeatingConfig struct {
+	// AisleWidth is the number of empty columns between blocks
+	AisleWidth int
+	// States lists the seat states in legend order, the first state is the state of seats without one
+	States          []SeatStateStyle
+	TextColor       color.Color
+	BackgroundColor color.Color
+	Font            *truetype.Font
+	FontFraction    float64
+}
+
+// GetAisleWidth gets the number of empty columns between blocks
+func (g *SeatingConfig) GetAisleWidth() int {
+	if g.AisleWidth <= 0 {
+		return defaultSeatingAisleWidth
+	}
+	return g.AisleWidth
+}
+
+// GetStates gets seat states, free, reserved and blocked by default
+func (g *SeatingConfig) GetStates() []SeatStateStyle {
+	if len(g.States) == 0 {
+		return defaultSeatingStates
+	}
+	return g.States
+}
+
+// GetTextColor gets the color of block names, row letters and the legend
+func (g *SeatingConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultSeatingTextColor
+	}
+	return g.TextColor
+}
+
+// GetBackgroundColor gets background color
+func (g *SeatingConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultSeatingBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *SeatingConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultSeatingFontFraction
+	}
+	return g.FontFraction
+}
+
+// BingoConfig Bingo Card Generation Configuration
+type BingoConfig struct {
+	// Ranges holds the range of numbers of every column, from 1-15 to 61-75 over 5 columns by default
+	Ranges     []BingoRange
+	Rows       int
+	FreeCenter bool
+	// Rand is the source of randomness, seed it for reproducible cards. Cards are seeded by time when it is nil
+	Rand *rand.Rand
+}
+
+// GetRanges gets the range of numbers of every column
+func (g *BingoConfig) GetRanges() []BingoRange {
+	if len(g.Ranges) == 0 {
+		return defaultBingoRanges
+	}
+	return g.Ranges
+}
+
+// GetRows gets the number of rows
+func (g *BingoConfig) GetRows() int {
+	if g.Rows <= 0 {
+		return defaultBingoRows
+	}
+	return g.Rows
+}
+
+// BingoCardConfig Bingo Card Rendering Configuration
+type BingoCardConfig struct {
+	Header          []string
+	FreeText        string
+	HeaderColor     color.Color
+	FreeColor       color.Color
+	TextColor       color.Color
+	LineColor       color.Color
+	BackgroundColor color.Color
+	Font            *truetype.Font
+	FontFraction    float64
+}
+
+// GetFreeText gets the text of the free space
+func (g *BingoCardConfig) GetFreeText() string {
+	if g.FreeText == "" {
+		return defaultBingoFreeText
+	}
+	return g.FreeText
+}
+
+// GetHeaderColor gets the background color of the header
+func (g *BingoCardConfig) GetHeaderColor() color.Color {
+	if g.HeaderColor == nil {
+		return defaultBingoHeaderColor
+	}
+	return g.HeaderColor
+}
+
+// GetFreeColor gets the background color of the free space
+func (g *BingoCardConfig) GetFreeColor() color.Color {
+	if g.FreeColor == nil {
+		return defaultBingoFreeColor
+	}
+	return g.FreeColor
+}
+
+// GetTextColor gets the color of numbers
+func (g *BingoCardConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultBingoTextColor
+	}
+	return g.TextColor
+}
+
+// GetLineColor gets the color of grid lines
+func (g *BingoCardConfig) GetLineColor() color.Color {
+	if g.LineColor == nil {
+		return defaultBingoLineColor
+	}
+	return g.LineColor
+}
+
+// GetBackgroundColor gets background color
+func (g *BingoCardConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultBingoBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *BingoCardConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultBingoFontFraction
+	}
+	return g.FontFraction
+}
+
+// NonogramConfig Nonogram Configuration
+type NonogramConfig struct {
+	ImageConfig ImageConfig
+	// ShowSolution fills in the cells of the solution
+	ShowSolution         bool
+	MajorLineInterval    int
+	LineStrokeWidth      float64
+	MajorLineStrokeWidth float64
+	LineColor            color.Color
+	MajorLineColor       color.Color
+	FillColor            color.Color
+	TextColor            color.Color
+	BackgroundColor      color.Color
+	Font                 *truetype.Font
+	FontFraction         float64
+}
+
+// GetMajorLineInterval gets the number of cells between major lines
+func (g *NonogramConfig) GetMajorLineInterval() int {
+	if g.MajorLineInterval <= 0 {
+		return defaultNonogramMajorLineInterval
+	}
+	return g.MajorLineInterval
+}
+
+// GetLineStrokeWidth gets line stroke width
+func (g *NonogramConfig) GetLineStrokeWidth() float64 {
+	if g.LineStrokeWidth <= 0 {
+		return defaultNonogramLineStrokeWidth
+	}
+	return g.LineStrokeWidth
+}
+
+// GetMajorLineStrokeWidth gets major line stroke width
+func (g *NonogramConfig) GetMajorLineStrokeWidth() float64 {
+	if g.MajorLineStrokeWidth <= 0 {
+		return defaultNonogramMajorLineStrokeWidth
+	}
+	return g.MajorLineStrokeWidth
+}
+
+// GetLineColor gets line color
+func (g *NonogramConfig) GetLineColor() color.Color {
+	if g.LineColor == nil {
+		return defaultNonogramLineColor
+	}
+	return g.LineColor
+}
+
+// GetMajorLineColor gets major line color
+func (g *NonogramConfig) GetMajorLineColor() color.Color {
+	if g.MajorLineColor == nil {
+		return defaultNonogramMajorLineColor
+	}
+	return g.MajorLineColor
+}
+
+// GetFillColor gets the color of the cells of the solution
+func (g *NonogramConfig) GetFillColor() color.Color {
+	if g.FillColor == nil {
+		return defaultNonogramFillColor
+	}
+	return g.FillColor
+}
+
+// GetTextColor gets the color of clues
+func (g *NonogramConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultNonogramTextColor
+	}
+	return g.TextColor
+}
+
+// GetBackgroundColor gets background color
+func (g *NonogramConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultNonogramBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *NonogramConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultNonogramFontFraction
+	}
+	return g.FontFraction
+}
+
+// BattleshipConfig Battleship Board Configuration
+type BattleshipConfig struct {
+	// Size is the number of rows and columns of each board
+	Size            int
+	FleetTitle      string
+	TargetTitle     string
+	WaterColor      color.Color
+	ShipColor       color.Color
+	HitColor        color.Color
+	MissColor       color.Color
+	TextColor       color.Color
+	BackgroundColor color.Color
+	Font            *truetype.Font
+	FontFraction    float64
+}
+
+// GetSize gets the number of rows and columns of each board
+func (g *BattleshipConfig) GetSize() int {
+	if g.Size <= 0 {
+		return defaultBattleshipSize
+	}
+	return g.Size
+}
+
+// GetFleetTitle gets the title of the fleet board
+func (g *BattleshipConfig) GetFleetTitle() string {
+	if g.FleetTitle == "" {
+		return defaultBattleshipFleetTitle
+	}
+	return g.FleetTitle
+}
+
+// GetTargetTitle gets the title of the tracking board
+func (g *BattleshipConfig) GetTargetTitle() string {
+	if g.TargetTitle == "" {
+		return defaultBattleshipTargetTitle
+	}
+	return g.TargetTitle
+}
+
+// GetWaterColor gets the color of board cells
+func (g *BattleshipConfig) GetWaterColor() color.Color {
+	if g.WaterColor == nil {
+		return defaultBattleshipWaterColor
+	}
+	return g.WaterColor
+}
+
+// GetShipColor gets ship color
+func (g *BattleshipConfig) GetShipColor() color.Color {
+	if g.ShipColor == nil {
+		return defaultBattleshipShipColor
+	}
+	return g.ShipColor
+}
+
+// GetHitColor gets the color of hit markers
+func (g *BattleshipConfig) GetHitColor() color.Color {
+	if g.HitColor == nil {
+		return defaultBattleshipHitColor
+	}
+	return g.HitColor
+}
+
+// GetMissColor gets the color of miss markers
+func (g *BattleshipConfig) GetMissColor() color.Color {
+	if g.MissColor == nil {
+		return defaultBattleshipMissColor
+	}
+	return g.MissColor
+}
+
+// GetTextColor gets the color of titles and coordinates
+func (g *BattleshipConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultBattleshipTextColor
+	}
+	return g.TextColor
+}
+
+// GetBackgroundColor gets background color
+func (g *BattleshipConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultBattleshipBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *BattleshipConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultBattleshipFontFraction
+	}
+	return g.FontFraction
+}
+
+// BarChartConfig Bar Chart Configuration
+type BarChartConfig struct {
+	// Unit is the value of a cell, values are rounded to whole cells
+	Unit float64
+	// TickInterval is the number of cells between the value labels of the axis
+	TickInterval    int
+	EmptyColor      color.Color
+	TextColor       color.Color
+	BackgroundColor color.Color
+	Font            *truetype.Font
+	FontFraction    float64
+}
+
+// GetUnit gets the value of a cell
+func (g *BarChartConfig) GetUnit() float64 {
+	if g.Unit <= 0 {
+		return defaultBarChartUnit
+	}
+	return g.Unit
+}
+
+// GetTickInterval gets the number of cells between axis labels
+func (g *BarChartConfig) GetTickInterval() int {
+	if g.TickInterval <= 0 {
+		return defaultBarChartTickInterval
+	}
+	return g.TickInterval
+}
+
+// GetEmptyColor gets the color of the cells above the bars
+func (g *BarChartConfig) GetEmptyColor() color.Color {
+	if g.EmptyColor == nil {
+		return defaultBarChartEmptyColor
+	}
+	return g.EmptyColor
+}
+
+// GetTextColor gets the color of labels and the legend
+func (g *BarChartConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultBarChartTextColor
+	}
+	return g.TextColor
+}
+
+// GetBackgroundColor gets background color
+func (g *BarChartConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultBarChartBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *BarChartConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultBarChartFontFraction
+	}
+	return g.FontFraction
+}
+
+// WaffleConfig Waffle Chart Configuration
+type WaffleConfig struct {
+	ImageConfig ImageConfig
+	// Cells is the number of cells shared out between the parts
+	Cells           int
+	TextColor       color.Color
+	BackgroundColor color.Color
+	Font            *truetype.Font
+	FontFraction    float64
+}
+
+// GetCells gets the number of cells shared out between the parts
+func (g *WaffleConfig) GetCells() int {
+	if g.Cells <= 0 {
+		return defaultWaffleCells
+	}
+	return g.Cells
+}
+
+// GetTextColor gets the color of the legend
+func (g *WaffleConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultWaffleTextColor
+	}
+	return g.TextColor
+}
+
+// GetBackgroundColor gets background color
+func (g *WaffleConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultWaffleBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *WaffleConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultWaffleFontFraction
+	}
+	return g.FontFraction
+}
+
+// TreemapConfig Treemap Configuration
+type TreemapConfig struct {
+	ImageConfig ImageConfig
+	// Rows and Columns are the dimensions of the grid the blocks are laid out on
+	Rows    int
+	Columns int
+	// Colormap maps a value relative to the value range of the items, between 0 and 1, to a color
+	Colormap        func(fraction float64) color.Color
+	BackgroundColor color.Color
+	Font            *truetype.Font
+	FontFraction    float64
+}
+
+// GetRows gets the rows of the grid
+func (g *TreemapConfig) GetRows() int {
+	if g.Rows <= 0 {
+		return defaultTreemapRows
+	}
+	return g.Rows
+}
+
+// GetColumns gets the columns of the grid
+func (g *TreemapConfig) GetColumns() int {
+	if g.Columns <= 0 {
+		return defaultTreemapColumns
+	}
+	return g.Columns
+}
+
+// GetColor gets the color of a value relative to the value range
+func (g *TreemapConfig) GetColor(fraction float64) color.Color {
+	if g.Colormap == nil {
+		return LinearColormap(defaultTreemapLowColor, defaultTreemapHighColor)(fraction)
+	}
+	return g.Colormap(fraction)
+}
+
+// GetBackgroundColor gets background color
+func (g *TreemapConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultTreemapBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *TreemapConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultTreemapFontFraction
+	}
+	return g.FontFraction
+}
+
+// MatrixOrder selects the order of the nodes of a matrix
+type MatrixOrder int
+
+const (
+	// OrderInput keeps the nodes in the order they are given
+	OrderInput MatrixOrder = iota
+	// OrderCluster moves connected nodes next to each other, so that clusters form blocks along the diagonal
+	OrderCluster
+)
+
+// AdjacencyMatrixConfig Adjacency Matrix Configuration
+type AdjacencyMatrixConfig struct {
+	ImageConfig ImageConfig
+	Order       MatrixOrder
+	// Colormap maps an edge weight relative to the largest weight, between 0 and 1, to a color
+	Colormap   func(fraction float64) color.Color
+	EmptyColor color.Color
+	// LabelRotation is the counterclockwise rotation of column labels in degrees
+	LabelRotation float64
+	TextColor     color.Color
+	Font          *truetype.Font
+	FontFraction  float64
+}
+
+// GetColor gets the color of an edge weight relative to the largest weight
+func (g *AdjacencyMatrixConfig) GetColor(fraction float64) color.Color {
+	if g.Colormap == nil {
+		return LinearColormap(defaultAdjacencyMatrixLowColor, defaultAdjacencyMatrixHighColor)(fraction)
+	}
+	return g.Colormap(fraction)
+}
+
+// GetEmptyColor gets the color of cells without an edge
+func (g *AdjacencyMatrixConfig) GetEmptyColor() color.Color {
+	if g.EmptyColor == nil {
+		return defaultAdjacencyMatrixEmptyColor
+	}
+	return g.EmptyColor
+}
+
+// GetLabelRotation gets the rotation of column labels in degrees
+func (g *AdjacencyMatrixConfig) GetLabelRotation() float64 {
+	if g.LabelRotation <= 0 {
+		return defaultAdjacencyMatrixLabelRotation
+	}
+	return g.LabelRotation
+}
+
+// GetTextColor gets the color of labels and colorbar ticks
+func (g *AdjacencyMatrixConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultAdjacencyMatrixTextColor
+	}
+	return g.TextColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *AdjacencyMatrixConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultAdjacencyMatrixFontFraction
+	}
+	return g.FontFraction
+}
+
+// TimetableConfig Timetable Configuration
+type TimetableConfig struct {
+	// Days are the names of the day columns, Monday to Friday by default
+	Days []string
+	// StartTime and EndTime bound the day, as times since midnight. The day runs from 8:00 to 18:00 by default,
+	// and from midnight when only EndTime is set
+	StartTime time.Duration
+	EndTime   time.Duration
+	// SlotDuration is the duration of a row
+	SlotDuration    time.Duration
+	LineColor       color.Color
+	TextColor       color.Color
+	BackgroundColor color.Color
+	Font            *truetype.Font
+	FontFraction    float64
+}
+
+// GetDays gets the names of the day columns
+func (g *TimetableConfig) GetDays() []string {
+	if len(g.Days) == 0 {
+		return defaultTimetableDays
+	}
+	return g.Days
+}
+
+// GetStartTime gets the start of the day
+func (g *TimetableConfig) GetStartTime() time.Duration {
+	if g.StartTime <= 0 && g.EndTime <= 0 {
+		return defaultTimetableStartTime
+	}
+	return g.StartTime
+}
+
+// GetEndTime gets the end of the day
+func (g *TimetableConfig) GetEndTime() time.Duration {
+	if g.EndTime <= 0 {
+		return defaultTimetableEndTime
+	}
+	return g.EndTime
+}
+
+// GetSlotDuration gets the duration of a row
+func (g *TimetableConfig) GetSlotDuration() time.Duration {
+	if g.SlotDuration <= 0 {
+		return defaultTimetableSlotDuration
+	}
+	return g.SlotDuration
+}
+
+// GetLineColor gets the color of the slot lines
+func (g *TimetableConfig) GetLineColor() color.Color {
+	if g.LineColor == nil {
+		return defaultTimetableLineColor
+	}
+	return g.LineColor
+}
+
+// GetTextColor gets the color of the day names and times
+func (g *TimetableConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultTimetableTextColor
+	}
+	return g.TextColor
+}
+
+// GetBackgroundColor gets background color
+func (g *TimetableConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultTimetableBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *TimetableConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultTimetableFontFraction
+	}
+	return g.FontFraction
+}
+
+// KeyboardConfig Keyboard Layout Configuration
+type KeyboardConfig struct {
+	// KeyColor is the color of keys without a color of their own
+	KeyColor        color.Color
+	BackgroundColor color.Color
+	// CornerRadius is the radius of the keycap corners in pixels
+	CornerRadius float64
+	Font         *truetype.Font
+	FontFraction float64
+}
+
+// GetKeyColor gets the color of keys
+func (g *KeyboardConfig) GetKeyColor() color.Color {
+	if g.KeyColor == nil {
+		return defaultKeyboardKeyColor
+	}
+	return g.KeyColor
+}
+
+// GetBackgroundColor gets background color
+func (g *KeyboardConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultKeyboardBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetCornerRadius gets the radius of the keycap corners
+func (g *KeyboardConfig) GetCornerRadius() float64 {
+	if g.CornerRadius <= 0 {
+		return defaultKeyboardCornerRadius
+	}
+	return g.CornerRadius
+}
+
+// GetFontFraction gets the legend size relative to the cell height
+func (g *KeyboardConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultKeyboardFontFraction
+	}
+	return g.FontFraction
+}
+
+// LEDMatrixConfig LED Matrix Configuration
+type LEDMatrixConfig struct {
+	ImageConfig ImageConfig
+	OnColor     color.Color
+	// OffBrightness is the brightness of unlit LEDs as a fraction of the on color, negative for dark LEDs
+	OffBrightness float64
+	// Glow is the width of the glow around lit LEDs relative to their radius, negative for no glow
+	Glow float64
+	// Size is the diameter of the LEDs relative to the cell size
+	Size            float64
+	BackgroundColor color.Color
+}
+
+// GetOnColor gets the color of lit LEDs
+func (g *LEDMatrixConfig) GetOnColor() color.Color {
+	if g.OnColor == nil {
+		return defaultLEDMatrixOnColor
+	}
+	return g.OnColor
+}
+
+// GetOffBrightness gets the brightness of unlit LEDs
+func (g *LEDMatrixConfig) GetOffBrightness() float64 {
+	if g.OffBrightness < 0 {
+		return 0
+	}
+	if g.OffBrightness == 0 {
+		return defaultLEDMatrixOffBrightness
+	}
+	return math.Min(g.OffBrightness, 1)
+}
+
+// GetGlow gets the width of the glow around lit LEDs
+func (g *LEDMatrixConfig) GetGlow() float64 {
+	if g.Glow < 0 {
+		return 0
+	}
+	if g.Glow == 0 {
+		return defaultLEDMatrixGlow
+	}
+	return g.Glow
+}
+
+// GetSize gets the diameter of the LEDs relative to the cell size
+func (g *LEDMatrixConfig) GetSize() float64 {
+	if g.Size <= 0 {
+		return defaultLEDMatrixSize
+	}
+	return math.Min(g.Size, 1)
+}
+
+// GetBackgroundColor gets background color
+func (g *LEDMatrixConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultLEDMatrixBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// CellSampling is how the color of a cell is taken from the pixels of an image it covers
+type CellSampling int
+
+const (
+	// SampleAverage takes the mean color of the pixels, suited to photos
+	SampleAverage CellSampling = iota
+	// SampleDominant takes the most frequent color of the pixels, which keeps the flat colors of pixel art and logos
+	SampleDominant
+)
+
+// FromImageConfig Image Import Configuration
+type FromImageConfig struct {
+	ImageConfig ImageConfig
+	Sampling    CellSampling
+	// Palette restricts cells to the closest of its colors when set
+	Palette         []color.Color
+	LineColor       color.Color
+	LineStrokeWidth float64
+}
+
+// GetSampling gets how the color of a cell is taken from the image
+func (g *FromImageConfig) GetSampling() CellSampling {
+	return g.Sampling
+}
+
+// GetPalette gets the colors cells are restricted to, nil for any color
+func (g *FromImageConfig) GetPalette() color.Palette {
+	if len(g.Palette) == 0 {
+		return nil
+	}
+	return g.Palette
+}
+
+// GetLineColor gets line color
+func (g *FromImageConfig) GetLineColor() color.Color {
+	if g.LineColor == nil {
+		return defaultFromImageLineColor
+	}
+	return g.LineColor
+}
+
+// GetLineStrokeWidth gets line stroke width
+func (g *FromImageConfig) GetLineStrokeWidth() float64 {
+	if g.LineStrokeWidth < 0 {
+		return 0
+	}
+	return g.LineStrokeWidth
+}
+
+// CrossStitchConfig Cross-Stitch Pattern Configuration
+type CrossStitchConfig struct {
+	ImageConfig ImageConfig
+	Sampling    CellSampling
+	// Threads are the available thread or yarn colors, the colors of the image when empty
+	Threads []Thread
+	// Symbols are the characters marking the threads, one per thread used in the pattern
+	Symbols              string
+	MajorLineInterval    int
+	LineColor            color.Color
+	LineStrokeWidth      float64
+	MajorLineColor       color.Color
+	MajorLineStrokeWidth float64
+	TextColor            color.Color
+	BackgroundColor      color.Color
+	Font                 *truetype.Font
+	FontFraction         float64
+}
+
+// GetSampling gets how the color of a cell is taken from the image
+func (g *CrossStitchConfig) GetSampling() CellSampling {
+	return g.Sampling
+}
+
+// GetSymbols gets the characters marking the threads
+func (g *CrossStitchConfig) GetSymbols() []rune {
+	if g.Symbols == "" {
+		return []rune(defaultCrossStitchSymbols)
+	}
+	return []rune(g.Symbols)
+}
+
+// GetMajorLineInterval gets the number of cells between major lines
+func (g *CrossStitchConfig) GetMajorLineInterval() int {
+	if g.MajorLineInterval <= 0 {
+		return defaultCrossStitchMajorLineInterval
+	}
+	return g.MajorLineInterval
+}
+
+// GetLineColor gets line color
+func (g *CrossStitchConfig) GetLineColor() color.Color {
+	if g.LineColor == nil {
+		return defaultCrossStitchLineColor
+	}
+	return g.LineColor
+}
+
+// GetLineStrokeWidth gets line stroke width
+func (g *CrossStitchConfig) GetLineStrokeWidth() float64 {
+	if g.LineStrokeWidth <= 0 {
+		return defaultCrossStitchLineStrokeWidth
+	}
+	return g.LineStrokeWidth
+}
+
+// GetMajorLineColor gets major line color
+func (g *CrossStitchConfig) GetMajorLineColor() color.Color {
+	if g.MajorLineColor == nil {
+		return defaultCrossStitchMajorLineColor
+	}
+	return g.MajorLineColor
+}
+
+// GetMajorLineStrokeWidth gets major line stroke width
+func (g *CrossStitchConfig) GetMajorLineStrokeWidth() float64 {
+	if g.MajorLineStrokeWidth <= 0 {
+		return defaultCrossStitchMajorLineStrokeWidth
+	}
+	return g.MajorLineStrokeWidth
+}
+
+// GetTextColor gets the color of the legend
+func (g *CrossStitchConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultCrossStitchTextColor
+	}
+	return g.TextColor
+}
+
+// GetBackgroundColor gets background color
+func (g *CrossStitchConfig) GetBackgroundColor() color.Color {
+	if g.BackgroundColor == nil {
+		return defaultCrossStitchBackgroundColor
+	}
+	return g.BackgroundColor
+}
+
+// GetFontFraction gets the symbol size relative to the cell height
+func (g *CrossStitchConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultCrossStitchFontFraction
+	}
+	return g.FontFraction
+}
+
+// Corner is a corner of the grid where a decoration is placed, or a corner of a cell
+type Corner int
+
+const (
+	// CornerTopLeft places a decoration at the top left of the grid
+	CornerTopLeft Corner = iota
+	// CornerTopRight places a decoration at the top right of the grid
+	CornerTopRight
+	// CornerBottomLeft places a decoration at the bottom left of the grid
+	CornerBottomLeft
+	// CornerBottomRight places a decoration at the bottom right of the grid
+	CornerBottomRight
+)
+
+// NorthArrowConfig North Arrow Configuration
+type NorthArrowConfig struct {
+	Corner Corner
+	// Padding is the distance from the sides of the grid in pixels
+	Padding float64
+	// Size is the length of the arrow in pixels
+	Size float64
+	// Angle is the direction of north in degrees clockwise from the top of the image
+	Angle float64
+	Color color.Color
+	// FontFace draws an N beyond the tip of the arrow when it is set
+	FontFace font.Face
+}
+
+// GetPadding gets the distance from the sides of the grid
+func (g *NorthArrowConfig) GetPadding() float64 {
+	if g.Padding <= 0 {
+		return defaultDecorationPadding
+	}
+	return g.Padding
+}
+
+// GetSize gets the length of the arrow
+func (g *NorthArrowConfig) GetSize() float64 {
+	if g.Size <= 0 {
+		return defaultNorthArrowSize
+	}
+	return g.Size
+}
+
+// GetColor gets the color of the arrow and its label
+func (g *NorthArrowConfig) GetColor() color.Color {
+	if g.Color == nil {
+		return defaultDecorationColor
+	}
+	return g.Color
+}
+
+// ScaleBarConfig Scale Bar Configuration
+type ScaleBarConfig struct {
+	Corner Corner
+	// Padding is the distance from the sides of the grid in pixels
+	Padding float64
+	// Segments is the number of alternately filled segments of the bar
+	Segments int
+	// Height is the height of the bar in pixels
+	Height float64
+	Color  color.Color
+	// FontFace labels the ends of the bar with 0 and the distance when it is set
+	FontFace font.Face
+}
+
+// GetPadding gets the distance from the sides of the grid
+func (g *ScaleBarConfig) GetPadding() float64 {
+	if g.Padding <= 0 {
+		return defaultDecorationPadding
+	}
+	return g.Padding
+}
+
+// GetSegments gets the number of segments of the bar
+func (g *ScaleBarConfig) GetSegments() int {
+	if g.Segments <= 0 {
+		return defaultScaleBarSegments
+	}
+	return g.Segments
+}
+
+// GetHeight gets the height of the bar
+func (g *ScaleBarConfig) GetHeight() float64 {
+	if g.Height <= 0 {
+		return defaultScaleBarHeight
+	}
+	return g.Height
+}
+
+// GetColor gets the color of the bar and its labels
+func (g *ScaleBarConfig) GetColor() color.Color {
+	if g.Color == nil {
+		return defaultDecorationColor
+	}
+	return g.Color
+}
+
+// InsetLocatorConfig Inset Locator Configuration
+type InsetLocatorConfig struct {
+	Corner Corner
+	// Padding is the distance from the sides of the grid in pixels
+	Padding float64
+	// Size is the width of the inset relative to the grid width
+	Size              float64
+	BorderColor       color.Color
+	BorderStrokeWidth float64
+	// RegionColor is the color of the box marking the region shown by the grid
+	RegionColor color.Color
+}
+
+// GetPadding gets the distance from the sides of the grid
+func (g *InsetLocatorConfig) GetPadding() float64 {
+	if g.Padding <= 0 {
+		return defaultDecorationPadding
+	}
+	return g.Padding
+}
+
+// GetSize gets the width of the inset relative to the grid width
+func (g *InsetLocatorConfig) GetSize() float64 {
+	if g.Size <= 0 {
+		return defaultInsetLocatorSize
+	}
+	return math.Min(g.Size, 1)
+}
+
+// GetBorderColor gets the color of the inset border
+func (g *InsetLocatorConfig) GetBorderColor() color.Color {
+	if g.BorderColor == nil {
+		return defaultDecorationColor
+	}
+	return g.BorderColor
+}
+
+// GetBorderStrokeWidth gets the width of the inset border and the region box
+func (g *InsetLocatorConfig) GetBorderStrokeWidth() float64 {
+	if g.BorderStrokeWidth <= 0 {
+		return defaultInsetLocatorBorderStrokeWidth
+	}
+	return g.BorderStrokeWidth
+}
+
+// GetRegionColor gets the color of the region box
+func (g *InsetLocatorConfig) GetRegionColor() color.Color {
+	if g.RegionColor == nil {
+		return defaultInsetLocatorRegionColor
+	}
+	return g.RegionColor
+}
+
+// BenchmarkConfig Benchmark Configuration
+type BenchmarkConfig struct {
+	// Iterations is the number of measured runs of every scenario
+	Iterations int
+}
+
+// GetIterations gets the number of measured runs of every scenario
+func (g *BenchmarkConfig) GetIterations() int {
+	if g.Iterations <= 0 {
+		return defaultBenchmarkIterations
+	}
+	return g.Iterations
+}
+
+// GIFConfig GIF Encoding Configuration
+type GIFConfig struct {
+	// Colors is the size of the palette, from 1 to 256
+	Colors int
+	// Dither diffuses the error of colors missing from the palette, instead of mapping them to the nearest color
+	Dither bool
+}
+
+// GetColors gets the size of the palette
+func (g *GIFConfig) GetColors() int {
+	if g.Colors <= 0 || g.Colors > defaultGIFColors {
+		return defaultGIFColors
+	}
+	return g.Colors
+}
+
+// IsDither determines if missing colors are dithered
+func (g *GIFConfig) IsDither() bool {
+	return g.Dither
+}
+
+// LabelDeclutterConfig Label Decluttering Configuration
+type LabelDeclutterConfig struct {
+	Mode LabelDeclutterMode
+	// Padding is the space in pixels kept clear around labels
+	Padding float64
+	// Ellipsis ends abbreviated labels
+	Ellipsis string
+}
+
+// GetMode gets the decluttering mode
+func (g *LabelDeclutterConfig) GetMode() LabelDeclutterMode {
+	return g.Mode
+}
+
+// GetPadding gets the space kept clear around labels
+func (g *LabelDeclutterConfig) GetPadding() float64 {
+	return g.Padding
+}
+
+// GetEllipsis gets the ending of abbreviated labels
+func (g *LabelDeclutterConfig) GetEllipsis() string {
+	if g.Ellipsis == "" {
+		return defaultLabelEllipsis
+	}
+	return g.Ellipsis
+}
+
+// PathRoutingConfig Path Routing Configuration
+type PathRoutingConfig struct {
+	// Spacing is the distance in pixels between overlapping parallel paths nudged apart
+	Spacing float64
+	// JumpRadius is the radius in pixels of the arcs where paths jump over the paths they cross
+	JumpRadius float64
+}
+
+// GetSpacing gets the distance between nudged paths
+func (g *PathRoutingConfig) GetSpacing() float64 {
+	if g.Spacing <= 0 {
+		return defaultPathRoutingSpacing
+	}
+	return g.Spacing
+}
+
+// GetJumpRadius gets the radius of jumps
+func (g *PathRoutingConfig) GetJumpRadius() float64 {
+	if g.JumpRadius <= 0 {
+		return defaultPathJumpRadius
+	}
+	return g.JumpRadius
+}
+
+// Side is a side of a cell
+type Side int
+
+const (
+	// SideTop is the side shared with the cell above
+	SideTop Side = iota
+	// SideRight is the side shared with the cell to the right
+	SideRight
+	// SideBottom is the side shared with the cell below
+	SideBottom
+	// SideLeft is the side shared with the cell to the left
+	SideLeft
+)
+
+// EdgeConfig Cell Edge Configuration
+type EdgeConfig struct {
+	StrokeWidth float64
+	Dashes      float64
+	Color       color.Color
+}
+
+// GetStrokeWidth gets stroke width
+func (g *EdgeConfig) GetStrokeWidth() float64 {
+	if g.StrokeWidth <= 0 {
+		return defaultEdgeStrokeWidth
+	}
+	return g.StrokeWidth
+}
+
+// GetDashes gets dashes
+func (g *EdgeConfig) GetDashes() float64 {
+	return g.Dashes
+}
+
+// GetColor gets color
+func (g *EdgeConfig) GetColor() color.Color {
+	if g.Color == nil {
+		return defaultEdgeColor
+	}
+	return g.Color
+}
+
+// CornerConfig Cell Corner Marker Configuration
+type CornerConfig struct {
+	// Size is the side of the square marker in pixels
+	Size  float64
+	Color color.Color
+}
+
+// GetSize gets the side of the marker
+func (g *CornerConfig) GetSize() float64 {
+	if g.Size <= 0 {
+		return defaultCornerSize
+	}
+	return g.Size
+}
+
+// GetColor gets color
+func (g *CornerConfig) GetColor() color.Color {
+	if g.Color == nil {
+		return defaultCornerColor
+	}
+	return g.Color
+}
+
+// BorderConfig Cell Border Configuration
+type BorderConfig struct {
+	StrokeWidth float64
+	Dashes      float64
+	Color       color.Color
+	// Sides are the sides of the cell bordered, all four when empty
+	Sides []Side
+	// Top, Right, Bottom and Left style sides apart from the rest of the border, taking the style of the border for
+	// the fields they leave unset
+	Top    *EdgeConfig
+	Right  *EdgeConfig
+	Bottom *EdgeConfig
+	Left   *EdgeConfig
+}
+
+// GetStrokeWidth gets stroke width
+func (g *BorderConfig) GetStrokeWidth() float64 {
+	if g.StrokeWidth <= 0 {
+		return defaultCellBorderStrokeWidth
+	}
+	return g.StrokeWidth
+}
+
+// GetDashes gets dashes
+func (g *BorderConfig) GetDashes() float64 {
+	return g.Dashes
+}
+
+// GetColor gets color
+func (g *BorderConfig) GetColor() color.Color {
+	if g.Color == nil {
+		return defaultCellBorderColor
+	}
+	return g.Color
+}
+
+// GetSides gets the sides bordered
+func (g *BorderConfig) GetSides() []Side {
+	if len(g.Sides) == 0 {
+		return []Side{SideTop, SideRight, SideBottom, SideLeft}
+	}
+	return g.Sides
+}
+
+// GetSideConfig gets the style of a side of the border
+func (g *BorderConfig) GetSideConfig(side Side) EdgeConfig {
+	var sideConfig EdgeConfig
+	switch side {
+	case SideTop:
+		sideConfig = getSideConfig(g.Top)
+	case SideRight:
+		sideConfig = getSideConfig(g.Right)
+	case SideBottom:
+		sideConfig = getSideConfig(g.Bottom)
+	case SideLeft:
+		sideConfig = getSideConfig(g.Left)
+	}
+	return sideConfig.withStyle(CellStyle{StrokeWidth: g.GetStrokeWidth(), Dashes: g.GetDashes(), Color: g.GetColor()})
+}
+
+// PrintConfig Print Splitting Configuration, with lengths in millimetres
+type PrintConfig struct {
+	// PageWidth and PageHeight are the paper dimensions, A4 portrait by default
+	PageWidth  float64
+	PageHeight float64
+	// Margin is the unprinted space around the content of every page
+	Margin float64
+	// Overlap is the length of content repeated on neighboring pages, to glue the sheets together
+	Overlap float64
+	// Bleed is the length the content of a print page extends past its trim edges, so that it runs to the edges of
+	// the sheet once trimmed. Split pages have no bleed
+	Bleed             float64
+	CropMarks         bool
+	CropMarkLength    float64
+	CropMarkColor     color.Color
+	RegistrationMarks bool
+}
+
+// GetPageWidth gets the paper width
+func (g *PrintConfig) GetPageWidth() float64 {
+	if g.PageWidth <= 0 {
+		return defaultPrintPageWidth
+	}
+	return g.PageWidth
+}
+
+// GetPageHeight gets the paper height
+func (g *PrintConfig) GetPageHeight() float64 {
+	if g.PageHeight <= 0 {
+		return defaultPrintPageHeight
+	}
+	return g.PageHeight
+}
+
+// GetMargin gets the page margin
+func (g *PrintConfig) GetMargin() float64 {
+	if g.Margin <= 0 {
+		return defaultPrintMargin
+	}
+	return g.Margin
+}
+
+// GetOverlap gets the overlap of neighboring pages
+func (g *PrintConfig) GetOverlap() float64 {
+	if g.Overlap < 0 {
+		return 0
+	}
+	return g.Overlap
+}
+
+// GetBleed gets the bleed of a print page
+func (g *PrintConfig) GetBleed() float64 {
+	if g.Bleed < 0 {
+		return 0
+	}
+	return g.Bleed
+}
+
+// IsCropMarks returns whether crop marks are drawn at the corners of the content
+func (g *PrintConfig) IsCropMarks() bool {
+	return g.CropMarks
+}
+
+// GetCropMarkLength gets the crop mark length
+func (g *PrintConfig) GetCropMarkLength() float64 {
+	if g.CropMarkLength <= 0 {
+		return defaultPrintCropMarkLength
+	}
+	return g.CropMarkLength
+}
+
+// GetCropMarkColor gets the crop mark color
+func (g *PrintConfig) GetCropMarkColor() color.Color {
+	if g.CropMarkColor == nil {
+		return defaultPrintCropMarkColor
+	}
+	return g.CropMarkColor
+}
+
+// IsRegistrationMarks returns whether registration marks are drawn in the margin at the middle of every side
+func (g *PrintConfig) IsRegistrationMarks() bool {
+	return g.RegistrationMarks
+}
+
+// ColorbarConfig Colorbar Configuration
+type ColorbarConfig struct {
+	// Thickness is the width of vertical bars or the height of horizontal bars relative to their region
+	Thickness    float64
+	TickLength   float64
+	FontFace     font.Face
+	NumberFormat *NumberFormat
+	TextColor    color.Color
+}
+
+// GetThickness gets the bar thickness relative to its region
+func (g *ColorbarConfig) GetThickness() float64 {
+	if g.Thickness <= 0 {
+		return defaultColorbarThickness
+	}
+	return g.Thickness
+}
+
+// GetTickLength gets tick length
+func (g *ColorbarConfig) GetTickLength() float64 {
+	if g.TickLength <= 0 {
+		return defaultColorbarTickLength
+	}
+	return g.TickLength
+}
+
+// GetNumberFormat gets the format of tick labels
+func (g *ColorbarConfig) GetNumberFormat() NumberFormat {
+	if g.NumberFormat == nil {
+		return defaultColorbarNumberFormat
+	}
+	return *g.NumberFormat
+}
+
+// GetTextColor gets the color of ticks and their labels
+func (g *ColorbarConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultColorbarTextColor
+	}
+	return g.TextColor
+}
+
+// TriangleMask selects a triangle of a symmetric matrix that is left blank
+type TriangleMask int
+
+const (
+	// MaskNone shows the whole matrix
+	MaskNone TriangleMask = iota
+	// MaskUpper hides the cells above the diagonal
+	MaskUpper
+	// MaskLower hides the cells below the diagonal
+	MaskLower
+)
+
+// CorrelationMatrixConfig Correlation Matrix Configuration
+type CorrelationMatrixConfig struct {
+	ImageConfig ImageConfig
+	Mask        TriangleMask
+	// Colormap maps a correlation scaled from -1..1 to 0..1 to a color
+	Colormap     func(fraction float64) color.Color
+	NumberFormat *NumberFormat
+	// LabelRotation is the counterclockwise rotation of column labels in degrees
+	LabelRotation float64
+	TextColor     color.Color
+	Font          *truetype.Font
+	FontFraction  float64
+}
+
+// GetColor gets the color of a correlation scaled from -1..1 to 0..1
+func (g *CorrelationMatrixConfig) GetColor(fraction float64) color.Color {
+	if g.Colormap == nil {
+		return DivergingColormap(defaultCorrelationMatrixLowColor, color.White, defaultCorrelationMatrixHighColor)(fraction)
+	}
+	return g.Colormap(fraction)
+}
+
+// GetNumberFormat gets the format of correlations
+func (g *CorrelationMatrixConfig) GetNumberFormat() NumberFormat {
+	if g.NumberFormat == nil {
+		return defaultCorrelationMatrixNumberFormat
+	}
+	return *g.NumberFormat
+}
+
+// GetLabelRotation gets the rotation of column labels in degrees
+func (g *CorrelationMatrixConfig) GetLabelRotation() float64 {
+	if g.LabelRotation <= 0 {
+		return defaultCorrelationMatrixLabelRotation
+	}
+	return g.LabelRotation
+}
+
+// GetTextColor gets the color of labels and colorbar ticks
+func (g *CorrelationMatrixConfig) GetTextColor() color.Color {
+	if g.TextColor == nil {
+		return defaultCorrelationMatrixTextColor
+	}
+	return g.TextColor
+}
+
+// GetFontFraction gets the text size relative to the cell height
+func (g *CorrelationMatrixConfig) GetFontFraction() float64 {
+	if g.FontFraction <= 0 {
+		return defaultCorrelationMatrixFontFraction
+	}
+	return g.FontFraction
+}
+
+// GraphConfig Graph Configuration
+type GraphConfig struct {
+	NodeScale       float64
+	NodeColor       color.Color
+	EdgeStrokeWidth float64
+	EdgeColor       color.Color
+	LabelColor      color.Color
+}
+
+// GetNodeScale gets the node size relative to its cell
+func (g *GraphConfig) GetNodeScale() float64 {
+	if g.NodeScale <= 0 {
+		return defaultGraphNodeScale
+	}
+	return g.NodeScale
+}
+
+// GetNodeColor gets node color
+func (g *GraphConfig) GetNodeColor() color.Color {
+	if g.NodeColor == nil {
+		return defaultGraphNodeColor
+	}
+	return g.NodeColor
+}
+
+// GetEdgeStrokeWidth gets edge stroke width
+func (g *GraphConfig) GetEdgeStrokeWidth() float64 {
+	if g.EdgeStrokeWidth <= 0 {
+		return defaultGraphEdgeStrokeWidth
+	}
+	return g.EdgeStrokeWidth
+}
+
+// GetEdgeColor gets edge color
+func (g *GraphConfig) GetEdgeColor() color.Color {
+	if g.EdgeColor == nil {
+		return defaultGraphEdgeColor
+	}
+	return g.EdgeColor
+}
+
+// GetLabelColor gets label color
+func (g *GraphConfig) GetLabelColor() color.Color {
+	if g.LabelColor == nil {
+		return defaultGraphTextColor
+	}
+	return g.LabelColor
+}
+
+// MoveConfig Move Configuration
+type MoveConfig struct {
+	Arc float64
+}
+
+// GetArc gets the height of the arc in cells, perpendicular to the move
+func (g *MoveConfig) GetArc() float64 {
+	return g.Arc
+}
+
+// ScaleFilter is the interpolation used when images are scaled into cells
+type ScaleFilter int
+
+const (
+	// ScaleBilinear smooths scaled images, suited to photos and drawings
+	ScaleBilinear ScaleFilter = iota
+	// ScaleNearest keeps the hard pixel edges of pixel art
+	ScaleNearest
+)
+
+// SpriteConfig Sprite Configuration
+type SpriteConfig struct {
+	Filter ScaleFilter
+	// FlipHorizontal and FlipVertical mirror the sprite in its cell, from left to right and from top to bottom
+	FlipHorizontal bool
+	FlipVertical   bool
+}
+
+// GetFilter gets scale filter
+func (g *SpriteConfig) GetFilter() ScaleFilter {
+	return g.Filter
+}
+
+// IsFlipHorizontal determines if the sprite is mirrored from left to right
+func (g *SpriteConfig) IsFlipHorizontal() bool {
+	return g.FlipHorizontal
+}
+
+// IsFlipVertical determines if the sprite is mirrored from top to bottom
+func (g *SpriteConfig) IsFlipVertical() bool {
+	return g.FlipVertical
+}
+
+// ImageFit is how an image is scaled into a cell
+type ImageFit int
+
+const (
+	// FitContain scales the image to fit inside the cell, keeping its aspect ratio
+	FitContain ImageFit = iota
+	// FitCover scales the image to cover the cell, keeping its aspect ratio and cropping the sides that overflow
+	FitCover
+	// FitStretch scales the image to the size of the cell
+	FitStretch
+)
+
+// ImageDrawConfig Image Drawing Configuration
+type ImageDrawConfig struct {
+	Fit ImageFit
+	// Rotate is the rotation of the image about the center of the cell in degrees
+	Rotate float64
+	// Opacity is from 0 to 1, images are opaque when it is unset
+	Opacity float64
+	Filter  ScaleFilter
+	// FlipHorizontal and FlipVertical mirror the image before it is rotated, from left to right and from top to bottom
+	FlipHorizontal bool
+	FlipVertical   bool
+}
+
+// GetFit gets how the image is scaled into the cell
+func (g *ImageDrawConfig) GetFit() ImageFit {
+	return g.Fit
+}
+
+// GetRotate gets the rotation in degrees
+func (g *ImageDrawConfig) GetRotate() float64 {
+	return g.Rotate
+}
+
+// GetOpacity gets the opacity
+func (g *ImageDrawConfig) GetOpacity() float64 {
+	if g.Opacity == 0 {
+		return 1
+	}
+	return g.Opacity
+}
+
+// GetFilter gets scale filter
+func (g *ImageDrawConfig) GetFilter() ScaleFilter {
+	return g.Filter
+}
+
+// IsFlipHorizontal determines if the image is mirrored from left to right
+func (g *ImageDrawConfig) IsFlipHorizontal() bool {
+	return g.FlipHorizontal
+}
+
+// IsFlipVertical determines if the image is mirrored from top to bottom
+func (g *ImageDrawConfig) IsFlipVertical() bool {
+	return g.FlipVertical
+}
+
+// NineSliceConfig Nine-Slice Configuration. The insets split the image into corners that keep their size, edges that
+// stretch along one axis and a center that stretches along both
+type NineSliceConfig struct {
+	Left   int
+	Top    int
+	Right  int
+	Bottom int
+	Scale  float64
+	Filter ScaleFilter
+}
+
+// GetLeft gets left inset in image pixels
+func (g *NineSliceConfig) GetLeft() int {
+	return g.Left
+}
+
+// GetTop gets top inset in image pixels
+func (g *NineSliceConfig) GetTop() int {
+	return g.Top
+}
+
+// GetRight gets right inset in image pixels
+func (g *NineSliceConfig) GetRight() int {
+	return g.Right
+}
+
+// GetBottom gets bottom inset in image pixels
+func (g *NineSliceConfig) GetBottom() int {
+	return g.Bottom
+}
+
+// GetScale gets the scale of corners and edges, corners shrink further when the region is too small for them
+func (g *NineSliceConfig) GetScale() float64 {
+	if g.Scale <= 0 {
+		return defaultNineSliceScale
+	}
+	return g.Scale
+}
+
+// GetFilter gets scale filter
+func (g *NineSliceConfig) GetFilter() ScaleFilter {
+	return g.Filter
+}
+
+func getFirstRectangleConfig(configs ...RectangleConfig) RectangleConfig {
+	if len(configs) == 0 {
+		return RectangleConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstCircleConfig(configs ...CircleConfig) CircleConfig {
+	if len(configs) == 0 {
+		return CircleConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstPathConfig(configs ...PathConfig) PathConfig {
+	if len(configs) == 0 {
+		return PathConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstLineConfig(configs ...LineConfig) LineConfig {
+	if len(configs) == 0 {
+		return LineConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstStringConfig(configs ...StringConfig) StringConfig {
+	if len(configs) == 0 {
+		return StringConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstContourConfig(configs ...ContourConfig) ContourConfig {
+	if len(configs) == 0 {
+		return ContourConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstVectorFieldConfig(configs ...VectorFieldConfig) VectorFieldConfig {
+	if len(configs) == 0 {
+		return VectorFieldConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstGeoJSONConfig(configs ...GeoJSONConfig) GeoJSONConfig {
+	if len(configs) == 0 {
+		return GeoJSONConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstCalendarConfig(configs ...CalendarConfig) CalendarConfig {
+	if len(configs) == 0 {
+		return CalendarConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstConfusionMatrixConfig(configs ...ConfusionMatrixConfig) ConfusionMatrixConfig {
+	if len(configs) == 0 {
+		return ConfusionMatrixConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstSeatingConfig(configs ...SeatingConfig) SeatingConfig {
+	if len(configs) == 0 {
+		return SeatingConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstBingoConfig(configs ...BingoConfig) BingoConfig {
+	if len(configs) == 0 {
+		return BingoConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstBingoCardConfig(configs ...BingoCardConfig) BingoCardConfig {
+	if len(configs) == 0 {
+		return BingoCardConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstNonogramConfig(configs ...NonogramConfig) NonogramConfig {
+	if len(configs) == 0 {
+		return NonogramConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstBattleshipConfig(configs ...BattleshipConfig) BattleshipConfig {
+	if len(configs) == 0 {
+		return BattleshipConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstBarChartConfig(configs ...BarChartConfig) BarChartConfig {
+	if len(configs) == 0 {
+		return BarChartConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstWaffleConfig(configs ...WaffleConfig) WaffleConfig {
+	if len(configs) == 0 {
+		return WaffleConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstTreemapConfig(configs ...TreemapConfig) TreemapConfig {
+	if len(configs) == 0 {
+		return TreemapConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstAdjacencyMatrixConfig(configs ...AdjacencyMatrixConfig) AdjacencyMatrixConfig {
+	if len(configs) == 0 {
+		return AdjacencyMatrixConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstTimetableConfig(configs ...TimetableConfig) TimetableConfig {
+	if len(configs) == 0 {
+		return TimetableConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstKeyboardConfig(configs ...KeyboardConfig) KeyboardConfig {
+	if len(configs) == 0 {
+		return KeyboardConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstLEDMatrixConfig(configs ...LEDMatrixConfig) LEDMatrixConfig {
+	if len(configs) == 0 {
+		return LEDMatrixConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstFromImageConfig(configs ...FromImageConfig) FromImageConfig {
+	if len(configs) == 0 {
+		return FromImageConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstCrossStitchConfig(configs ...CrossStitchConfig) CrossStitchConfig {
+	if len(configs) == 0 {
+		return CrossStitchConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstNorthArrowConfig(configs ...NorthArrowConfig) NorthArrowConfig {
+	if len(configs) == 0 {
+		return NorthArrowConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstScaleBarConfig(configs ...ScaleBarConfig) ScaleBarConfig {
+	if len(configs) == 0 {
+		return ScaleBarConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstInsetLocatorConfig(configs ...InsetLocatorConfig) InsetLocatorConfig {
+	if len(configs) == 0 {
+		return InsetLocatorConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstRenderLimits(limits ...RenderLimits) RenderLimits {
+	if len(limits) == 0 {
+		return RenderLimits{}
+	}
+	return limits[0]
+}
+
+func getFirstBenchmarkConfig(configs ...BenchmarkConfig) BenchmarkConfig {
+	if len(configs) == 0 {
+		return BenchmarkConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstGIFConfig(configs ...GIFConfig) GIFConfig {
+	if len(configs) == 0 {
+		return GIFConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstEdgeConfig(configs ...EdgeConfig) EdgeConfig {
+	if len(configs) == 0 {
+		return EdgeConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstCornerConfig(configs ...CornerConfig) CornerConfig {
+	if len(configs) == 0 {
+		return CornerConfig{}
+	}
+	return configs[0]
+}
+
+func getSideConfig(config *EdgeConfig) EdgeConfig {
+	if config == nil {
+		return EdgeConfig{}
+	}
+	return *config
+}
+
+func getFirstPrintConfig(configs ...PrintConfig) PrintConfig {
+	if len(configs) == 0 {
+		return PrintConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstColorbarConfig(configs ...ColorbarConfig) ColorbarConfig {
+	if len(configs) == 0 {
+		return ColorbarConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstCorrelationMatrixConfig(configs ...CorrelationMatrixConfig) CorrelationMatrixConfig {
+	if len(configs) == 0 {
+		return CorrelationMatrixConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstGraphConfig(configs ...GraphConfig) GraphConfig {
+	if len(configs) == 0 {
+		return GraphConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstNineSliceConfig(configs ...NineSliceConfig) NineSliceConfig {
+	if len(configs) == 0 {
+		return NineSliceConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstSpriteConfig(configs ...SpriteConfig) SpriteConfig {
+	if len(configs) == 0 {
+		return SpriteConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstImageDrawConfig(configs ...ImageDrawConfig) ImageDrawConfig {
+	if len(configs) == 0 {
+		return ImageDrawConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstMoveConfig(configs ...MoveConfig) MoveConfig {
+	if len(configs) == 0 {
+		return MoveConfig{}
 	}
 	return configs[0]
 }
@@ -3,6 +3,7 @@ package gridder
 import (
 	"image/color"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -12,11 +13,47 @@ func TestImageConfig(t *testing.T) {
 	assert.Equal(t, config1.GetWidth(), defaultGridWidth)
 	assert.Equal(t, config1.GetHeight(), defaultGridHeight)
 	assert.Equal(t, config1.GetName(), "Hello")
+	assert.Equal(t, config1.GetDPI(), defaultImageDPI)
+	assert.Equal(t, config1.IsDeterministic(), false)
 
-	config2 := &ImageConfig{Name: "Bye", Width: 10, Height: 100}
+	config2 := &ImageConfig{Name: "Bye", Width: 10, Height: 100, DPI: 300, Deterministic: true}
 	assert.Equal(t, config2.GetWidth(), 10)
 	assert.Equal(t, config2.GetHeight(), 100)
 	assert.Equal(t, config2.GetName(), "Bye")
+	assert.Equal(t, config2.GetDPI(), 300.0)
+	assert.Equal(t, config2.IsDeterministic(), true)
+}
+
+func TestRoughStyle(t *testing.T) {
+	config1 := &ImageConfig{}
+	assert.Nil(t, config1.GetRough())
+
+	rough1 := &RoughStyle{}
+	assert.Equal(t, rough1.GetRoughness(), defaultRoughness)
+	assert.Equal(t, rough1.GetPasses(), defaultRoughPasses)
+	assert.Equal(t, rough1.GetWidthVariation(), defaultRoughWidthVariation)
+	assert.Equal(t, rough1.GetSeed(), int64(0))
+
+	config2 := &ImageConfig{Rough: &RoughStyle{Roughness: 3, Passes: 1, WidthVariation: 2, Seed: 5}}
+	rough2 := config2.GetRough()
+	assert.Equal(t, rough2.GetRoughness(), 3.0)
+	assert.Equal(t, rough2.GetPasses(), 1)
+	assert.Equal(t, rough2.GetWidthVariation(), 1.0)
+	assert.Equal(t, rough2.GetSeed(), int64(5))
+}
+
+func TestRenderLimits(t *testing.T) {
+	config1 := &ImageConfig{}
+	limits1 := config1.GetLimits()
+	assert.Equal(t, limits1.GetMaxPixels(), 0)
+	assert.Equal(t, limits1.GetMaxOperations(), 0)
+	assert.Equal(t, limits1.GetMaxTextLength(), 0)
+
+	config2 := &ImageConfig{Limits: RenderLimits{MaxPixels: 1, MaxOperations: 2, MaxTextLength: 3}}
+	limits2 := config2.GetLimits()
+	assert.Equal(t, limits2.GetMaxPixels(), 1)
+	assert.Equal(t, limits2.GetMaxOperations(), 2)
+	assert.Equal(t, limits2.GetMaxTextLength(), 3)
 }
 
 func TestGridConfig(t *testing.T) {
@@ -28,16 +65,24 @@ func TestGridConfig(t *testing.T) {
 	assert.Equal(t, config1.GetHeight(100), 100)
 	assert.Equal(t, config1.GetLineDashes(), 0.0)
 	assert.Equal(t, config1.GetLineStrokeWidth(), 0.0)
+	assert.Equal(t, config1.GetJitter(), 0.0)
+	assert.Equal(t, config1.GetJitterSeed(), int64(0))
 	assert.Equal(t, config1.GetBorderDashes(), 0.0)
 	assert.Equal(t, config1.GetBorderStrokeWidth(), 0.0)
 	assert.Equal(t, config1.GetLineColor(), defaultGridLineColor)
 	assert.Equal(t, config1.GetBorderColor(), defaultGridBorderColor)
 	assert.Equal(t, config1.GetBackgroundColor(), defaultGridBackgroundColor)
+	assert.Equal(t, config1.GetCellStyle(), CellStyle{})
+	assert.Equal(t, config1.GetRowStyle(0), CellStyle{})
+	assert.Equal(t, config1.GetColumnStyle(0), CellStyle{})
 
 	config2 := &GridConfig{
 		Rows: 100, Columns: 200, MarginWidth: 1, LineDashes: 1, BorderDashes: 2,
-		LineStrokeWidth: 4, BorderStrokeWidth: 8,
+		LineStrokeWidth: 4, BorderStrokeWidth: 8, Jitter: 2, JitterSeed: 7,
 		LineColor: color.White, BorderColor: color.White, BackgroundColor: color.White,
+		CellStyle:    &CellStyle{Color: color.Black},
+		RowStyles:    []*RowStyle{{Row: 1, Style: CellStyle{StrokeWidth: 1}}},
+		ColumnStyles: []*ColumnStyle{{Column: 2, Style: CellStyle{Dashes: 1}}},
 	}
 	assert.Equal(t, config2.GetRows(), 100)
 	assert.Equal(t, config2.GetColumns(), 200)
@@ -46,11 +91,17 @@ func TestGridConfig(t *testing.T) {
 	assert.Equal(t, config2.GetHeight(100), 98)
 	assert.Equal(t, config2.GetLineDashes(), 1.0)
 	assert.Equal(t, config2.GetLineStrokeWidth(), 4.0)
+	assert.Equal(t, config2.GetJitter(), 2.0)
+	assert.Equal(t, config2.GetJitterSeed(), int64(7))
 	assert.Equal(t, config2.GetBorderDashes(), 2.0)
 	assert.Equal(t, config2.GetBorderStrokeWidth(), 8.0)
 	assert.Equal(t, config2.GetLineColor(), color.White)
 	assert.Equal(t, config2.GetBorderColor(), color.White)
 	assert.Equal(t, config2.GetBackgroundColor(), color.White)
+	assert.Equal(t, config2.GetCellStyle(), CellStyle{Color: color.Black})
+	assert.Equal(t, config2.GetRowStyle(1), CellStyle{StrokeWidth: 1})
+	assert.Equal(t, config2.GetRowStyle(2), CellStyle{})
+	assert.Equal(t, config2.GetColumnStyle(2), CellStyle{Dashes: 1})
 }
 
 func TestPathConfig(t *testing.T) {
@@ -58,11 +109,15 @@ func TestPathConfig(t *testing.T) {
 	assert.Equal(t, config1.GetDashes(), 0.0)
 	assert.Equal(t, config1.GetStrokeWidth(), defaultLineStrokeWidth)
 	assert.Equal(t, config1.GetColor(), defaultLineColor)
+	assert.Nil(t, config1.GetStartPort())
+	assert.Nil(t, config1.GetEndPort())
 
-	config2 := &PathConfig{Dashes: 1, StrokeWidth: 10, Color: color.White}
+	config2 := &PathConfig{Dashes: 1, StrokeWidth: 10, Color: color.White, StartPort: &Port{Side: SideRight}, EndPort: &Port{Side: SideLeft}}
 	assert.Equal(t, config2.GetDashes(), 1.0)
 	assert.Equal(t, config2.GetStrokeWidth(), 10.0)
 	assert.Equal(t, config2.GetColor(), color.White)
+	assert.Equal(t, config2.GetStartPort(), &Port{Side: SideRight})
+	assert.Equal(t, config2.GetEndPort(), &Port{Side: SideLeft})
 }
 
 func TestLineConfig(t *testing.T) {
@@ -106,8 +161,9 @@ func TestRectangleConfig(t *testing.T) {
 	assert.Equal(t, config1.IsStroke(), false)
 	assert.Equal(t, config1.GetStrokeWidth(), defaultRectangleStrokeWidth)
 	assert.Equal(t, config1.GetColor(), defaultRectangleColor)
+	assert.Equal(t, config1.GetSpan(), Span{})
 
-	config2 := &RectangleConfig{Width: 1, Height: 2, Rotate: 90, Dashes: 1, Stroke: true, StrokeWidth: 10, Color: color.White}
+	config2 := &RectangleConfig{Width: 1, Height: 2, Rotate: 90, Dashes: 1, Stroke: true, StrokeWidth: 10, Color: color.White, Span: Span{Rows: 2}}
 	assert.Equal(t, config2.GetWidth(), 1.0)
 	assert.Equal(t, config2.GetHeight(), 2.0)
 	assert.Equal(t, config2.GetRotate(), 90.0)
@@ -115,16 +171,604 @@ func TestRectangleConfig(t *testing.T) {
 	assert.Equal(t, config2.IsStroke(), true)
 	assert.Equal(t, config2.GetStrokeWidth(), 10.0)
 	assert.Equal(t, config2.GetColor(), color.White)
+	assert.Equal(t, config2.GetSpan(), Span{Rows: 2})
 }
 
 func TestStringConfig(t *testing.T) {
 	config1 := &StringConfig{}
 	assert.Equal(t, config1.GetRotate(), 0.0)
 	assert.Equal(t, config1.GetColor(), defaultStringColor)
+	assert.Equal(t, config1.GetSpan(), Span{})
+	assert.Equal(t, config1.IsWrap(), false)
+	assert.Equal(t, config1.GetLineSpacing(), defaultStringLineSpacing)
+	assert.Equal(t, config1.GetHorizontalAlign(), AlignCenter)
+	assert.Equal(t, config1.GetVerticalAlign(), AlignMiddle)
+	assert.Equal(t, config1.GetPadding(), 0.0)
 
-	config2 := &StringConfig{Rotate: 1, Color: color.White}
+	config2 := &StringConfig{
+		Rotate: 1, Color: color.White, Span: Span{Columns: 3}, Wrap: true, LineSpacing: 1.5,
+		HorizontalAlign: AlignRight, VerticalAlign: AlignTop, Padding: 2,
+	}
 	assert.Equal(t, config2.GetRotate(), 1.0)
 	assert.Equal(t, config2.GetColor(), color.White)
+	assert.Equal(t, config2.GetSpan(), Span{Columns: 3})
+	assert.Equal(t, config2.IsWrap(), true)
+	assert.Equal(t, config2.GetLineSpacing(), 1.5)
+	assert.Equal(t, config2.GetHorizontalAlign(), AlignRight)
+	assert.Equal(t, config2.GetVerticalAlign(), AlignTop)
+	assert.Equal(t, config2.GetPadding(), 2.0)
+}
+
+func TestContourConfig(t *testing.T) {
+	config1 := &ContourConfig{}
+	assert.Equal(t, config1.GetDashes(), 0.0)
+	assert.Equal(t, config1.GetStrokeWidth(), defaultContourStrokeWidth)
+	assert.Equal(t, config1.GetColor(), defaultContourColor)
+
+	config2 := &ContourConfig{Dashes: 1, StrokeWidth: 10, Color: color.White}
+	assert.Equal(t, config2.GetDashes(), 1.0)
+	assert.Equal(t, config2.GetStrokeWidth(), 10.0)
+	assert.Equal(t, config2.GetColor(), color.White)
+}
+
+func TestVectorFieldConfig(t *testing.T) {
+	config1 := &VectorFieldConfig{}
+	assert.Equal(t, config1.GetScale(), defaultVectorFieldScale)
+	assert.Equal(t, config1.GetHeadSize(), defaultVectorFieldHeadSize)
+	assert.Equal(t, config1.GetStrokeWidth(), defaultVectorFieldStrokeWidth)
+	assert.Equal(t, config1.GetColor(1), defaultVectorColor)
+
+	config2 := &VectorFieldConfig{Scale: 0.5, HeadSize: 0.1, StrokeWidth: 10, Color: color.White}
+	assert.Equal(t, config2.GetScale(), 0.5)
+	assert.Equal(t, config2.GetHeadSize(), 0.1)
+	assert.Equal(t, config2.GetStrokeWidth(), 10.0)
+	assert.Equal(t, config2.GetColor(1), color.White)
+
+	config3 := &VectorFieldConfig{ColorFunc: func(magnitude float64) color.Color { return color.Gray{Y: uint8(magnitude * 255)} }}
+	assert.Equal(t, config3.GetColor(1), color.Gray{Y: 255})
+}
+
+func TestGeoJSONConfig(t *testing.T) {
+	config1 := &GeoJSONConfig{}
+	assert.Equal(t, config1.GetColor(1), defaultGeoJSONColor)
+	assert.Equal(t, config1.GetColor(0.5), color.NRGBA{R: 200, A: 128})
+	assert.Equal(t, config1.GetCategoryColor("a"), nil)
+
+	config2 := &GeoJSONConfig{Color: color.White, Categories: map[string]color.Color{"a": color.Black}}
+	assert.Equal(t, config2.GetColor(1), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, config2.GetCategoryColor("a"), color.Black)
+
+	config3 := &GeoJSONConfig{ColorFunc: func(fraction float64) color.Color { return color.Gray{Y: uint8(fraction * 255)} }}
+	assert.Equal(t, config3.GetColor(1), color.Gray{Y: 255})
+}
+
+func TestCalendarConfig(t *testing.T) {
+	config1 := &CalendarConfig{}
+	assert.Equal(t, config1.GetColor(1), defaultCalendarColors[3])
+	assert.Equal(t, config1.GetEmptyColor(), defaultCalendarEmptyColor)
+	assert.Equal(t, config1.GetTextColor(), defaultCalendarTextColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultCalendarFontFraction)
+	assert.Equal(t, config1.GetLegendLow(), defaultCalendarLegendLow)
+	assert.Equal(t, config1.GetLegendHigh(), defaultCalendarLegendHigh)
+
+	config2 := &CalendarConfig{
+		Colormap:     func(fraction float64) color.Color { return color.Gray{Y: uint8(fraction * 255)} },
+		EmptyColor:   color.White,
+		TextColor:    color.White,
+		FontFraction: 0.5,
+		LegendLow:    "0",
+		LegendHigh:   "10",
+	}
+	assert.Equal(t, config2.GetColor(1), color.Gray{Y: 255})
+	assert.Equal(t, config2.GetEmptyColor(), color.White)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetFontFraction(), 0.5)
+	assert.Equal(t, config2.GetLegendLow(), "0")
+	assert.Equal(t, config2.GetLegendHigh(), "10")
+}
+
+func TestConfusionMatrixConfig(t *testing.T) {
+	config1 := &ConfusionMatrixConfig{}
+	assert.Equal(t, config1.GetColor(1), defaultConfusionMatrixColor)
+	assert.Equal(t, config1.GetNumberFormat(), defaultConfusionMatrixNumberFormat)
+	assert.Equal(t, config1.GetDiagonalColor(), defaultConfusionMatrixDiagonalColor)
+	assert.Equal(t, config1.GetDiagonalStrokeWidth(), defaultConfusionMatrixDiagonalStrokeWidth)
+	assert.Equal(t, config1.GetTextColor(), defaultConfusionMatrixTextColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultConfusionMatrixFontFraction)
+
+	config2 := &ConfusionMatrixConfig{
+		Colormap:            func(fraction float64) color.Color { return color.Gray{Y: uint8(fraction * 255)} },
+		NumberFormat:        &NumberFormat{Precision: 2},
+		DiagonalColor:       color.White,
+		DiagonalStrokeWidth: 1,
+		TextColor:           color.White,
+		FontFraction:        0.5,
+	}
+	assert.Equal(t, config2.GetColor(1), color.Gray{Y: 255})
+	assert.Equal(t, config2.GetNumberFormat(), NumberFormat{Precision: 2})
+	assert.Equal(t, config2.GetDiagonalColor(), color.White)
+	assert.Equal(t, config2.GetDiagonalStrokeWidth(), 1.0)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetFontFraction(), 0.5)
+}
+
+func TestSeatingConfig(t *testing.T) {
+	config1 := &SeatingConfig{}
+	assert.Equal(t, config1.GetAisleWidth(), defaultSeatingAisleWidth)
+	assert.Equal(t, config1.GetStates(), defaultSeatingStates)
+	assert.Equal(t, config1.GetTextColor(), defaultSeatingTextColor)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultSeatingBackgroundColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultSeatingFontFraction)
+
+	states := []SeatStateStyle{{Name: "open", Color: color.White}}
+	config2 := &SeatingConfig{AisleWidth: 2, States: states, TextColor: color.White, BackgroundColor: color.Black, FontFraction: 0.5}
+	assert.Equal(t, config2.GetAisleWidth(), 2)
+	assert.Equal(t, config2.GetStates(), states)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetBackgroundColor(), color.Black)
+	assert.Equal(t, config2.GetFontFraction(), 0.5)
+}
+
+func TestBingoConfig(t *testing.T) {
+	config1 := &BingoConfig{}
+	assert.Equal(t, config1.GetRanges(), defaultBingoRanges)
+	assert.Equal(t, config1.GetRows(), defaultBingoRows)
+
+	ranges := []BingoRange{{Min: 1, Max: 9}}
+	config2 := &BingoConfig{Ranges: ranges, Rows: 3}
+	assert.Equal(t, config2.GetRanges(), ranges)
+	assert.Equal(t, config2.GetRows(), 3)
+}
+
+func TestBingoCardConfig(t *testing.T) {
+	config1 := &BingoCardConfig{}
+	assert.Equal(t, config1.GetFreeText(), defaultBingoFreeText)
+	assert.Equal(t, config1.GetHeaderColor(), defaultBingoHeaderColor)
+	assert.Equal(t, config1.GetFreeColor(), defaultBingoFreeColor)
+	assert.Equal(t, config1.GetTextColor(), defaultBingoTextColor)
+	assert.Equal(t, config1.GetLineColor(), defaultBingoLineColor)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultBingoBackgroundColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultBingoFontFraction)
+
+	config2 := &BingoCardConfig{FreeText: "*", HeaderColor: color.White, FreeColor: color.White, TextColor: color.White, LineColor: color.White, BackgroundColor: color.Black, FontFraction: 0.5}
+	assert.Equal(t, config2.GetFreeText(), "*")
+	assert.Equal(t, config2.GetHeaderColor(), color.White)
+	assert.Equal(t, config2.GetFreeColor(), color.White)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetLineColor(), color.White)
+	assert.Equal(t, config2.GetBackgroundColor(), color.Black)
+	assert.Equal(t, config2.GetFontFraction(), 0.5)
+}
+
+func TestNonogramConfig(t *testing.T) {
+	config1 := &NonogramConfig{}
+	assert.Equal(t, config1.GetMajorLineInterval(), defaultNonogramMajorLineInterval)
+	assert.Equal(t, config1.GetLineStrokeWidth(), defaultNonogramLineStrokeWidth)
+	assert.Equal(t, config1.GetMajorLineStrokeWidth(), defaultNonogramMajorLineStrokeWidth)
+	assert.Equal(t, config1.GetLineColor(), defaultNonogramLineColor)
+	assert.Equal(t, config1.GetMajorLineColor(), defaultNonogramMajorLineColor)
+	assert.Equal(t, config1.GetFillColor(), defaultNonogramFillColor)
+	assert.Equal(t, config1.GetTextColor(), defaultNonogramTextColor)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultNonogramBackgroundColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultNonogramFontFraction)
+
+	config2 := &NonogramConfig{MajorLineInterval: 3, LineStrokeWidth: 2, MajorLineStrokeWidth: 4, LineColor: color.White, MajorLineColor: color.White, FillColor: color.White, TextColor: color.White, BackgroundColor: color.Black, FontFraction: 0.3}
+	assert.Equal(t, config2.GetMajorLineInterval(), 3)
+	assert.Equal(t, config2.GetLineStrokeWidth(), 2.0)
+	assert.Equal(t, config2.GetMajorLineStrokeWidth(), 4.0)
+	assert.Equal(t, config2.GetLineColor(), color.White)
+	assert.Equal(t, config2.GetMajorLineColor(), color.White)
+	assert.Equal(t, config2.GetFillColor(), color.White)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetBackgroundColor(), color.Black)
+	assert.Equal(t, config2.GetFontFraction(), 0.3)
+}
+
+func TestBattleshipConfig(t *testing.T) {
+	config1 := &BattleshipConfig{}
+	assert.Equal(t, config1.GetSize(), defaultBattleshipSize)
+	assert.Equal(t, config1.GetFleetTitle(), defaultBattleshipFleetTitle)
+	assert.Equal(t, config1.GetTargetTitle(), defaultBattleshipTargetTitle)
+	assert.Equal(t, config1.GetWaterColor(), defaultBattleshipWaterColor)
+	assert.Equal(t, config1.GetShipColor(), defaultBattleshipShipColor)
+	assert.Equal(t, config1.GetHitColor(), defaultBattleshipHitColor)
+	assert.Equal(t, config1.GetMissColor(), defaultBattleshipMissColor)
+	assert.Equal(t, config1.GetTextColor(), defaultBattleshipTextColor)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultBattleshipBackgroundColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultBattleshipFontFraction)
+
+	config2 := &BattleshipConfig{Size: 8, FleetTitle: "Me", TargetTitle: "You", WaterColor: color.White, ShipColor: color.White, HitColor: color.White, MissColor: color.Black, TextColor: color.White, BackgroundColor: color.Black, FontFraction: 0.3}
+	assert.Equal(t, config2.GetSize(), 8)
+	assert.Equal(t, config2.GetFleetTitle(), "Me")
+	assert.Equal(t, config2.GetTargetTitle(), "You")
+	assert.Equal(t, config2.GetWaterColor(), color.White)
+	assert.Equal(t, config2.GetShipColor(), color.White)
+	assert.Equal(t, config2.GetHitColor(), color.White)
+	assert.Equal(t, config2.GetMissColor(), color.Black)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetBackgroundColor(), color.Black)
+	assert.Equal(t, config2.GetFontFraction(), 0.3)
+}
+
+func TestBarChartConfig(t *testing.T) {
+	config1 := &BarChartConfig{}
+	assert.Equal(t, config1.GetUnit(), defaultBarChartUnit)
+	assert.Equal(t, config1.GetTickInterval(), defaultBarChartTickInterval)
+	assert.Equal(t, config1.GetEmptyColor(), defaultBarChartEmptyColor)
+	assert.Equal(t, config1.GetTextColor(), defaultBarChartTextColor)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultBarChartBackgroundColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultBarChartFontFraction)
+
+	config2 := &BarChartConfig{Unit: 10, TickInterval: 2, EmptyColor: color.Black, TextColor: color.White, BackgroundColor: color.Black, FontFraction: 0.3}
+	assert.Equal(t, config2.GetUnit(), 10.0)
+	assert.Equal(t, config2.GetTickInterval(), 2)
+	assert.Equal(t, config2.GetEmptyColor(), color.Black)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetBackgroundColor(), color.Black)
+	assert.Equal(t, config2.GetFontFraction(), 0.3)
+}
+
+func TestWaffleConfig(t *testing.T) {
+	config1 := &WaffleConfig{}
+	assert.Equal(t, config1.GetCells(), defaultWaffleCells)
+	assert.Equal(t, config1.GetTextColor(), defaultWaffleTextColor)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultWaffleBackgroundColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultWaffleFontFraction)
+
+	config2 := &WaffleConfig{Cells: 50, TextColor: color.White, BackgroundColor: color.Black, FontFraction: 0.3}
+	assert.Equal(t, config2.GetCells(), 50)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetBackgroundColor(), color.Black)
+	assert.Equal(t, config2.GetFontFraction(), 0.3)
+}
+
+func TestTreemapConfig(t *testing.T) {
+	config1 := &TreemapConfig{}
+	assert.Equal(t, config1.GetRows(), defaultTreemapRows)
+	assert.Equal(t, config1.GetColumns(), defaultTreemapColumns)
+	assert.Equal(t, config1.GetColor(0), color.NRGBAModel.Convert(defaultTreemapLowColor))
+	assert.Equal(t, config1.GetColor(1), color.NRGBAModel.Convert(defaultTreemapHighColor))
+	assert.Equal(t, config1.GetBackgroundColor(), defaultTreemapBackgroundColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultTreemapFontFraction)
+
+	config2 := &TreemapConfig{Rows: 4, Columns: 6, Colormap: func(float64) color.Color { return color.Black }, BackgroundColor: color.Black, FontFraction: 0.3}
+	assert.Equal(t, config2.GetRows(), 4)
+	assert.Equal(t, config2.GetColumns(), 6)
+	assert.Equal(t, config2.GetColor(0.5), color.Black)
+	assert.Equal(t, config2.GetBackgroundColor(), color.Black)
+	assert.Equal(t, config2.GetFontFraction(), 0.3)
+}
+
+func TestAdjacencyMatrixConfig(t *testing.T) {
+	config1 := &AdjacencyMatrixConfig{}
+	assert.Equal(t, config1.GetColor(0), color.NRGBAModel.Convert(defaultAdjacencyMatrixLowColor))
+	assert.Equal(t, config1.GetColor(1), color.NRGBAModel.Convert(defaultAdjacencyMatrixHighColor))
+	assert.Equal(t, config1.GetEmptyColor(), defaultAdjacencyMatrixEmptyColor)
+	assert.Equal(t, config1.GetLabelRotation(), defaultAdjacencyMatrixLabelRotation)
+	assert.Equal(t, config1.GetTextColor(), defaultAdjacencyMatrixTextColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultAdjacencyMatrixFontFraction)
+
+	config2 := &AdjacencyMatrixConfig{Colormap: func(float64) color.Color { return color.Black }, EmptyColor: color.White, LabelRotation: 90, TextColor: color.White, FontFraction: 0.3}
+	assert.Equal(t, config2.GetColor(0.5), color.Black)
+	assert.Equal(t, config2.GetEmptyColor(), color.White)
+	assert.Equal(t, config2.GetLabelRotation(), 90.0)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetFontFraction(), 0.3)
+}
+
+func TestTimetableConfig(t *testing.T) {
+	config1 := &TimetableConfig{}
+	assert.Equal(t, config1.GetDays(), defaultTimetableDays)
+	assert.Equal(t, config1.GetStartTime(), defaultTimetableStartTime)
+	assert.Equal(t, config1.GetEndTime(), defaultTimetableEndTime)
+	assert.Equal(t, config1.GetSlotDuration(), defaultTimetableSlotDuration)
+	assert.Equal(t, config1.GetLineColor(), defaultTimetableLineColor)
+	assert.Equal(t, config1.GetTextColor(), defaultTimetableTextColor)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultTimetableBackgroundColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultTimetableFontFraction)
+
+	config2 := &TimetableConfig{Days: []string{"Sat"}, StartTime: 0, EndTime: 12 * time.Hour, SlotDuration: 15 * time.Minute, LineColor: color.Black, TextColor: color.White, BackgroundColor: color.Black, FontFraction: 0.3}
+	assert.Equal(t, config2.GetDays(), []string{"Sat"})
+	assert.Equal(t, config2.GetStartTime(), time.Duration(0))
+	assert.Equal(t, config2.GetEndTime(), 12*time.Hour)
+	assert.Equal(t, config2.GetSlotDuration(), 15*time.Minute)
+	assert.Equal(t, config2.GetLineColor(), color.Black)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetBackgroundColor(), color.Black)
+	assert.Equal(t, config2.GetFontFraction(), 0.3)
+}
+
+func TestKeyboardConfig(t *testing.T) {
+	config1 := &KeyboardConfig{}
+	assert.Equal(t, config1.GetKeyColor(), defaultKeyboardKeyColor)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultKeyboardBackgroundColor)
+	assert.Equal(t, config1.GetCornerRadius(), defaultKeyboardCornerRadius)
+	assert.Equal(t, config1.GetFontFraction(), defaultKeyboardFontFraction)
+
+	config2 := &KeyboardConfig{KeyColor: color.Black, BackgroundColor: color.White, CornerRadius: 2, FontFraction: 0.5}
+	assert.Equal(t, config2.GetKeyColor(), color.Black)
+	assert.Equal(t, config2.GetBackgroundColor(), color.White)
+	assert.Equal(t, config2.GetCornerRadius(), 2.0)
+	assert.Equal(t, config2.GetFontFraction(), 0.5)
+}
+
+func TestLEDMatrixConfig(t *testing.T) {
+	config1 := &LEDMatrixConfig{}
+	assert.Equal(t, config1.GetOnColor(), defaultLEDMatrixOnColor)
+	assert.Equal(t, config1.GetOffBrightness(), defaultLEDMatrixOffBrightness)
+	assert.Equal(t, config1.GetGlow(), defaultLEDMatrixGlow)
+	assert.Equal(t, config1.GetSize(), defaultLEDMatrixSize)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultLEDMatrixBackgroundColor)
+
+	config2 := &LEDMatrixConfig{OnColor: color.White, OffBrightness: 2, Glow: 1, Size: 0.5, BackgroundColor: color.White}
+	assert.Equal(t, config2.GetOnColor(), color.White)
+	assert.Equal(t, config2.GetOffBrightness(), 1.0)
+	assert.Equal(t, config2.GetGlow(), 1.0)
+	assert.Equal(t, config2.GetSize(), 0.5)
+	assert.Equal(t, config2.GetBackgroundColor(), color.White)
+
+	config3 := &LEDMatrixConfig{OffBrightness: -1, Glow: -1}
+	assert.Equal(t, config3.GetOffBrightness(), 0.0)
+	assert.Equal(t, config3.GetGlow(), 0.0)
+}
+
+func TestFromImageConfig(t *testing.T) {
+	config1 := &FromImageConfig{LineStrokeWidth: -1}
+	assert.Equal(t, config1.GetSampling(), SampleAverage)
+	assert.Equal(t, config1.GetPalette(), color.Palette(nil))
+	assert.Equal(t, config1.GetLineColor(), defaultFromImageLineColor)
+	assert.Equal(t, config1.GetLineStrokeWidth(), 0.0)
+
+	config2 := &FromImageConfig{Sampling: SampleDominant, Palette: []color.Color{color.Black}, LineColor: color.White, LineStrokeWidth: 2}
+	assert.Equal(t, config2.GetSampling(), SampleDominant)
+	assert.Equal(t, config2.GetPalette(), color.Palette{color.Black})
+	assert.Equal(t, config2.GetLineColor(), color.White)
+	assert.Equal(t, config2.GetLineStrokeWidth(), 2.0)
+}
+
+func TestCrossStitchConfig(t *testing.T) {
+	config1 := &CrossStitchConfig{}
+	assert.Equal(t, config1.GetSampling(), SampleAverage)
+	assert.Equal(t, config1.GetSymbols(), []rune(defaultCrossStitchSymbols))
+	assert.Equal(t, config1.GetMajorLineInterval(), defaultCrossStitchMajorLineInterval)
+	assert.Equal(t, config1.GetLineColor(), defaultCrossStitchLineColor)
+	assert.Equal(t, config1.GetLineStrokeWidth(), defaultCrossStitchLineStrokeWidth)
+	assert.Equal(t, config1.GetMajorLineColor(), defaultCrossStitchMajorLineColor)
+	assert.Equal(t, config1.GetMajorLineStrokeWidth(), defaultCrossStitchMajorLineStrokeWidth)
+	assert.Equal(t, config1.GetTextColor(), defaultCrossStitchTextColor)
+	assert.Equal(t, config1.GetBackgroundColor(), defaultCrossStitchBackgroundColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultCrossStitchFontFraction)
+
+	config2 := &CrossStitchConfig{Sampling: SampleDominant, Symbols: "ab", MajorLineInterval: 5, LineColor: color.Black, LineStrokeWidth: 2, MajorLineColor: color.White, MajorLineStrokeWidth: 4, TextColor: color.White, BackgroundColor: color.Black, FontFraction: 0.5}
+	assert.Equal(t, config2.GetSampling(), SampleDominant)
+	assert.Equal(t, config2.GetSymbols(), []rune("ab"))
+	assert.Equal(t, config2.GetMajorLineInterval(), 5)
+	assert.Equal(t, config2.GetLineColor(), color.Black)
+	assert.Equal(t, config2.GetLineStrokeWidth(), 2.0)
+	assert.Equal(t, config2.GetMajorLineColor(), color.White)
+	assert.Equal(t, config2.GetMajorLineStrokeWidth(), 4.0)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetBackgroundColor(), color.Black)
+	assert.Equal(t, config2.GetFontFraction(), 0.5)
+}
+
+func TestNorthArrowConfig(t *testing.T) {
+	config1 := &NorthArrowConfig{}
+	assert.Equal(t, config1.GetPadding(), defaultDecorationPadding)
+	assert.Equal(t, config1.GetSize(), defaultNorthArrowSize)
+	assert.Equal(t, config1.GetColor(), defaultDecorationColor)
+
+	config2 := &NorthArrowConfig{Padding: 1, Size: 2, Color: color.White}
+	assert.Equal(t, config2.GetPadding(), 1.0)
+	assert.Equal(t, config2.GetSize(), 2.0)
+	assert.Equal(t, config2.GetColor(), color.White)
+}
+
+func TestScaleBarConfig(t *testing.T) {
+	config1 := &ScaleBarConfig{}
+	assert.Equal(t, config1.GetPadding(), defaultDecorationPadding)
+	assert.Equal(t, config1.GetSegments(), defaultScaleBarSegments)
+	assert.Equal(t, config1.GetHeight(), defaultScaleBarHeight)
+	assert.Equal(t, config1.GetColor(), defaultDecorationColor)
+
+	config2 := &ScaleBarConfig{Padding: 1, Segments: 2, Height: 3, Color: color.White}
+	assert.Equal(t, config2.GetPadding(), 1.0)
+	assert.Equal(t, config2.GetSegments(), 2)
+	assert.Equal(t, config2.GetHeight(), 3.0)
+	assert.Equal(t, config2.GetColor(), color.White)
+}
+
+func TestInsetLocatorConfig(t *testing.T) {
+	config1 := &InsetLocatorConfig{}
+	assert.Equal(t, config1.GetPadding(), defaultDecorationPadding)
+	assert.Equal(t, config1.GetSize(), defaultInsetLocatorSize)
+	assert.Equal(t, config1.GetBorderColor(), defaultDecorationColor)
+	assert.Equal(t, config1.GetBorderStrokeWidth(), defaultInsetLocatorBorderStrokeWidth)
+	assert.Equal(t, config1.GetRegionColor(), defaultInsetLocatorRegionColor)
+
+	config2 := &InsetLocatorConfig{Padding: 1, Size: 2, BorderColor: color.White, BorderStrokeWidth: 3, RegionColor: color.Black}
+	assert.Equal(t, config2.GetPadding(), 1.0)
+	assert.Equal(t, config2.GetSize(), 1.0)
+	assert.Equal(t, config2.GetBorderColor(), color.White)
+	assert.Equal(t, config2.GetBorderStrokeWidth(), 3.0)
+	assert.Equal(t, config2.GetRegionColor(), color.Black)
+}
+
+func TestBenchmarkConfig(t *testing.T) {
+	config1 := &BenchmarkConfig{}
+	assert.Equal(t, config1.GetIterations(), defaultBenchmarkIterations)
+
+	config2 := &BenchmarkConfig{Iterations: 1}
+	assert.Equal(t, config2.GetIterations(), 1)
+}
+
+func TestImageDrawConfig(t *testing.T) {
+	config1 := &ImageDrawConfig{}
+	assert.Equal(t, config1.GetFit(), FitContain)
+	assert.Equal(t, config1.GetRotate(), 0.0)
+	assert.Equal(t, config1.GetOpacity(), 1.0)
+	assert.Equal(t, config1.GetFilter(), ScaleBilinear)
+	assert.False(t, config1.IsFlipHorizontal())
+	assert.False(t, config1.IsFlipVertical())
+
+	config2 := &ImageDrawConfig{Fit: FitCover, Rotate: 90, Opacity: 0.5, Filter: ScaleNearest, FlipHorizontal: true, FlipVertical: true}
+	assert.Equal(t, config2.GetFit(), FitCover)
+	assert.Equal(t, config2.GetRotate(), 90.0)
+	assert.Equal(t, config2.GetOpacity(), 0.5)
+	assert.Equal(t, config2.GetFilter(), ScaleNearest)
+	assert.True(t, config2.IsFlipHorizontal())
+	assert.True(t, config2.IsFlipVertical())
+}
+
+func TestGIFConfig(t *testing.T) {
+	config1 := &GIFConfig{Colors: 300}
+	assert.Equal(t, config1.GetColors(), defaultGIFColors)
+	assert.Equal(t, config1.IsDither(), false)
+
+	config2 := &GIFConfig{Colors: 16, Dither: true}
+	assert.Equal(t, config2.GetColors(), 16)
+	assert.Equal(t, config2.IsDither(), true)
+}
+
+func TestLabelDeclutterConfig(t *testing.T) {
+	config1 := &LabelDeclutterConfig{}
+	assert.Equal(t, config1.GetMode(), DeclutterNone)
+	assert.Equal(t, config1.GetPadding(), 0.0)
+	assert.Equal(t, config1.GetEllipsis(), defaultLabelEllipsis)
+
+	config2 := &LabelDeclutterConfig{Mode: DeclutterSample, Padding: 2, Ellipsis: "..."}
+	assert.Equal(t, config2.GetMode(), DeclutterSample)
+	assert.Equal(t, config2.GetPadding(), 2.0)
+	assert.Equal(t, config2.GetEllipsis(), "...")
+}
+
+func TestPathRoutingConfig(t *testing.T) {
+	config1 := &PathRoutingConfig{}
+	assert.Equal(t, config1.GetSpacing(), defaultPathRoutingSpacing)
+	assert.Equal(t, config1.GetJumpRadius(), defaultPathJumpRadius)
+
+	config2 := &PathRoutingConfig{Spacing: 2, JumpRadius: 6}
+	assert.Equal(t, config2.GetSpacing(), 2.0)
+	assert.Equal(t, config2.GetJumpRadius(), 6.0)
+}
+
+func TestEdgeConfig(t *testing.T) {
+	config1 := &EdgeConfig{}
+	assert.Equal(t, config1.GetStrokeWidth(), defaultEdgeStrokeWidth)
+	assert.Equal(t, config1.GetDashes(), 0.0)
+	assert.Equal(t, config1.GetColor(), defaultEdgeColor)
+
+	config2 := &EdgeConfig{StrokeWidth: 1, Dashes: 2, Color: color.White}
+	assert.Equal(t, config2.GetStrokeWidth(), 1.0)
+	assert.Equal(t, config2.GetDashes(), 2.0)
+	assert.Equal(t, config2.GetColor(), color.White)
+}
+
+func TestCornerConfig(t *testing.T) {
+	config1 := &CornerConfig{}
+	assert.Equal(t, config1.GetSize(), defaultCornerSize)
+	assert.Equal(t, config1.GetColor(), defaultCornerColor)
+
+	config2 := &CornerConfig{Size: 4, Color: color.White}
+	assert.Equal(t, config2.GetSize(), 4.0)
+	assert.Equal(t, config2.GetColor(), color.White)
+}
+
+func TestBorderConfig(t *testing.T) {
+	config1 := &BorderConfig{}
+	assert.Equal(t, config1.GetStrokeWidth(), defaultCellBorderStrokeWidth)
+	assert.Equal(t, config1.GetDashes(), 0.0)
+	assert.Equal(t, config1.GetColor(), defaultCellBorderColor)
+	assert.Equal(t, config1.GetSides(), []Side{SideTop, SideRight, SideBottom, SideLeft})
+	assert.Equal(t, config1.GetSideConfig(SideLeft), EdgeConfig{StrokeWidth: defaultCellBorderStrokeWidth, Color: defaultCellBorderColor})
+
+	config2 := &BorderConfig{
+		StrokeWidth: 2, Dashes: 4, Color: color.White, Sides: []Side{SideTop, SideBottom},
+		Top: &EdgeConfig{StrokeWidth: 6}, Bottom: &EdgeConfig{Color: color.Black},
+	}
+	assert.Equal(t, config2.GetStrokeWidth(), 2.0)
+	assert.Equal(t, config2.GetDashes(), 4.0)
+	assert.Equal(t, config2.GetColor(), color.White)
+	assert.Equal(t, config2.GetSides(), []Side{SideTop, SideBottom})
+	assert.Equal(t, config2.GetSideConfig(SideTop), EdgeConfig{StrokeWidth: 6, Dashes: 4, Color: color.White})
+	assert.Equal(t, config2.GetSideConfig(SideBottom), EdgeConfig{StrokeWidth: 2, Dashes: 4, Color: color.Black})
+}
+
+func TestPrintConfig(t *testing.T) {
+	config1 := &PrintConfig{Overlap: -1, Bleed: -1}
+	assert.Equal(t, config1.GetPageWidth(), defaultPrintPageWidth)
+	assert.Equal(t, config1.GetPageHeight(), defaultPrintPageHeight)
+	assert.Equal(t, config1.GetMargin(), defaultPrintMargin)
+	assert.Equal(t, config1.GetOverlap(), 0.0)
+	assert.Equal(t, config1.GetBleed(), 0.0)
+	assert.Equal(t, config1.IsCropMarks(), false)
+	assert.Equal(t, config1.GetCropMarkLength(), defaultPrintCropMarkLength)
+	assert.Equal(t, config1.GetCropMarkColor(), defaultPrintCropMarkColor)
+	assert.Equal(t, config1.IsRegistrationMarks(), false)
+
+	config2 := &PrintConfig{PageWidth: 297, PageHeight: 420, Margin: 5, Overlap: 8, Bleed: 3, CropMarks: true, CropMarkLength: 3, CropMarkColor: color.White, RegistrationMarks: true}
+	assert.Equal(t, config2.GetPageWidth(), 297.0)
+	assert.Equal(t, config2.GetPageHeight(), 420.0)
+	assert.Equal(t, config2.GetMargin(), 5.0)
+	assert.Equal(t, config2.GetOverlap(), 8.0)
+	assert.Equal(t, config2.GetBleed(), 3.0)
+	assert.Equal(t, config2.IsCropMarks(), true)
+	assert.Equal(t, config2.GetCropMarkLength(), 3.0)
+	assert.Equal(t, config2.GetCropMarkColor(), color.White)
+	assert.Equal(t, config2.IsRegistrationMarks(), true)
+}
+
+func TestColorbarConfig(t *testing.T) {
+	config1 := &ColorbarConfig{}
+	assert.Equal(t, config1.GetThickness(), defaultColorbarThickness)
+	assert.Equal(t, config1.GetTickLength(), defaultColorbarTickLength)
+	assert.Equal(t, config1.GetNumberFormat(), defaultColorbarNumberFormat)
+	assert.Equal(t, config1.GetTextColor(), defaultColorbarTextColor)
+
+	config2 := &ColorbarConfig{Thickness: 1, TickLength: 2, NumberFormat: &NumberFormat{Precision: 1}, TextColor: color.White}
+	assert.Equal(t, config2.GetThickness(), 1.0)
+	assert.Equal(t, config2.GetTickLength(), 2.0)
+	assert.Equal(t, config2.GetNumberFormat(), NumberFormat{Precision: 1})
+	assert.Equal(t, config2.GetTextColor(), color.White)
+}
+
+func TestCorrelationMatrixConfig(t *testing.T) {
+	config1 := &CorrelationMatrixConfig{}
+	assert.Equal(t, config1.GetColor(0.5), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, config1.GetColor(1), defaultCorrelationMatrixHighColor)
+	assert.Equal(t, config1.GetNumberFormat(), defaultCorrelationMatrixNumberFormat)
+	assert.Equal(t, config1.GetLabelRotation(), defaultCorrelationMatrixLabelRotation)
+	assert.Equal(t, config1.GetTextColor(), defaultCorrelationMatrixTextColor)
+	assert.Equal(t, config1.GetFontFraction(), defaultCorrelationMatrixFontFraction)
+
+	config2 := &CorrelationMatrixConfig{
+		Colormap:      func(fraction float64) color.Color { return color.Gray{Y: uint8(fraction * 255)} },
+		NumberFormat:  &NumberFormat{Precision: 1},
+		LabelRotation: 90,
+		TextColor:     color.White,
+		FontFraction:  0.5,
+	}
+	assert.Equal(t, config2.GetColor(1), color.Gray{Y: 255})
+	assert.Equal(t, config2.GetNumberFormat(), NumberFormat{Precision: 1})
+	assert.Equal(t, config2.GetLabelRotation(), 90.0)
+	assert.Equal(t, config2.GetTextColor(), color.White)
+	assert.Equal(t, config2.GetFontFraction(), 0.5)
+}
+
+func TestGraphConfig(t *testing.T) {
+	config1 := &GraphConfig{}
+	assert.Equal(t, config1.GetNodeScale(), defaultGraphNodeScale)
+	assert.Equal(t, config1.GetNodeColor(), defaultGraphNodeColor)
+	assert.Equal(t, config1.GetEdgeStrokeWidth(), defaultGraphEdgeStrokeWidth)
+	assert.Equal(t, config1.GetEdgeColor(), defaultGraphEdgeColor)
+	assert.Equal(t, config1.GetLabelColor(), defaultGraphTextColor)
+
+	config2 := &GraphConfig{NodeScale: 0.5, NodeColor: color.White, EdgeStrokeWidth: 2, EdgeColor: color.White, LabelColor: color.White}
+	assert.Equal(t, config2.GetNodeScale(), 0.5)
+	assert.Equal(t, config2.GetNodeColor(), color.White)
+	assert.Equal(t, config2.GetEdgeStrokeWidth(), 2.0)
+	assert.Equal(t, config2.GetEdgeColor(), color.White)
+	assert.Equal(t, config2.GetLabelColor(), color.White)
 }
 
 func TestFirstRectangleConfig(t *testing.T) {
@@ -166,3 +810,307 @@ func TestFirstStringConfig(t *testing.T) {
 	config2 := getFirstStringConfig(config1)
 	assert.Equal(t, config2, config1)
 }
+
+func TestFirstContourConfig(t *testing.T) {
+	config1 := getFirstContourConfig()
+	assert.Equal(t, config1, ContourConfig{})
+
+	config2 := getFirstContourConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstVectorFieldConfig(t *testing.T) {
+	config1 := getFirstVectorFieldConfig()
+	assert.Equal(t, config1, VectorFieldConfig{})
+
+	config2 := getFirstVectorFieldConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstGeoJSONConfig(t *testing.T) {
+	config1 := getFirstGeoJSONConfig()
+	assert.Equal(t, config1, GeoJSONConfig{})
+
+	config2 := getFirstGeoJSONConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstCalendarConfig(t *testing.T) {
+	config1 := getFirstCalendarConfig()
+	assert.Equal(t, config1, CalendarConfig{})
+
+	config2 := getFirstCalendarConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstConfusionMatrixConfig(t *testing.T) {
+	config1 := getFirstConfusionMatrixConfig()
+	assert.Equal(t, config1, ConfusionMatrixConfig{})
+
+	config2 := getFirstConfusionMatrixConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstSeatingConfig(t *testing.T) {
+	config1 := getFirstSeatingConfig()
+	assert.Equal(t, config1, SeatingConfig{})
+
+	config2 := getFirstSeatingConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstBingoConfig(t *testing.T) {
+	config1 := getFirstBingoConfig()
+	assert.Equal(t, config1, BingoConfig{})
+
+	config2 := getFirstBingoConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstBingoCardConfig(t *testing.T) {
+	config1 := getFirstBingoCardConfig()
+	assert.Equal(t, config1, BingoCardConfig{})
+
+	config2 := getFirstBingoCardConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstNonogramConfig(t *testing.T) {
+	config1 := getFirstNonogramConfig()
+	assert.Equal(t, config1, NonogramConfig{})
+
+	config2 := getFirstNonogramConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstBattleshipConfig(t *testing.T) {
+	config1 := getFirstBattleshipConfig()
+	assert.Equal(t, config1, BattleshipConfig{})
+
+	config2 := getFirstBattleshipConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstBarChartConfig(t *testing.T) {
+	config1 := getFirstBarChartConfig()
+	assert.Equal(t, config1, BarChartConfig{})
+
+	config2 := getFirstBarChartConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstWaffleConfig(t *testing.T) {
+	config1 := getFirstWaffleConfig()
+	assert.Equal(t, config1, WaffleConfig{})
+
+	config2 := getFirstWaffleConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstTreemapConfig(t *testing.T) {
+	config1 := getFirstTreemapConfig()
+	assert.Equal(t, config1, TreemapConfig{})
+
+	config2 := getFirstTreemapConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstAdjacencyMatrixConfig(t *testing.T) {
+	config1 := getFirstAdjacencyMatrixConfig()
+	assert.Equal(t, config1, AdjacencyMatrixConfig{})
+
+	config2 := getFirstAdjacencyMatrixConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstTimetableConfig(t *testing.T) {
+	config1 := getFirstTimetableConfig()
+	assert.Equal(t, config1, TimetableConfig{})
+
+	config2 := getFirstTimetableConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstKeyboardConfig(t *testing.T) {
+	config1 := getFirstKeyboardConfig()
+	assert.Equal(t, config1, KeyboardConfig{})
+
+	config2 := getFirstKeyboardConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstLEDMatrixConfig(t *testing.T) {
+	config1 := getFirstLEDMatrixConfig()
+	assert.Equal(t, config1, LEDMatrixConfig{})
+
+	config2 := getFirstLEDMatrixConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstFromImageConfig(t *testing.T) {
+	config1 := getFirstFromImageConfig()
+	assert.Equal(t, config1, FromImageConfig{})
+
+	config2 := getFirstFromImageConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstCrossStitchConfig(t *testing.T) {
+	config1 := getFirstCrossStitchConfig()
+	assert.Equal(t, config1, CrossStitchConfig{})
+
+	config2 := getFirstCrossStitchConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstNorthArrowConfig(t *testing.T) {
+	config1 := getFirstNorthArrowConfig()
+	assert.Equal(t, config1, NorthArrowConfig{})
+
+	config2 := getFirstNorthArrowConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstScaleBarConfig(t *testing.T) {
+	config1 := getFirstScaleBarConfig()
+	assert.Equal(t, config1, ScaleBarConfig{})
+
+	config2 := getFirstScaleBarConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstInsetLocatorConfig(t *testing.T) {
+	config1 := getFirstInsetLocatorConfig()
+	assert.Equal(t, config1, InsetLocatorConfig{})
+
+	config2 := getFirstInsetLocatorConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstBenchmarkConfig(t *testing.T) {
+	config1 := getFirstBenchmarkConfig()
+	assert.Equal(t, config1, BenchmarkConfig{})
+
+	config2 := getFirstBenchmarkConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstImageDrawConfig(t *testing.T) {
+	config1 := getFirstImageDrawConfig()
+	assert.Equal(t, config1, ImageDrawConfig{})
+
+	config2 := getFirstImageDrawConfig(ImageDrawConfig{Fit: FitStretch})
+	assert.Equal(t, config2, ImageDrawConfig{Fit: FitStretch})
+}
+
+func TestFirstGIFConfig(t *testing.T) {
+	config1 := getFirstGIFConfig()
+	assert.Equal(t, config1, GIFConfig{})
+
+	config2 := getFirstGIFConfig(GIFConfig{Colors: 16})
+	assert.Equal(t, config2, GIFConfig{Colors: 16})
+}
+
+func TestFirstEdgeConfig(t *testing.T) {
+	config1 := getFirstEdgeConfig()
+	assert.Equal(t, config1, EdgeConfig{})
+
+	config2 := getFirstEdgeConfig(EdgeConfig{StrokeWidth: 2})
+	assert.Equal(t, config2, EdgeConfig{StrokeWidth: 2})
+}
+
+func TestFirstCornerConfig(t *testing.T) {
+	config1 := getFirstCornerConfig()
+	assert.Equal(t, config1, CornerConfig{})
+
+	config2 := getFirstCornerConfig(CornerConfig{Size: 2})
+	assert.Equal(t, config2, CornerConfig{Size: 2})
+}
+
+func TestSideConfig(t *testing.T) {
+	config1 := getSideConfig(nil)
+	assert.Equal(t, config1, EdgeConfig{})
+
+	config2 := getSideConfig(&EdgeConfig{StrokeWidth: 2})
+	assert.Equal(t, config2, EdgeConfig{StrokeWidth: 2})
+}
+
+func TestFirstPrintConfig(t *testing.T) {
+	config1 := getFirstPrintConfig()
+	assert.Equal(t, config1, PrintConfig{})
+
+	config2 := getFirstPrintConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstColorbarConfig(t *testing.T) {
+	config1 := getFirstColorbarConfig()
+	assert.Equal(t, config1, ColorbarConfig{})
+
+	config2 := getFirstColorbarConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstCorrelationMatrixConfig(t *testing.T) {
+	config1 := getFirstCorrelationMatrixConfig()
+	assert.Equal(t, config1, CorrelationMatrixConfig{})
+
+	config2 := getFirstCorrelationMatrixConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstGraphConfig(t *testing.T) {
+	config1 := getFirstGraphConfig()
+	assert.Equal(t, config1, GraphConfig{})
+
+	config2 := getFirstGraphConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestMoveConfig(t *testing.T) {
+	config1 := &MoveConfig{}
+	assert.Equal(t, config1.GetArc(), 0.0)
+
+	config2 := &MoveConfig{Arc: 0.5}
+	assert.Equal(t, config2.GetArc(), 0.5)
+}
+
+func TestSpriteConfig(t *testing.T) {
+	config1 := &SpriteConfig{}
+	assert.Equal(t, config1.GetFilter(), ScaleBilinear)
+	assert.False(t, config1.IsFlipHorizontal())
+	assert.False(t, config1.IsFlipVertical())
+
+	config2 := &SpriteConfig{Filter: ScaleNearest, FlipHorizontal: true, FlipVertical: true}
+	assert.Equal(t, config2.GetFilter(), ScaleNearest)
+	assert.True(t, config2.IsFlipHorizontal())
+	assert.True(t, config2.IsFlipVertical())
+}
+
+func TestFirstSpriteConfig(t *testing.T) {
+	assert.Equal(t, getFirstSpriteConfig(), SpriteConfig{})
+	assert.Equal(t, getFirstSpriteConfig(SpriteConfig{Filter: ScaleNearest}), SpriteConfig{Filter: ScaleNearest})
+}
+
+func TestNineSliceConfig(t *testing.T) {
+	config1 := &NineSliceConfig{}
+	assert.Equal(t, config1.GetLeft(), 0)
+	assert.Equal(t, config1.GetTop(), 0)
+	assert.Equal(t, config1.GetRight(), 0)
+	assert.Equal(t, config1.GetBottom(), 0)
+	assert.Equal(t, config1.GetScale(), defaultNineSliceScale)
+	assert.Equal(t, config1.GetFilter(), ScaleBilinear)
+
+	config2 := &NineSliceConfig{Left: 1, Top: 2, Right: 3, Bottom: 4, Scale: 2, Filter: ScaleNearest}
+	assert.Equal(t, config2.GetLeft(), 1)
+	assert.Equal(t, config2.GetTop(), 2)
+	assert.Equal(t, config2.GetRight(), 3)
+	assert.Equal(t, config2.GetBottom(), 4)
+	assert.Equal(t, config2.GetScale(), 2.0)
+	assert.Equal(t, config2.GetFilter(), ScaleNearest)
+}
+
+func TestFirstNineSliceConfig(t *testing.T) {
+	assert.Equal(t, getFirstNineSliceConfig(), NineSliceConfig{})
+	assert.Equal(t, getFirstNineSliceConfig(NineSliceConfig{Left: 1}), NineSliceConfig{Left: 1})
+}
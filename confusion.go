@@ -0,0 +1,192 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+
+	"golang.org/x/image/font"
+)
+
+// RenderConfusionMatrix creates a confusion matrix image with a row per true class and a column per predicted
+// class. Cells are colored by their count, or by their share of the row or column when normalized, and a colorbar
+// shows the color scale. Counts and class labels are drawn when a font is configured. Class labels default to
+// class indexes and the image is sized for square cells unless its dimensions are set
+func RenderConfusionMatrix(matrix [][]int, classLabels []string, confusionMatrixConfigs ...ConfusionMatrixConfig) (*Gridder, error) {
+	confusionMatrixConfig := getFirstConfusionMatrixConfig(confusionMatrixConfigs...)
+
+	classes := len(matrix)
+	if classes == 0 {
+		return nil, errNoRows
+	}
+	if classLabels == nil {
+		classLabels = make([]string, classes)
+		for i := range classLabels {
+			classLabels[i] = strconv.Itoa(i)
+		}
+	}
+	if len(classLabels) != classes {
+		return nil, fmt.Errorf("%w: %d class labels for %d classes", errMatrixDimensions, len(classLabels), classes)
+	}
+	for row := range matrix {
+		if len(matrix[row]) != classes {
+			return nil, errMatrixDimensions
+		}
+		for column, count := range matrix[row] {
+			if count < 0 {
+				return nil, fmt.Errorf("%w: count %d at %d,%d", errInvalidValue, count, row, column)
+			}
+		}
+	}
+
+	fractions, maxValue := normalizeConfusionMatrix(matrix, confusionMatrixConfig.Normalization)
+
+	g, err := newMatrixGridder(classes, confusionMatrixConfig.ImageConfig, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	countFormat := NumberFormat{}
+	if confusionMatrixConfig.Normalization != NormalizeNone {
+		countFormat = confusionMatrixConfig.GetNumberFormat()
+	}
+
+	var fontFace font.Face
+	if confusionMatrixConfig.Font != nil {
+		fontFace = g.FontFace(confusionMatrixConfig.Font, confusionMatrixConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		for row := 0; row < classes; row++ {
+			for column := 0; column < classes; column++ {
+				fill := confusionMatrixConfig.GetColor(fractions[row][column])
+				err := g.paintCell(row, column+1, fill)
+				if err != nil {
+					return err
+				}
+
+				if fontFace == nil {
+					continue
+				}
+
+				value := float64(matrix[row][column])
+				if confusionMatrixConfig.Normalization != NormalizeNone {
+					value = fractions[row][column]
+				}
+				text := g.formatNumber(countFormat, value)
+				err = g.drawString(row, column+1, text, fontFace, StringConfig{Color: contrastColor(fill)})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if confusionMatrixConfig.EmphasizeDiagonal {
+			for i := 0; i < classes; i++ {
+				g.strokeCell(i, i+1, confusionMatrixConfig.GetDiagonalStrokeWidth(), confusionMatrixConfig.GetDiagonalColor())
+			}
+		}
+
+		err := g.drawColorbar(0, classes+1, classes-1, classes+1, confusionMatrixConfig.GetColor, 0, maxValue, []float64{0, maxValue}, ColorbarConfig{
+			FontFace:     fontFace,
+			NumberFormat: &countFormat,
+			TextColor:    confusionMatrixConfig.GetTextColor(),
+		})
+		if err != nil || fontFace == nil {
+			return err
+		}
+
+		g.ctx.Push()
+		g.ctx.SetFontFace(fontFace)
+		g.ctx.SetColor(confusionMatrixConfig.GetTextColor())
+		for i, label := range classLabels {
+			g.drawAnchoredString(i, 0, label, 1, 0.35)
+			g.drawAnchoredString(classes, i+1, label, 0.5, 0.35)
+		}
+		g.ctx.Pop()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// normalizeConfusionMatrix maps counts to fractions of the color scale and gets the value at its top, which is the
+// largest count or a share of 1 when normalized or without counts. Rows and columns without counts stay at 0
+func normalizeConfusionMatrix(matrix [][]int, normalization Normalization) ([][]float64, float64) {
+	classes := len(matrix)
+	totals := make([]int, classes)
+	var maxCount int
+	for row := range matrix {
+		for column, count := range matrix[row] {
+			switch normalization {
+			case NormalizeRows:
+				totals[row] += count
+			case NormalizeColumns:
+				totals[column] += count
+			}
+			maxCount = maxInt(maxCount, count)
+		}
+	}
+
+	fractions := make([][]float64, classes)
+	for row := range matrix {
+		fractions[row] = make([]float64, classes)
+		for column, count := range matrix[row] {
+			var total int
+			switch normalization {
+			case NormalizeRows:
+				total = totals[row]
+			case NormalizeColumns:
+				total = totals[column]
+			default:
+				total = maxCount
+			}
+			if total > 0 {
+				fractions[row][column] = float64(count) / float64(total)
+			}
+		}
+	}
+
+	if normalization != NormalizeNone || maxCount == 0 {
+		return fractions, 1
+	}
+	return fractions, float64(maxCount)
+}
+
+// strokeCell outlines the inside of a cell
+func (g *Gridder) strokeCell(row int, column int, strokeWidth float64, strokeColor color.Color) {
+	cellWidth, cellHeight := g.getCellDimensions(row, column)
+	inset := g.gridConfig.GetLineStrokeWidth()/2 + strokeWidth/2
+	paintRectangle(g.ctx, g.imageConfig.GetRough(), g.getCellCenter(row, column), RectangleConfig{
+		Width:       cellWidth - 2*inset,
+		Height:      cellHeight - 2*inset,
+		Color:       strokeColor,
+		Stroke:      true,
+		StrokeWidth: strokeWidth,
+	})
+}
+
+// newMatrixGridder creates the layout of matrix presets: a wide label column, the matrix, a colorbar and a column of
+// colorbar ticks, above a row of column labels that is labelRows cells high. The image is sized for square cells
+// unless its dimensions are set
+func newMatrixGridder(size int, imageConfig ImageConfig, labelRows int) (*Gridder, error) {
+	rows, columns := size+1, size+3
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = (columns + 1) * defaultMatrixCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = (rows + labelRows - 1) * defaultMatrixCellSize
+	}
+
+	return New(imageConfig, GridConfig{
+		Rows:               rows,
+		Columns:            columns,
+		RowsHeightOffset:   []*RowHeightOffset{{Row: size, Offset: float64(imageConfig.Height*(labelRows-1)) / float64(rows+labelRows-1)}},
+		ColumnsWidthOffset: []*ColumnWidthOffset{{Column: 0, Offset: float64(imageConfig.Width) / float64(columns+1)}},
+		LineStrokeWidth:    defaultMatrixGap,
+		LineColor:          color.Transparent,
+		BorderColor:        color.Transparent,
+	})
+}
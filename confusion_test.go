@@ -0,0 +1,82 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestRenderConfusionMatrix(t *testing.T) {
+	gridder, err := RenderConfusionMatrix([][]int{{8, 2}, {1, 9}}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 360)
+	assert.Equal(t, gridder.ctx.Height(), 180)
+
+	colormap := LinearColormap(color.White, defaultConfusionMatrixColor)
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 30)), colormap(8.0/9))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 90)), colormap(1.0/9))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(210, 90)), defaultConfusionMatrixColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(270, 0)), defaultConfusionMatrixColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(270, 60)), colormap(0.5))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(250, 60)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(122, 30)), colormap(8.0/9))
+}
+
+func TestRenderConfusionMatrixNormalized(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	confusionMatrixConfig := ConfusionMatrixConfig{
+		ImageConfig:       ImageConfig{Width: 360, Height: 180},
+		Normalization:     NormalizeRows,
+		EmphasizeDiagonal: true,
+		Font:              ttf,
+	}
+
+	gridder, err := RenderConfusionMatrix([][]int{{8, 2}, {1, 9}}, []string{"cat", "dog"}, confusionMatrixConfig)
+	assert.Nil(t, err)
+
+	colormap := LinearColormap(color.White, defaultConfusionMatrixColor)
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(122, 30)), defaultConfusionMatrixDiagonalColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(182, 30)), colormap(0.2))
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"80%"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 0}], []string{"dog"})
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 1}], []string{"cat"})
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 4}], []string{"100%"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 4}], []string{"0%"})
+
+	confusionMatrixConfig.Normalization = NormalizeColumns
+	confusionMatrixConfig.NumberFormat = &NumberFormat{Precision: 2}
+	gridder, err = RenderConfusionMatrix([][]int{{8, 2}, {0, 0}}, []string{"cat", "dog"}, confusionMatrixConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"1.00"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 1}], []string{"0.00"})
+}
+
+func TestRenderConfusionMatrixErrors(t *testing.T) {
+	_, err := RenderConfusionMatrix(nil, nil)
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = RenderConfusionMatrix([][]int{{1, 2}, {3}}, nil)
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+
+	_, err = RenderConfusionMatrix([][]int{{1}}, []string{"a", "b"})
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+
+	_, err = RenderConfusionMatrix([][]int{{-1}}, nil)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestNormalizeConfusionMatrix(t *testing.T) {
+	fractions, maxValue := normalizeConfusionMatrix([][]int{{1, 3}, {0, 0}}, NormalizeRows)
+	assert.Equal(t, fractions, [][]float64{{0.25, 0.75}, {0, 0}})
+	assert.Equal(t, maxValue, 1.0)
+
+	fractions, maxValue = normalizeConfusionMatrix([][]int{{1, 3}, {0, 0}}, NormalizeNone)
+	assert.Equal(t, fractions, [][]float64{{1.0 / 3, 1}, {0, 0}})
+	assert.Equal(t, maxValue, 3.0)
+}
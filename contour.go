@@ -0,0 +1,144 @@
+package gridder
+
+import (
+	"github.com/fogleman/gg"
+)
+
+const (
+	edgeTop = iota
+	edgeRight
+	edgeBottom
+	edgeLeft
+)
+
+// contourSegments maps a marching squares case to pairs of edges joined by a segment.
+// Saddle cases (5 and 10) are resolved separately
+var contourSegments = [16][]int{
+	1:  {edgeLeft, edgeBottom},
+	2:  {edgeBottom, edgeRight},
+	3:  {edgeLeft, edgeRight},
+	4:  {edgeTop, edgeRight},
+	6:  {edgeTop, edgeBottom},
+	7:  {edgeLeft, edgeTop},
+	8:  {edgeLeft, edgeTop},
+	9:  {edgeTop, edgeBottom},
+	11: {edgeTop, edgeRight},
+	12: {edgeLeft, edgeRight},
+	13: {edgeBottom, edgeRight},
+	14: {edgeLeft, edgeBottom},
+}
+
+// DrawContours draws iso-lines through the cell centers for each level of a value matrix using marching squares.
+// The matrix must have one value per cell
+func (g *Gridder) DrawContours(values [][]float64, levels []float64, contourConfigs ...ContourConfig) error {
+	return g.retain(func() error {
+		return g.drawContours(values, levels, contourConfigs...)
+	})
+}
+
+func (g *Gridder) drawContours(values [][]float64, levels []float64, contourConfigs ...ContourConfig) error {
+	err := g.verifyMatrix(len(values), func(row int) int { return len(values[row]) })
+	if err != nil {
+		return err
+	}
+
+	err = validateValues(verifyFinite("value", values), verifyFinite("level", [][]float64{levels}))
+	if err != nil {
+		return err
+	}
+
+	contourConfig := getFirstContourConfig(contourConfigs...).withStyle(g.gridConfig.GetCellStyle())
+	err = contourConfig.validate(g.maxStrokeWidth())
+	if err != nil {
+		return err
+	}
+
+	rows, columns := g.gridConfig.GetRows(), g.gridConfig.GetColumns()
+
+	g.ctx.Push()
+	dashes := contourConfig.GetDashes()
+	if dashes > 0 {
+		g.ctx.SetDash(dashes)
+	} else {
+		g.ctx.SetDash()
+	}
+	g.ctx.SetColor(contourConfig.GetColor())
+	g.stroke(contourConfig.GetStrokeWidth(), func() {
+		for _, level := range levels {
+			for row := 0; row < rows-1; row++ {
+				for column := 0; column < columns-1; column++ {
+					g.traceContourSquare(values, level, row, column)
+				}
+			}
+		}
+	})
+	g.ctx.Pop()
+	return nil
+}
+
+func (g *Gridder) traceContourSquare(values [][]float64, level float64, row int, column int) {
+	corners := [4]Cell{
+		{Row: row, Column: column},
+		{Row: row, Column: column + 1},
+		{Row: row + 1, Column: column + 1},
+		{Row: row + 1, Column: column},
+	}
+
+	var index int
+	var sum float64
+	for i, corner := range corners {
+		value := values[corner.Row][corner.Column]
+		sum += value
+		if value >= level {
+			index |= 8 >> uint(i)
+		}
+	}
+
+	edges := contourSegments[index]
+	switch index {
+	case 5:
+		edges = []int{edgeTop, edgeRight, edgeLeft, edgeBottom}
+		if sum/4 >= level {
+			edges = []int{edgeLeft, edgeTop, edgeBottom, edgeRight}
+		}
+	case 10:
+		edges = []int{edgeLeft, edgeTop, edgeBottom, edgeRight}
+		if sum/4 >= level {
+			edges = []int{edgeTop, edgeRight, edgeLeft, edgeBottom}
+		}
+	}
+
+	for i := 0; i+1 < len(edges); i += 2 {
+		start := g.contourEdgePoint(values, level, corners, edges[i])
+		end := g.contourEdgePoint(values, level, corners, edges[i+1])
+		g.ctx.MoveTo(start.X, start.Y)
+		g.ctx.LineTo(end.X, end.Y)
+	}
+}
+
+func (g *Gridder) contourEdgePoint(values [][]float64, level float64, corners [4]Cell, edge int) gg.Point {
+	from, to := corners[edge], corners[(edge+1)%4]
+	fromValue, toValue := values[from.Row][from.Column], values[to.Row][to.Column]
+	fromCenter, toCenter := g.getCellCenter(from.Row, from.Column), g.getCellCenter(to.Row, to.Column)
+
+	t := 0.5
+	if fromValue != toValue {
+		t = (level - fromValue) / (toValue - fromValue)
+	}
+	return gg.Point{
+		X: fromCenter.X + t*(toCenter.X-fromCenter.X),
+		Y: fromCenter.Y + t*(toCenter.Y-fromCenter.Y),
+	}
+}
+
+func (g *Gridder) verifyMatrix(rows int, columns func(row int) int) error {
+	if rows != g.gridConfig.GetRows() {
+		return errMatrixDimensions
+	}
+	for row := 0; row < rows; row++ {
+		if columns(row) != g.gridConfig.GetColumns() {
+			return errMatrixDimensions
+		}
+	}
+	return nil
+}
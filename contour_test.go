@@ -0,0 +1,37 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawContours(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 300, Height: 300}, GridConfig{Rows: 3, Columns: 3})
+	assert.Nil(t, err)
+
+	err = gridder.DrawContours([][]float64{{0, 0}}, []float64{0.5})
+	assert.NotNil(t, err)
+
+	err = gridder.DrawContours([][]float64{{0, 0, 0}, {0, 0}, {0, 0, 0}}, []float64{0.5})
+	assert.NotNil(t, err)
+
+	values := [][]float64{
+		{0, 0, 0},
+		{0, 1, 0},
+		{0, 0, 0},
+	}
+	err = gridder.DrawContours(values, []float64{0.5}, ContourConfig{StrokeWidth: 2, Color: color.Black})
+	assert.Nil(t, err)
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(150, 100)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(150, 150)), color.RGBAModel.Convert(color.White))
+
+	saddle := [][]float64{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 0},
+	}
+	err = gridder.DrawContours(saddle, []float64{0.5})
+	assert.Nil(t, err)
+}
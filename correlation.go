@@ -0,0 +1,117 @@
+package gridder
+
+import (
+	"fmt"
+
+	"github.com/fogleman/gg"
+	"golang.org/x/image/font"
+)
+
+// RenderCorrelationMatrix creates a correlation matrix heatmap with a diverging colormap from -1 to 1 and a
+// colorbar. One triangle of the symmetric matrix can be masked. Values and labels are drawn when a font is
+// configured, with labels to the left of the rows and rotated below the columns
+func RenderCorrelationMatrix(values [][]float64, labels []string, correlationMatrixConfigs ...CorrelationMatrixConfig) (*Gridder, error) {
+	correlationMatrixConfig := getFirstCorrelationMatrixConfig(correlationMatrixConfigs...)
+
+	size := len(values)
+	if size == 0 {
+		return nil, errNoRows
+	}
+	if labels != nil && len(labels) != size {
+		return nil, fmt.Errorf("%w: %d labels for %d variables", errMatrixDimensions, len(labels), size)
+	}
+	for row := range values {
+		if len(values[row]) != size {
+			return nil, errMatrixDimensions
+		}
+	}
+	err := verifyFinite("values", values)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := newMatrixGridder(size, correlationMatrixConfig.ImageConfig, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if correlationMatrixConfig.Font != nil {
+		fontFace = g.FontFace(correlationMatrixConfig.Font, correlationMatrixConfig.GetFontFraction())
+	}
+
+	numberFormat := correlationMatrixConfig.GetNumberFormat()
+	err = g.retain(func() error {
+		for row := 0; row < size; row++ {
+			for column := 0; column < size; column++ {
+				if correlationMatrixConfig.Mask.masks(row, column) {
+					continue
+				}
+
+				fill := correlationMatrixConfig.GetColor((values[row][column] + 1) / 2)
+				err := g.paintCell(row, column+1, fill)
+				if err != nil {
+					return err
+				}
+
+				if fontFace == nil {
+					continue
+				}
+
+				text := g.formatNumber(numberFormat, values[row][column])
+				err = g.drawString(row, column+1, text, fontFace, StringConfig{Color: contrastColor(fill)})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		err := g.drawColorbar(0, size+1, size-1, size+1, correlationMatrixConfig.GetColor, -1, 1, []float64{-1, 0, 1}, ColorbarConfig{
+			FontFace:     fontFace,
+			NumberFormat: &numberFormat,
+			TextColor:    correlationMatrixConfig.GetTextColor(),
+		})
+		if err != nil || fontFace == nil {
+			return err
+		}
+
+		g.ctx.Push()
+		g.ctx.SetFontFace(fontFace)
+		g.ctx.SetColor(correlationMatrixConfig.GetTextColor())
+		for i, label := range labels {
+			g.drawAnchoredString(i, 0, label, 1, 0.35)
+			g.drawRotatedColumnLabel(size, i+1, label, correlationMatrixConfig.GetLabelRotation())
+		}
+		g.ctx.Pop()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// drawRotatedColumnLabel draws a label hanging from the top of a cell and ending below its center, rotated
+// counterclockwise so that long labels of neighboring columns do not overlap
+func (g *Gridder) drawRotatedColumnLabel(row int, column int, text string, rotation float64) {
+	center := g.getCellCenter(row, column)
+	_, cellHeight := g.getCellDimensions(row, column)
+	x, y := center.X, center.Y-cellHeight/2+g.gridConfig.GetLineStrokeWidth()
+
+	g.ctx.Push()
+	g.ctx.RotateAbout(gg.Radians(-rotation), x, y)
+	g.ctx.DrawStringAnchored(text, x, y, 1, 1)
+	g.ctx.Pop()
+	g.addLabel(row, column, text)
+}
+
+// masks determines if a triangle mask hides a cell, the diagonal is never masked
+func (m TriangleMask) masks(row int, column int) bool {
+	switch m {
+	case MaskUpper:
+		return column > row
+	case MaskLower:
+		return column < row
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+var testCorrelations = [][]float64{{1, 0.5, -1}, {0.5, 1, 0}, {-1, 0, 1}}
+
+func TestRenderCorrelationMatrix(t *testing.T) {
+	gridder, err := RenderCorrelationMatrix(testCorrelations, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 420)
+	assert.Equal(t, gridder.ctx.Height(), 300)
+
+	img := gridder.image()
+	white := color.NRGBAModel.Convert(color.White)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 30)), defaultCorrelationMatrixHighColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(270, 30)), defaultCorrelationMatrixLowColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(270, 90)), white)
+	assert.NotEqual(t, color.NRGBAModel.Convert(img.At(210, 30)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(330, 0)), defaultCorrelationMatrixHighColor)
+	colormap := DivergingColormap(defaultCorrelationMatrixLowColor, color.White, defaultCorrelationMatrixHighColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(330, 179)), colormap(1.0/180))
+}
+
+func TestRenderCorrelationMatrixMask(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	correlationMatrixConfig := CorrelationMatrixConfig{Mask: MaskUpper, Font: ttf}
+	gridder, err := RenderCorrelationMatrix(testCorrelations, []string{"a", "b", "c"}, correlationMatrixConfig)
+	assert.Nil(t, err)
+
+	img := gridder.image()
+	white := color.NRGBAModel.Convert(color.White)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(210, 30)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(125, 155)), defaultCorrelationMatrixLowColor)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"1.00"})
+	assert.Equal(t, len(gridder.labels[Cell{Row: 0, Column: 2}]), 0)
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 0}], []string{"b"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 3}], []string{"c"})
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 5}], []string{"1.00"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 5}], []string{"0.00"})
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 5}], []string{"-1.00"})
+
+	var rotatedLabel bool
+	for x := 100; x < 270; x++ {
+		for y := 182; y < 300; y++ {
+			if color.NRGBAModel.Convert(img.At(x, y)) != white {
+				rotatedLabel = true
+			}
+		}
+	}
+	assert.True(t, rotatedLabel)
+
+	correlationMatrixConfig.Mask = MaskLower
+	gridder, err = RenderCorrelationMatrix(testCorrelations, nil, correlationMatrixConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(150, 150)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(245, 5)), defaultCorrelationMatrixLowColor)
+}
+
+func TestRenderCorrelationMatrixErrors(t *testing.T) {
+	_, err := RenderCorrelationMatrix(nil, nil)
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = RenderCorrelationMatrix([][]float64{{1, 0}, {0}}, nil)
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+
+	_, err = RenderCorrelationMatrix([][]float64{{1}}, []string{"a", "b"})
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+
+	_, err = RenderCorrelationMatrix([][]float64{{math.NaN()}}, nil)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestTriangleMask(t *testing.T) {
+	assert.False(t, MaskNone.masks(0, 1))
+	assert.True(t, MaskUpper.masks(0, 1))
+	assert.False(t, MaskUpper.masks(1, 1))
+	assert.True(t, MaskLower.masks(1, 0))
+	assert.False(t, MaskLower.masks(0, 1))
+}
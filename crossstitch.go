@@ -0,0 +1,196 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"sort"
+
+	"golang.org/x/image/font"
+)
+
+// Thread is a thread or yarn color of a cross-stitch pattern, such as a numbered color of a thread maker
+type Thread struct {
+	Name  string
+	Color color.Color
+}
+
+// threadUse is a thread used in a pattern with its symbol and number of stitches
+type threadUse struct {
+	thread   Thread
+	symbol   rune
+	stitches int
+}
+
+// RenderCrossStitch creates a cross-stitch or knitting pattern of an image pixelated onto a grid of rows and
+// columns, with a stitch per cell in the closest thread color. Mostly transparent cells are left without a stitch.
+// When a font is configured, every stitch is marked with the symbol of its thread and a legend of the threads with
+// their symbol and number of stitches follows the pattern, most used first. Every tenth line is a major line, and the
+// image is sized for square cells unless its dimensions are set
+func RenderCrossStitch(img image.Image, rows int, columns int, crossStitchConfigs ...CrossStitchConfig) (*Gridder, error) {
+	crossStitchConfig := getFirstCrossStitchConfig(crossStitchConfigs...)
+	if rows <= 0 {
+		return nil, errNoRows
+	}
+	if columns <= 0 {
+		return nil, errNoColumns
+	}
+	if img.Bounds().Empty() {
+		return nil, fmt.Errorf("%w: empty image", errInvalidValue)
+	}
+
+	stitches, uses, err := crossStitches(sampleImage(img, rows, columns, crossStitchConfig.GetSampling()), crossStitchConfig.Threads, crossStitchConfig.GetSymbols())
+	if err != nil {
+		return nil, err
+	}
+
+	// the pattern, and a spacing row and a legend row per thread when texts are drawn
+	totalRows := rows
+	if crossStitchConfig.Font != nil {
+		totalRows += 1 + len(uses)
+	}
+
+	imageConfig := crossStitchConfig.ImageConfig
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultCrossStitchCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = totalRows * defaultCrossStitchCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            totalRows,
+		Columns:         columns,
+		LineStrokeWidth: crossStitchConfig.GetLineStrokeWidth(),
+		LineColor:       color.Transparent,
+		BorderColor:     color.Transparent,
+		BackgroundColor: crossStitchConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if crossStitchConfig.Font != nil {
+		fontFace = g.FontFace(crossStitchConfig.Font, crossStitchConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		for row := range stitches {
+			for column, use := range stitches[row] {
+				if use < 0 {
+					continue
+				}
+				fill := uses[use].thread.Color
+				err := g.paintCell(row, column, fill)
+				if err != nil {
+					return err
+				}
+				if fontFace != nil {
+					err = g.drawString(row, column, string(uses[use].symbol), fontFace, StringConfig{Color: contrastColor(fill)})
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		g.drawMajorLines(image.Rect(0, 0, columns, rows), majorLines{
+			interval:         crossStitchConfig.GetMajorLineInterval(),
+			color:            crossStitchConfig.GetLineColor(),
+			strokeWidth:      crossStitchConfig.GetLineStrokeWidth(),
+			majorColor:       crossStitchConfig.GetMajorLineColor(),
+			majorStrokeWidth: crossStitchConfig.GetMajorLineStrokeWidth(),
+		})
+
+		if fontFace == nil {
+			return nil
+		}
+
+		g.ctx.Push()
+		defer g.ctx.Pop()
+		g.ctx.SetFontFace(fontFace)
+		for i, use := range uses {
+			row := rows + 1 + i
+			g.ctx.SetColor(crossStitchConfig.GetTextColor())
+			g.drawLegend(row, 0, []string{fmt.Sprintf("%s: %d", use.thread.Name, use.stitches)}, []color.Color{use.thread.Color})
+
+			// the symbol on the swatch, which spans the cell height from the left of the row
+			center := g.getCellCenter(row, 0)
+			cellWidth, cellHeight := g.getCellDimensions(row, 0)
+			size := cellHeight - g.gridConfig.GetLineStrokeWidth()
+			g.ctx.SetColor(contrastColor(use.thread.Color))
+			g.ctx.DrawStringAnchored(string(use.symbol), center.X-cellWidth/2+size/2, center.Y, 0.5, 0.35)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// crossStitches maps every cell color to the closest thread, or to a thread per distinct color without threads, and
+// gives the used threads symbols from the most used. It gets the index of the use of every cell, -1 for cells left
+// without a stitch, and the uses
+func crossStitches(colors [][]color.Color, threads []Thread, symbols []rune) ([][]int, []threadUse, error) {
+	generated := len(threads) == 0
+	var palette color.Palette
+	for _, thread := range threads {
+		palette = append(palette, thread.Color)
+	}
+
+	stitches := make([][]int, len(colors))
+	counts := make(map[int]int)
+	for row := range colors {
+		stitches[row] = make([]int, len(colors[row]))
+		for column, c := range colors[row] {
+			_, _, _, a := c.RGBA()
+			if a < 0x8000 {
+				stitches[row][column] = -1
+				continue
+			}
+
+			if generated {
+				c = color.NRGBAModel.Convert(c)
+				if index := palette.Index(c); len(palette) == 0 || palette[index] != c {
+					palette = append(palette, c)
+					threads = append(threads, Thread{Name: FormatColor(c), Color: c})
+				}
+			}
+			index := palette.Index(c)
+			stitches[row][column] = index
+			counts[index]++
+		}
+	}
+
+	if len(counts) > len(symbols) {
+		return nil, nil, fmt.Errorf("%w: %d thread colors for %d symbols", errInvalidValue, len(counts), len(symbols))
+	}
+
+	order := make([]int, 0, len(counts))
+	for index := range counts {
+		order = append(order, index)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		if counts[order[i]] != counts[order[j]] {
+			return counts[order[i]] > counts[order[j]]
+		}
+		return order[i] < order[j]
+	})
+
+	uses := make([]threadUse, len(order))
+	useOfThread := make(map[int]int, len(order))
+	for i, index := range order {
+		uses[i] = threadUse{thread: threads[index], symbol: symbols[i], stitches: counts[index]}
+		useOfThread[index] = i
+	}
+	for row := range stitches {
+		for column, index := range stitches[row] {
+			if index >= 0 {
+				stitches[row][column] = useOfThread[index]
+			}
+		}
+	}
+	return stitches, uses, nil
+}
@@ -0,0 +1,81 @@
+package gridder
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+var testThreads = []Thread{
+	{Name: "321 Red", Color: color.NRGBA{R: 200, A: 255}},
+	{Name: "797 Blue", Color: color.NRGBA{B: 200, A: 255}},
+	{Name: "700 Green", Color: color.NRGBA{G: 200, A: 255}},
+}
+
+func TestRenderCrossStitch(t *testing.T) {
+	gridder, err := RenderCrossStitch(testPixelArt(), 4, 4, CrossStitchConfig{Threads: testThreads})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 80)
+	assert.Equal(t, gridder.ctx.Height(), 80)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(10, 10)), testThreads[2].Color)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 10)), testThreads[0].Color)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 70)), testThreads[1].Color)
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestRenderCrossStitchLegend(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	gridder, err := RenderCrossStitch(testPixelArt(), 4, 4, CrossStitchConfig{Threads: testThreads, Symbols: "abc", Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Height(), 160)
+
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 0}], []string{"c"})
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"b"})
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 2}], []string{"a"})
+	assert.Equal(t, gridder.labels[Cell{Row: 5, Column: 0}], []string{"797 Blue: 8"})
+	assert.Equal(t, gridder.labels[Cell{Row: 6, Column: 0}], []string{"321 Red: 7"})
+	assert.Equal(t, gridder.labels[Cell{Row: 7, Column: 0}], []string{"700 Green: 1"})
+}
+
+func TestRenderCrossStitchErrors(t *testing.T) {
+	_, err := RenderCrossStitch(testPixelArt(), 0, 4)
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = RenderCrossStitch(testPixelArt(), 4, 0)
+	assert.True(t, errors.Is(err, errNoColumns))
+
+	_, err = RenderCrossStitch(image.NewNRGBA(image.Rectangle{}), 4, 4)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = RenderCrossStitch(testPixelArt(), 4, 4, CrossStitchConfig{Symbols: "ab"})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestCrossStitches(t *testing.T) {
+	white, black := color.NRGBA{R: 255, G: 255, B: 255, A: 255}, color.NRGBA{A: 255}
+	colors := [][]color.Color{
+		{white, black, color.Transparent},
+		{black, black, white},
+	}
+	stitches, uses, err := crossStitches(colors, nil, []rune("XO"))
+	assert.Nil(t, err)
+	assert.Equal(t, stitches, [][]int{{1, 0, -1}, {0, 0, 1}})
+	assert.Equal(t, uses, []threadUse{
+		{thread: Thread{Name: "#000000ff", Color: black}, symbol: 'X', stitches: 3},
+		{thread: Thread{Name: "#ffffffff", Color: white}, symbol: 'O', stitches: 2},
+	})
+
+	stitches, uses, _ = crossStitches(colors, []Thread{{Name: "Ecru", Color: color.NRGBA{R: 240, G: 234, B: 218, A: 255}}}, []rune("XO"))
+	assert.Equal(t, stitches, [][]int{{0, 0, -1}, {0, 0, 0}})
+	assert.Equal(t, uses[0].stitches, 5)
+
+	_, _, err = crossStitches(colors, nil, []rune("X"))
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
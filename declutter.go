@@ -0,0 +1,117 @@
+package gridder
+
+import (
+	"fmt"
+	"math"
+)
+
+// LabelDeclutterMode is how strings drawn in cells are thinned out when they would overlap
+type LabelDeclutterMode int
+
+const (
+	// DeclutterNone draws every label
+	DeclutterNone LabelDeclutterMode = iota
+	// DeclutterHide hides labels overlapping a label drawn before them
+	DeclutterHide
+	// DeclutterAbbreviate shortens labels wider than their cell, ending them with an ellipsis, and hides labels too
+	// wide even when shortened to their first letter
+	DeclutterAbbreviate
+	// DeclutterSample draws the labels of every few rows and columns, with room for each label
+	DeclutterSample
+)
+
+// labelBox is the area of a drawn label, from its top left to its bottom right corner
+type labelBox struct {
+	x0 float64
+	y0 float64
+	x1 float64
+	y1 float64
+}
+
+// SetLabelDeclutter sets how the strings drawn on this gridder are decluttered. The base grid and its overlay are
+// decluttered separately. Decluttering applies to strings drawn afterwards and to every string when the grid is
+// re-rendered, and only changes the drawing: cell labels keep the full strings
+func (g *Gridder) SetLabelDeclutter(labelDeclutterConfig LabelDeclutterConfig) error {
+	mode := labelDeclutterConfig.GetMode()
+	if mode < DeclutterNone || mode > DeclutterSample {
+		return fmt.Errorf("%w: label declutter mode %d", errInvalidValue, mode)
+	}
+	err := validateValues(nonNegative("label padding", labelDeclutterConfig.Padding))
+	if err != nil {
+		return err
+	}
+
+	g.labelDeclutter = labelDeclutterConfig
+	return nil
+}
+
+// declutterLabel applies the decluttering of the gridder to a string of a cell centered on an area, measured with the
+// font face of the context. It gets the text to draw, and false when the string is hidden
+func (g *Gridder) declutterLabel(row int, column int, area cellArea, text string) (string, bool) {
+	width, height := g.ctx.MeasureString(text)
+	box := labelBox{
+		x0: area.center.X - width/2,
+		y0: area.center.Y - height/2,
+		x1: area.center.X + width/2,
+		y1: area.center.Y + height/2,
+	}
+	lines, ok := g.declutterLines(row, column, area, []string{text}, box)
+	if !ok {
+		return "", false
+	}
+	return lines[0], true
+}
+
+// declutterLines applies the decluttering of the gridder to the lines of a string of a cell drawn in a box, measured
+// with the font face of the context. Lines are abbreviated one by one, and hidden or sampled together. It gets the
+// lines to draw, and false when the string is hidden
+func (g *Gridder) declutterLines(row int, column int, area cellArea, lines []string, box labelBox) ([]string, bool) {
+	padding := g.labelDeclutter.GetPadding()
+	switch g.labelDeclutter.GetMode() {
+	case DeclutterHide:
+		box = labelBox{x0: box.x0 - padding, y0: box.y0 - padding, x1: box.x1 + padding, y1: box.y1 + padding}
+		for _, placed := range g.labelBoxes {
+			if box.x0 < placed.x1 && placed.x0 < box.x1 && box.y0 < placed.y1 && placed.y0 < box.y1 {
+				return nil, false
+			}
+		}
+		g.labelBoxes = append(g.labelBoxes, box)
+
+	case DeclutterAbbreviate:
+		abbreviated := make([]string, len(lines))
+		for i, line := range lines {
+			text, ok := g.abbreviateLabel(line, area.width-2*padding)
+			if !ok {
+				return nil, false
+			}
+			abbreviated[i] = text
+		}
+		return abbreviated, true
+
+	case DeclutterSample:
+		columnStep := int(math.Max(1, math.Ceil((box.x1-box.x0+2*padding)/area.width)))
+		rowStep := int(math.Max(1, math.Ceil((box.y1-box.y0+2*padding)/area.height)))
+		if row%rowStep != 0 || column%columnStep != 0 {
+			return nil, false
+		}
+	}
+	return lines, true
+}
+
+// abbreviateLabel shortens a line of a label wider than the available width, ending it with the ellipsis. It reports
+// false when even the first letter is too wide
+func (g *Gridder) abbreviateLabel(text string, available float64) (string, bool) {
+	if width, _ := g.ctx.MeasureString(text); width <= available {
+		return text, true
+	}
+
+	ellipsis := g.labelDeclutter.GetEllipsis()
+	runes := []rune(text)
+	for length := len(runes) - 1; length > 0; length-- {
+		abbreviation := string(runes[:length]) + ellipsis
+		if width, _ := g.ctx.MeasureString(abbreviation); width <= available {
+			return abbreviation, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,96 @@
+package gridder
+
+import (
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestSetLabelDeclutter(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	assert.ErrorIs(t, gridder.SetLabelDeclutter(LabelDeclutterConfig{Mode: DeclutterSample + 1}), errInvalidValue)
+	assert.ErrorIs(t, gridder.SetLabelDeclutter(LabelDeclutterConfig{Padding: -1}), errInvalidValue)
+
+	overlay := gridder.Overlay()
+	assert.Nil(t, overlay.SetLabelDeclutter(LabelDeclutterConfig{Mode: DeclutterHide}))
+	assert.Equal(t, overlay.labelDeclutter.GetMode(), DeclutterHide)
+	assert.Equal(t, gridder.labelDeclutter.GetMode(), DeclutterNone)
+}
+
+func TestDeclutterHide(t *testing.T) {
+	fontFace := newDeclutterFontFace(t)
+	gridder, err := New(ImageConfig{Width: 200, Height: 20}, GridConfig{Rows: 1, Columns: 20})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetLabelDeclutter(LabelDeclutterConfig{Mode: DeclutterHide}))
+
+	for column := 0; column < 20; column++ {
+		assert.Nil(t, gridder.DrawString(0, column, "Label", fontFace))
+	}
+	drawn := len(gridder.labelBoxes)
+	assert.Greater(t, drawn, 1)
+	assert.Less(t, drawn, 20)
+	for i := 1; i < drawn; i++ {
+		assert.GreaterOrEqual(t, gridder.labelBoxes[i].x0, gridder.labelBoxes[i-1].x1)
+	}
+	assert.Len(t, gridder.labels, 20)
+
+	assert.Nil(t, gridder.SetGridConfig(gridder.GridConfig()))
+	assert.Len(t, gridder.labelBoxes, drawn)
+
+	assert.Nil(t, gridder.SetLabelDeclutter(LabelDeclutterConfig{Mode: DeclutterHide, Padding: 20}))
+	assert.Nil(t, gridder.SetGridConfig(gridder.GridConfig()))
+	assert.Less(t, len(gridder.labelBoxes), drawn)
+}
+
+func TestDeclutterAbbreviate(t *testing.T) {
+	fontFace := newDeclutterFontFace(t)
+	gridder, err := New(ImageConfig{Width: 120, Height: 20}, GridConfig{Rows: 1, Columns: 3})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetLabelDeclutter(LabelDeclutterConfig{Mode: DeclutterAbbreviate, Ellipsis: "."}))
+	gridder.ctx.SetFontFace(fontFace)
+
+	text, ok := gridder.declutterLabel(0, 0, gridder.getCellArea(0, 0), "Row")
+	assert.True(t, ok)
+	assert.Equal(t, text, "Row")
+
+	text, ok = gridder.declutterLabel(0, 0, gridder.getCellArea(0, 0), "Abbreviation")
+	assert.True(t, ok)
+	assert.Less(t, len(text), len("Abbreviation"))
+	assert.Equal(t, text[len(text)-1:], ".")
+	width, _ := gridder.ctx.MeasureString(text)
+	assert.LessOrEqual(t, width, 40.0)
+
+	assert.Nil(t, gridder.SetLabelDeclutter(LabelDeclutterConfig{Mode: DeclutterAbbreviate, Padding: 19}))
+	_, ok = gridder.declutterLabel(0, 0, gridder.getCellArea(0, 0), "Abbreviation")
+	assert.False(t, ok)
+	assert.Nil(t, gridder.DrawString(0, 1, "Abbreviation", fontFace))
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"Abbreviation"})
+}
+
+func TestDeclutterSample(t *testing.T) {
+	fontFace := newDeclutterFontFace(t)
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 10, Columns: 10})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetLabelDeclutter(LabelDeclutterConfig{Mode: DeclutterSample}))
+	gridder.ctx.SetFontFace(fontFace)
+
+	width, height := gridder.ctx.MeasureString("88")
+	columnStep, rowStep := int(width/10)+1, int(height/10)+1
+	for row := 0; row < 10; row++ {
+		for column := 0; column < 10; column++ {
+			_, ok := gridder.declutterLabel(row, column, gridder.getCellArea(row, column), "88")
+			assert.Equal(t, ok, row%rowStep == 0 && column%columnStep == 0)
+		}
+	}
+}
+
+func newDeclutterFontFace(t *testing.T) font.Face {
+	font, err := truetype.Parse(goregular.TTF)
+	assert.Nil(t, err)
+	return truetype.NewFace(font, &truetype.Options{Size: 12})
+}
@@ -0,0 +1,196 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"strings"
+
+	"github.com/fogleman/gg"
+	"golang.org/x/image/font"
+)
+
+// DrawNorthArrow draws a north arrow in a corner of the grid, with its west half filled and its east half white
+func (g *Gridder) DrawNorthArrow(northArrowConfigs ...NorthArrowConfig) error {
+	northArrowConfig := getFirstNorthArrowConfig(northArrowConfigs...)
+	err := validateValues(finite("north arrow angle", northArrowConfig.Angle))
+	if err != nil {
+		return err
+	}
+
+	return g.retain(func() error {
+		size := northArrowConfig.GetSize()
+		labelHeight := fontHeight(northArrowConfig.FontFace)
+		box := size + 2*labelHeight
+		x, y := g.cornerOrigin(northArrowConfig.Corner, northArrowConfig.GetPadding(), box, box)
+		centerX, centerY := x+box/2, y+box/2
+
+		// points relative to the center of an arrow pointing up, turned to north
+		sin, cos := math.Sincos(gg.Radians(northArrowConfig.Angle))
+		point := func(dx float64, dy float64) (float64, float64) {
+			return centerX + dx*cos - dy*sin, centerY + dx*sin + dy*cos
+		}
+		half, wing := size/2, size*0.3
+		traceHalf := func(side float64) {
+			g.ctx.MoveTo(point(0, -half))
+			g.ctx.LineTo(point(side*wing, half))
+			g.ctx.LineTo(point(0, half/2))
+			g.ctx.ClosePath()
+		}
+
+		g.ctx.Push()
+		defer g.ctx.Pop()
+		g.ctx.SetDash()
+		g.ctx.SetColor(northArrowConfig.GetColor())
+		traceHalf(-1)
+		g.ctx.Fill()
+		g.ctx.SetColor(color.White)
+		traceHalf(1)
+		g.ctx.Fill()
+
+		g.ctx.SetColor(northArrowConfig.GetColor())
+		g.stroke(1, func() {
+			traceHalf(-1)
+			traceHalf(1)
+		})
+
+		if northArrowConfig.FontFace != nil {
+			g.ctx.SetFontFace(northArrowConfig.FontFace)
+			labelX, labelY := point(0, -half-labelHeight/2)
+			g.ctx.DrawStringAnchored("N", labelX, labelY, 0.5, 0.35)
+		}
+		return nil
+	})
+}
+
+// DrawScaleBar draws a scale bar of a distance in a corner of the grid, for a map where a cell is as wide as a cell
+// distance in the same unit. The bar is split into alternately filled segments and labeled with 0 and the distance
+// with its unit above its ends when a font face is configured
+func (g *Gridder) DrawScaleBar(distance float64, cellDistance float64, unit string, scaleBarConfigs ...ScaleBarConfig) error {
+	err := validateValues(finite("scale bar distance", distance), finite("scale bar cell distance", cellDistance))
+	if err != nil {
+		return err
+	}
+	if distance <= 0 || cellDistance <= 0 {
+		return fmt.Errorf("%w: scale bar distance %v for a cell distance %v", errInvalidValue, distance, cellDistance)
+	}
+
+	scaleBarConfig := getFirstScaleBarConfig(scaleBarConfigs...)
+	return g.retain(func() error {
+		gridWidth, _ := g.getGridDimensions()
+		length := distance / cellDistance * gridWidth / float64(g.gridConfig.GetColumns())
+		height := scaleBarConfig.GetHeight()
+		fontFace := scaleBarConfig.FontFace
+		label := strings.TrimSpace(g.formatNumber(NumberFormat{Precision: -1}, distance) + " " + unit)
+
+		g.ctx.Push()
+		defer g.ctx.Pop()
+		g.ctx.SetDash()
+
+		// the labels are centered on the ends of the bar and may stick out of it
+		var startWidth, endWidth, labelHeight float64
+		if fontFace != nil {
+			g.ctx.SetFontFace(fontFace)
+			startWidth, _ = g.ctx.MeasureString("0")
+			endWidth, _ = g.ctx.MeasureString(label)
+			labelHeight = fontHeight(fontFace) * 1.25
+		}
+		x, y := g.cornerOrigin(scaleBarConfig.Corner, scaleBarConfig.GetPadding(), length+(startWidth+endWidth)/2, height+labelHeight)
+		barX, barY := x+startWidth/2, y+labelHeight
+
+		segments := scaleBarConfig.GetSegments()
+		segmentLength := length / float64(segments)
+		for i := 0; i < segments; i++ {
+			g.ctx.DrawRectangle(barX+float64(i)*segmentLength, barY, segmentLength, height)
+			if i%2 == 0 {
+				g.ctx.SetColor(scaleBarConfig.GetColor())
+			} else {
+				g.ctx.SetColor(color.White)
+			}
+			g.ctx.Fill()
+		}
+
+		g.ctx.SetColor(scaleBarConfig.GetColor())
+		g.stroke(1, func() {
+			g.ctx.DrawRectangle(barX, barY, length, height)
+		})
+
+		if fontFace != nil {
+			g.ctx.DrawStringAnchored("0", barX, y, 0.5, 1)
+			g.ctx.DrawStringAnchored(label, barX+length, y, 0.5, 1)
+		}
+		return nil
+	})
+}
+
+// DrawInsetLocator draws an overview map scaled into a corner of the grid, with a box marking the region of the
+// overview in pixels that the grid shows
+func (g *Gridder) DrawInsetLocator(overview image.Image, region image.Rectangle, insetLocatorConfigs ...InsetLocatorConfig) error {
+	if overview == nil || overview.Bounds().Empty() {
+		return fmt.Errorf("%w: empty inset overview", errInvalidValue)
+	}
+	region = region.Canon()
+	if region.Empty() {
+		return fmt.Errorf("%w: empty inset region %v", errInvalidValue, region)
+	}
+
+	insetLocatorConfig := getFirstInsetLocatorConfig(insetLocatorConfigs...)
+	return g.retain(func() error {
+		bounds := overview.Bounds()
+		gridWidth, _ := g.getGridDimensions()
+		width := gridWidth * insetLocatorConfig.GetSize()
+		scale := width / float64(bounds.Dx())
+		height := float64(bounds.Dy()) * scale
+		x, y := g.cornerOrigin(insetLocatorConfig.Corner, insetLocatorConfig.GetPadding(), width, height)
+
+		g.ctx.Push()
+		defer g.ctx.Pop()
+		g.ctx.SetDash()
+
+		g.ctx.Push()
+		g.ctx.Translate(x, y)
+		g.ctx.Scale(scale, scale)
+		g.ctx.DrawImage(overview, -bounds.Min.X, -bounds.Min.Y)
+		g.ctx.Pop()
+
+		strokeWidth := insetLocatorConfig.GetBorderStrokeWidth()
+		g.ctx.SetColor(insetLocatorConfig.GetBorderColor())
+		g.stroke(strokeWidth, func() {
+			g.ctx.DrawRectangle(x, y, width, height)
+		})
+
+		g.ctx.SetColor(insetLocatorConfig.GetRegionColor())
+		g.stroke(strokeWidth, func() {
+			g.ctx.DrawRectangle(
+				x+float64(region.Min.X-bounds.Min.X)*scale,
+				y+float64(region.Min.Y-bounds.Min.Y)*scale,
+				float64(region.Dx())*scale,
+				float64(region.Dy())*scale,
+			)
+		})
+		return nil
+	})
+}
+
+// cornerOrigin gets the top left of a box of a width and height placed in a corner of the grid at a padding from its
+// sides
+func (g *Gridder) cornerOrigin(corner Corner, padding float64, width float64, height float64) (float64, float64) {
+	gridWidth, gridHeight := g.getGridDimensions()
+	x, y := padding, padding
+	if corner == CornerTopRight || corner == CornerBottomRight {
+		x = gridWidth - padding - width
+	}
+	if corner == CornerBottomLeft || corner == CornerBottomRight {
+		y = gridHeight - padding - height
+	}
+	return x, y
+}
+
+// fontHeight gets the line height of a font face in pixels, 0 without a font face
+func fontHeight(fontFace font.Face) float64 {
+	if fontFace == nil {
+		return 0
+	}
+	return float64(fontFace.Metrics().Height) / 64
+}
@@ -0,0 +1,76 @@
+package gridder
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestDrawNorthArrow(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 200}, GridConfig{Rows: 2, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 12})
+	err = gridder.DrawNorthArrow(NorthArrowConfig{Corner: CornerTopRight, FontFace: fontFace})
+	assert.Nil(t, err)
+
+	// the west half of the arrow is filled right of the center of the box in the top right corner
+	img := gridder.image()
+	size := defaultNorthArrowSize + 2*fontHeight(fontFace)
+	centerX := int(200 - defaultDecorationPadding - size/2)
+	centerY := int(defaultDecorationPadding + size/2)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(centerX-3, centerY+5)), color.NRGBA{A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(centerX+3, centerY+5)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+
+	err = gridder.DrawNorthArrow(NorthArrowConfig{Angle: math.NaN()})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestDrawScaleBar(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 1, Columns: 4, BackgroundColor: color.White, LineColor: color.White})
+	assert.Nil(t, err)
+
+	// 100 meters over cells of 50 meters 50 pixels wide
+	err = gridder.DrawScaleBar(100, 50, "m", ScaleBarConfig{Corner: CornerBottomLeft, Segments: 2})
+	assert.Nil(t, err)
+
+	img := gridder.image()
+	y := int(100 - defaultDecorationPadding - defaultScaleBarHeight/2)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(20, y)), color.NRGBA{A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(80, y)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, y)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+
+	err = gridder.DrawScaleBar(100, 0, "m")
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestDrawInsetLocator(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 200}, GridConfig{Rows: 2, Columns: 2, BackgroundColor: color.White, LineColor: color.White})
+	assert.Nil(t, err)
+
+	err = gridder.DrawInsetLocator(nil, image.Rect(0, 0, 10, 10))
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	overview := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	for i := 1; i < len(overview.Pix); i += 4 {
+		overview.Pix[i], overview.Pix[i+2] = 255, 255
+	}
+	err = gridder.DrawInsetLocator(overview, image.Rect(50, 50, 100, 0), InsetLocatorConfig{Corner: CornerBottomRight, Size: 0.5})
+	assert.Nil(t, err)
+
+	// the inset covers the bottom right quarter, with the region box along the top right of the overview
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(110, 160)), color.NRGBA{G: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(140, 115)), defaultInsetLocatorRegionColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 50)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+
+	err = gridder.DrawInsetLocator(overview, image.Rect(0, 0, 0, 10))
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
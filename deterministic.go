@@ -0,0 +1,56 @@
+package gridder
+
+import (
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"sync"
+)
+
+// snapPrecision matches the 26.6 fixed point precision used by the rasterizer
+const snapPrecision = 64
+
+var pngEncoder = png.Encoder{CompressionLevel: png.DefaultCompression, BufferPool: &pngBufferPool{}}
+
+// pngBufferPool reuses the state of the PNG encoder across encodes, its compressor and row buffers being the largest
+// allocations of an encode
+type pngBufferPool struct {
+	pool sync.Pool
+}
+
+func (p *pngBufferPool) Get() *png.EncoderBuffer {
+	buffer, _ := p.pool.Get().(*png.EncoderBuffer)
+	return buffer
+}
+
+func (p *pngBufferPool) Put(buffer *png.EncoderBuffer) {
+	p.pool.Put(buffer)
+}
+
+// encodePNG encodes with pinned settings so the output does not depend on encoder defaults
+func encodePNG(w io.Writer, img image.Image) error {
+	return pngEncoder.Encode(w, img)
+}
+
+// snap rounds a coordinate to the rasterizer precision in deterministic mode, so that platform specific
+// floating point differences such as fused multiply-adds do not leak into the rendered pixels
+func (g *Gridder) snap(value float64) float64 {
+	if !g.imageConfig.IsDeterministic() {
+		return value
+	}
+	return math.Round(value*snapPrecision) / snapPrecision
+}
+
+// toNRGBA converts an image to non-premultiplied RGBA so that the encoded pixel format is always the same
+func toNRGBA(img image.Image) *image.NRGBA {
+	if nrgba, ok := img.(*image.NRGBA); ok {
+		return nrgba
+	}
+
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(nrgba, nrgba.Bounds(), img, bounds.Min, draw.Src)
+	return nrgba
+}
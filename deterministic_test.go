@@ -0,0 +1,50 @@
+package gridder
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeterministic(t *testing.T) {
+	render := func() []byte {
+		gridder, err := New(ImageConfig{Width: 101, Height: 67, Deterministic: true}, GridConfig{Rows: 3, Columns: 7})
+		assert.Nil(t, err)
+		assert.Nil(t, gridder.DrawCircle(1, 3, CircleConfig{Radius: 5.3, Color: color.Black}))
+		assert.Nil(t, gridder.DrawPath(0, 0, 2, 6))
+
+		_, ok := gridder.image().(*image.NRGBA)
+		assert.True(t, ok)
+
+		buffer := new(bytes.Buffer)
+		assert.Nil(t, gridder.EncodePNG(buffer))
+		return buffer.Bytes()
+	}
+	assert.Equal(t, render(), render())
+}
+
+func TestSnap(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 3, Columns: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.snap(1.0/3), 1.0/3)
+
+	gridder, err = New(ImageConfig{Width: 100, Height: 100, Deterministic: true}, GridConfig{Rows: 3, Columns: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.snap(1.0/3), 21.0/64)
+
+	center := gridder.getCellCenter(1, 1)
+	assert.Equal(t, center.X*snapPrecision, float64(int(center.X*snapPrecision)))
+}
+
+func TestToNRGBA(t *testing.T) {
+	rgba := image.NewRGBA(image.Rect(2, 2, 4, 4))
+	rgba.Set(2, 2, color.RGBA{R: 128, A: 128})
+
+	nrgba := toNRGBA(rgba)
+	assert.Equal(t, nrgba.Bounds(), image.Rect(0, 0, 2, 2))
+	assert.Equal(t, nrgba.NRGBAAt(0, 0), color.NRGBA{R: 255, A: 128})
+	assert.Equal(t, toNRGBA(nrgba), nrgba)
+}
@@ -0,0 +1,359 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/image/font"
+)
+
+const defaultDiagramShapeScale = 0.7
+
+// DiagramOperation is a single statement of a diagram description
+type DiagramOperation struct {
+	Cell   Cell
+	Target *Cell
+	Shape  string
+	Color  color.Color
+	Label  string
+	Arrow  bool
+	// Port and TargetPort attach a connection to sides of its cells, moved onto the shapes in them
+	Port       *Port
+	TargetPort *Port
+}
+
+// diagramSides are the sides of the ports of diagram cells by compass direction
+var diagramSides = map[byte]Side{'n': SideTop, 'e': SideRight, 's': SideBottom, 'w': SideLeft}
+
+// ParseDiagram parses a compact diagram description. Statements are separated by semicolons or new lines and
+// cells use spreadsheet references (column letter, 1-based row):
+//
+//	A1: rect red 'Start'      shape (rect, circle, line, fill or text), optional color and label
+//	A1 -> B3 arrow blue       connection, optionally with an arrow head and a color
+//	A1.e -> B3.n+0.25 arrow   connection between ports on sides of the cells (n, e, s or w), optionally offset
+//	                          along the side by a fraction of it
+//
+// A # followed by a space starts a comment that runs to the end of the line
+func ParseDiagram(source string) ([]DiagramOperation, error) {
+	var operations []DiagramOperation
+	for lineNumber, line := range strings.Split(source, "\n") {
+		for _, statement := range splitDiagramStatements(line) {
+			tokens, err := tokenizeDiagram(statement)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber+1, err)
+			}
+			if len(tokens) == 0 {
+				continue
+			}
+
+			operation, err := parseDiagramStatement(tokens)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber+1, err)
+			}
+			operations = append(operations, operation)
+		}
+	}
+	return operations, nil
+}
+
+// DrawDiagram parses a diagram description and draws it. Connections are drawn beneath shapes.
+// A font face is required when any statement has a label
+func (g *Gridder) DrawDiagram(source string, fontFace font.Face) error {
+	return g.retain(func() error {
+		return g.drawDiagram(source, fontFace)
+	})
+}
+
+func (g *Gridder) drawDiagram(source string, fontFace font.Face) error {
+	operations, err := ParseDiagram(source)
+	if err != nil {
+		return err
+	}
+	return g.drawDiagramOperations(operations, fontFace)
+}
+
+// drawDiagramOperations draws parsed diagram operations, connections beneath shapes
+func (g *Gridder) drawDiagramOperations(operations []DiagramOperation, fontFace font.Face) error {
+	for _, operation := range operations {
+		if operation.Target == nil {
+			continue
+		}
+
+		err := g.drawDiagramConnection(operation)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, operation := range operations {
+		if operation.Target != nil {
+			continue
+		}
+
+		err := g.drawDiagramShape(operation, fontFace)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Gridder) drawDiagramConnection(operation DiagramOperation) error {
+	from, to := operation.Cell, *operation.Target
+	err := g.verifyInBounds(from.Row, from.Column)
+	if err != nil {
+		return err
+	}
+
+	err = g.verifyInBounds(to.Row, to.Column)
+	if err != nil {
+		return err
+	}
+
+	startPort := g.diagramPort(operation.Port, from)
+	endPort := g.diagramPort(operation.TargetPort, to)
+	if !operation.Arrow {
+		return g.DrawPath(from.Row, from.Column, to.Row, to.Column, PathConfig{Color: operation.Color, StartPort: startPort, EndPort: endPort})
+	}
+
+	start := portPoint(startPort, g.getCellArea(from.Row, from.Column))
+	end := portPoint(endPort, g.getCellArea(to.Row, to.Column))
+	cellWidth, cellHeight := g.getCellDimensions(to.Row, to.Column)
+	extent := math.Min(cellWidth, cellHeight) * defaultDiagramShapeScale / 2
+	length := math.Hypot(end.X-start.X, end.Y-start.Y)
+	// arrows into ports end on the shape already
+	trim := extent
+	if endPort != nil {
+		trim = 0
+	}
+	if length <= trim {
+		return nil
+	}
+
+	pathConfig := PathConfig{Color: operation.Color}.withStyle(g.getCellStyle(from.Row, from.Column))
+	x2 := end.X - (end.X-start.X)*trim/length
+	y2 := end.Y - (end.Y-start.Y)*trim/length
+
+	g.ctx.Push()
+	g.ctx.SetDash()
+	g.ctx.SetColor(pathConfig.GetColor())
+	g.stroke(pathConfig.GetStrokeWidth(), func() {
+		addArrow(g.ctx, start.X, start.Y, x2, y2, extent/2)
+	})
+	g.ctx.Pop()
+	return nil
+}
+
+// diagramPort gets a port of a diagram cell moved in onto the side of the shapes of the diagram, nil without a port
+func (g *Gridder) diagramPort(port *Port, cell Cell) *Port {
+	if port == nil {
+		return nil
+	}
+
+	cellWidth, cellHeight := g.getCellDimensions(cell.Row, cell.Column)
+	size := cellHeight
+	if port.Side == SideLeft || port.Side == SideRight {
+		size = cellWidth
+	}
+	return &Port{Side: port.Side, Offset: port.Offset, Inset: size * (1 - defaultDiagramShapeScale) / 2}
+}
+
+func (g *Gridder) drawDiagramShape(operation DiagramOperation, fontFace font.Face) error {
+	row, column := operation.Cell.Row, operation.Cell.Column
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	cellWidth, cellHeight := g.getCellDimensions(row, column)
+	switch operation.Shape {
+	case "rect":
+		err = g.DrawRectangle(row, column, RectangleConfig{
+			Width:  cellWidth * defaultDiagramShapeScale,
+			Height: cellHeight * defaultDiagramShapeScale,
+			Color:  operation.Color,
+		})
+	case "circle":
+		err = g.DrawCircle(row, column, CircleConfig{
+			Radius: math.Min(cellWidth, cellHeight) * defaultDiagramShapeScale / 2,
+			Color:  operation.Color,
+		})
+	case "line":
+		err = g.DrawLine(row, column, LineConfig{Length: cellWidth * defaultDiagramShapeScale, Color: operation.Color})
+	case "fill":
+		err = g.PaintCell(row, column, operation.Color)
+	}
+	if err != nil {
+		return err
+	}
+
+	if operation.Label == "" {
+		return nil
+	}
+
+	labelColor := color.Color(nil)
+	if operation.Shape == "text" {
+		labelColor = operation.Color
+	}
+	return g.DrawString(row, column, operation.Label, fontFace, StringConfig{Color: labelColor})
+}
+
+func parseDiagramStatement(tokens []string) (DiagramOperation, error) {
+	var operation DiagramOperation
+	cell, port, err := parseDiagramCell(tokens[0])
+	if err != nil {
+		return operation, err
+	}
+	operation.Cell, operation.Port = cell, port
+
+	if len(tokens) < 2 {
+		return operation, fmt.Errorf("%w: incomplete statement", errInvalidDiagram)
+	}
+
+	var options []string
+	switch tokens[1] {
+	case ":":
+		if len(tokens) < 3 {
+			return operation, fmt.Errorf("%w: missing shape", errInvalidDiagram)
+		}
+		if operation.Port != nil {
+			return operation, fmt.Errorf("%w: port on shape %s", errInvalidDiagram, tokens[0])
+		}
+
+		operation.Shape = strings.ToLower(tokens[2])
+		switch operation.Shape {
+		case "rect", "circle", "line", "fill", "text":
+		default:
+			return operation, fmt.Errorf("%w: unknown shape %q", errInvalidDiagram, tokens[2])
+		}
+		options = tokens[3:]
+	case "->":
+		if len(tokens) < 3 {
+			return operation, fmt.Errorf("%w: missing target cell", errInvalidDiagram)
+		}
+
+		target, targetPort, err := parseDiagramCell(tokens[2])
+		if err != nil {
+			return operation, err
+		}
+		operation.Target, operation.TargetPort = &target, targetPort
+		options = tokens[3:]
+	default:
+		return operation, fmt.Errorf("%w: expected : or -> after %s", errInvalidDiagram, tokens[0])
+	}
+
+	for _, option := range options {
+		switch {
+		case strings.HasPrefix(option, "'"):
+			operation.Label = option[1:]
+		case operation.Target != nil && strings.EqualFold(option, "arrow"):
+			operation.Arrow = true
+		case operation.Target != nil && strings.EqualFold(option, "line"):
+			operation.Arrow = false
+		default:
+			optionColor, err := ParseColor(option)
+			if err != nil {
+				return operation, fmt.Errorf("%w: unknown option %q", errInvalidDiagram, option)
+			}
+			operation.Color = optionColor
+		}
+	}
+	return operation, nil
+}
+
+// parseDiagramCell parses a cell reference, optionally followed by a dot and a port of a compass direction with an
+// offset, such as B3.n or B3.e-0.25
+func parseDiagramCell(token string) (Cell, *Port, error) {
+	reference, portName := token, ""
+	if dot := strings.IndexByte(token, '.'); dot >= 0 {
+		reference, portName = token[:dot], strings.ToLower(token[dot+1:])
+	}
+
+	row, column, err := parseCellReference(strings.ToUpper(reference))
+	if err != nil {
+		return Cell{}, nil, fmt.Errorf("%w: invalid cell %q", errInvalidDiagram, token)
+	}
+	cell := Cell{Row: row, Column: column}
+	if portName == "" {
+		return cell, nil, nil
+	}
+
+	side, ok := diagramSides[portName[0]]
+	if !ok {
+		return cell, nil, fmt.Errorf("%w: invalid port %q", errInvalidDiagram, token)
+	}
+	port := &Port{Side: side}
+	if len(portName) > 1 {
+		port.Offset, err = strconv.ParseFloat(portName[1:], 64)
+		if err != nil || (portName[1] != '+' && portName[1] != '-') {
+			return cell, nil, fmt.Errorf("%w: invalid port %q", errInvalidDiagram, token)
+		}
+	}
+	err = port.validate()
+	if err != nil {
+		return cell, nil, fmt.Errorf("%w: invalid port %q", errInvalidDiagram, token)
+	}
+	return cell, port, nil
+}
+
+// tokenizeDiagram splits a statement into words, ":" and "->" tokens and labels, which keep their opening quote
+func tokenizeDiagram(statement string) ([]string, error) {
+	var tokens []string
+	runes := []rune(statement)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == ':':
+			tokens = append(tokens, ":")
+			i++
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '>':
+			tokens = append(tokens, "->")
+			i += 2
+		case r == '\'' || r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("%w: unterminated label", errInvalidDiagram)
+			}
+			tokens = append(tokens, "'"+string(runes[i+1:end]))
+			i = end + 1
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != ':' && runes[i] != '\'' && runes[i] != '"' &&
+				!(runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '>') {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		}
+	}
+	return tokens, nil
+}
+
+// splitDiagramStatements splits a line on semicolons outside of labels, dropping a trailing comment
+func splitDiagramStatements(line string) []string {
+	var statements []string
+	var quote rune
+	start := 0
+	for i, r := range line {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == 0 && (r == '\'' || r == '"'):
+			quote = r
+		case quote == 0 && r == ';':
+			statements = append(statements, line[start:i])
+			start = i + 1
+		case quote == 0 && r == '#' && (i+1 == len(line) || line[i+1] == ' ' || line[i+1] == '\t'):
+			return append(statements, line[start:i])
+		}
+	}
+	return append(statements, line[start:])
+}
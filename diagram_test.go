@@ -0,0 +1,75 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestParseDiagram(t *testing.T) {
+	operations, err := ParseDiagram("A1: rect red 'Start; here'; A1->B3 arrow\n# comment\nc2: fill #00ff00 # trailing\nB3 -> C2 blue")
+	assert.Nil(t, err)
+	assert.Equal(t, operations, []DiagramOperation{
+		{Cell: Cell{Row: 0, Column: 0}, Shape: "rect", Color: color.RGBA{R: 255, A: 255}, Label: "Start; here"},
+		{Cell: Cell{Row: 0, Column: 0}, Target: &Cell{Row: 2, Column: 1}, Arrow: true},
+		{Cell: Cell{Row: 1, Column: 2}, Shape: "fill", Color: color.NRGBA{G: 255, A: 255}},
+		{Cell: Cell{Row: 2, Column: 1}, Target: &Cell{Row: 1, Column: 2}, Color: color.RGBA{B: 255, A: 255}},
+	})
+
+	for _, source := range []string{
+		"1A: rect",
+		"A1",
+		"A1:",
+		"A1: hexagon",
+		"A1 ->",
+		"A1 -> 3",
+		"A1 = B2",
+		"A1: rect 'unterminated",
+		"A1: rect sparkly",
+		"A1.e: rect",
+		"A1.x -> B1",
+		"A1.e0.2 -> B1",
+		"A1.e+0.7 -> B1",
+	} {
+		_, err = ParseDiagram(source)
+		assert.NotNil(t, err, source)
+	}
+}
+
+func TestParseDiagramPorts(t *testing.T) {
+	operations, err := ParseDiagram("A1.e -> C1.W+0.25 arrow; B2 -> B3.n")
+	assert.Nil(t, err)
+	assert.Equal(t, operations, []DiagramOperation{
+		{Cell: Cell{Row: 0, Column: 0}, Target: &Cell{Row: 0, Column: 2}, Arrow: true, Port: &Port{Side: SideRight}, TargetPort: &Port{Side: SideLeft, Offset: 0.25}},
+		{Cell: Cell{Row: 1, Column: 1}, Target: &Cell{Row: 2, Column: 1}, TargetPort: &Port{Side: SideTop}},
+	})
+}
+
+func TestDrawDiagram(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 300, Height: 300}, GridConfig{Rows: 3, Columns: 3})
+	assert.Nil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 12})
+
+	err = gridder.DrawDiagram("A1: rect red 'Start'; A1 -> B3 arrow; B3: circle; C1: line; C2: text blue 'Note'; A3 -> C3", fontFace)
+	assert.Nil(t, err)
+
+	err = gridder.DrawDiagram("A2.e -> C2.w arrow; B1.s -> B2.n", fontFace)
+	assert.Nil(t, err)
+
+	err = gridder.DrawDiagram("D1: rect", fontFace)
+	assert.NotNil(t, err)
+
+	err = gridder.DrawDiagram("A1 -> D4", fontFace)
+	assert.NotNil(t, err)
+
+	err = gridder.DrawDiagram("A1: rect 'Label'", nil)
+	assert.NotNil(t, err)
+
+	err = gridder.DrawDiagram("A1:", nil)
+	assert.NotNil(t, err)
+}
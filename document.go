@@ -0,0 +1,142 @@
+package gridder
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"image"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Document collects grids as the pages of a booklet or a report bundle
+type Document struct {
+	pages []documentPage
+}
+
+// documentPage is a grid, rendered when the document is encoded, or an image at a DPI with optional print boxes
+type documentPage struct {
+	gridder  *Gridder
+	img      image.Image
+	dpi      float64
+	trimBox  image.Rectangle
+	bleedBox image.Rectangle
+	title    string
+}
+
+func (p documentPage) pdfPage() pdfPage {
+	if p.gridder != nil {
+		return p.gridder.pdfPage()
+	}
+	return pdfPage{img: p.img, dpi: p.dpi, trimBox: p.trimBox, bleedBox: p.bleedBox}
+}
+
+func (p documentPage) encodePNG(w io.Writer) error {
+	if p.gridder != nil {
+		return p.gridder.EncodePNG(w)
+	}
+	return encodePNG(w, p.img)
+}
+
+// documentManifest describes the pages of a zip bundle
+type documentManifest struct {
+	Pages []documentManifestPage `json:"pages"`
+}
+
+type documentManifestPage struct {
+	File     string            `json:"file"`
+	Title    string            `json:"title,omitempty"`
+	Width    int               `json:"width"`
+	Height   int               `json:"height"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// NewDocument creates an empty document
+func NewDocument() *Document {
+	return &Document{}
+}
+
+// AddPage appends a grid as the next page of the document. The title is listed in the manifest of zip bundles
+func (d *Document) AddPage(g *Gridder, title string) {
+	d.pages = append(d.pages, documentPage{gridder: g, title: title})
+}
+
+// GetPageCount returns the number of pages
+func (d *Document) GetPageCount() int {
+	return len(d.pages)
+}
+
+// EncodePDF renders every page and writes them to w as a single PDF, keeping the links of cells
+func (d *Document) EncodePDF(w io.Writer) error {
+	if len(d.pages) == 0 {
+		return errNoPages
+	}
+
+	pages := make([]pdfPage, len(d.pages))
+	for i, page := range d.pages {
+		pages[i] = page.pdfPage()
+	}
+	return writePDF(w, pages)
+}
+
+// EncodeZip renders every page as a PNG and writes them to w as a zip archive, along with a manifest.json listing
+// the file, title, dimensions and metadata of each page in order
+func (d *Document) EncodeZip(w io.Writer) error {
+	if len(d.pages) == 0 {
+		return errNoPages
+	}
+
+	archive := zip.NewWriter(w)
+	manifest := documentManifest{Pages: make([]documentManifestPage, len(d.pages))}
+	for i, page := range d.pages {
+		name := fmt.Sprintf("page-%03d.png", i+1)
+		file, err := archive.Create(name)
+		if err != nil {
+			return err
+		}
+		err = page.encodePNG(file)
+		if err != nil {
+			return err
+		}
+
+		manifest.Pages[i] = documentManifestPage{File: name, Title: page.title}
+		if page.gridder != nil {
+			manifest.Pages[i].Width = page.gridder.imageConfig.GetWidth()
+			manifest.Pages[i].Height = page.gridder.imageConfig.GetHeight()
+			manifest.Pages[i].Metadata = page.gridder.Metadata()
+			if len(manifest.Pages[i].Metadata) == 0 {
+				manifest.Pages[i].Metadata = nil
+			}
+		} else {
+			manifest.Pages[i].Width = page.img.Bounds().Dx()
+			manifest.Pages[i].Height = page.img.Bounds().Dy()
+		}
+	}
+
+	file, err := archive.Create("manifest.json")
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(manifest)
+	if err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// Save writes the document to a file, as a PDF or a zip bundle by the file extension
+func (d *Document) Save(path string) error {
+	var encode func(w io.Writer) error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".pdf":
+		encode = d.EncodePDF
+	case ".zip":
+		encode = d.EncodeZip
+	default:
+		return fmt.Errorf("%w: %q", errUnknownFormat, filepath.Ext(path))
+	}
+	return saveFile(path, encode)
+}
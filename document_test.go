@@ -0,0 +1,90 @@
+package gridder
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"image/png"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentEncodePDF(t *testing.T) {
+	document := NewDocument()
+	assert.Equal(t, document.EncodePDF(&bytes.Buffer{}), errNoPages)
+
+	first, err := New(ImageConfig{Width: 200, Height: 100, DPI: 144}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, first.SetLink(0, 1, "https://example.com"))
+	second, err := New(ImageConfig{Width: 100, Height: 100, DPI: 144}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	document.AddPage(first, "First")
+	document.AddPage(second, "Second")
+	assert.Equal(t, document.GetPageCount(), 2)
+
+	var buffer bytes.Buffer
+	assert.Nil(t, document.EncodePDF(&buffer))
+	pdf := buffer.Bytes()
+	assert.True(t, bytes.Contains(pdf, []byte("/Kids [3 0 R 8 0 R] /Count 2")))
+	assert.True(t, bytes.Contains(pdf, []byte("8 0 obj\n<< /Type /Page /Parent 2 0 R /MediaBox [0 0 50 50] /Resources << /XObject << /Im0 10 0 R >> >> /Contents 9 0 R /Annots [] >>")))
+	assert.True(t, bytes.Contains(pdf, []byte("/Annots [7 0 R]")))
+	assert.Equal(t, len(regexp.MustCompile(`\d{10} 00000 n `).FindAll(pdf, -1)), 11)
+}
+
+func TestDocumentEncodeZip(t *testing.T) {
+	first, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, first.SetMetadata("Author", "Gridder"))
+	second, err := New(ImageConfig{Width: 100, Height: 50}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	document := NewDocument()
+	document.AddPage(first, "Worksheet")
+	document.AddPage(second, "")
+
+	var buffer bytes.Buffer
+	assert.Nil(t, document.EncodeZip(&buffer))
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	assert.Nil(t, err)
+	assert.Equal(t, len(archive.File), 3)
+	assert.Equal(t, archive.File[0].Name, "page-001.png")
+	assert.Equal(t, archive.File[1].Name, "page-002.png")
+	assert.Equal(t, archive.File[2].Name, "manifest.json")
+
+	file, err := archive.File[1].Open()
+	assert.Nil(t, err)
+	img, err := png.Decode(file)
+	assert.Nil(t, err)
+	assert.Equal(t, img.Bounds().Dx(), 100)
+	assert.Equal(t, img.Bounds().Dy(), 50)
+
+	file, err = archive.File[2].Open()
+	assert.Nil(t, err)
+	var manifest documentManifest
+	assert.Nil(t, json.NewDecoder(file).Decode(&manifest))
+	assert.Equal(t, manifest, documentManifest{Pages: []documentManifestPage{
+		{File: "page-001.png", Title: "Worksheet", Width: 200, Height: 100, Metadata: map[string]string{"Author": "Gridder"}},
+		{File: "page-002.png", Width: 100, Height: 50},
+	}})
+}
+
+func TestDocumentSave(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	document := NewDocument()
+	document.AddPage(gridder, "")
+
+	dir, err := ioutil.TempDir("", "gridder")
+	assert.Nil(t, err)
+	path := filepath.Join(dir, "booklet.PDF")
+	assert.Nil(t, document.Save(path))
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+
+	assert.NotNil(t, document.Save(filepath.Join(dir, "booklet.tar")))
+}
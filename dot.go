@@ -0,0 +1,495 @@
+package gridder
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/image/font"
+)
+
+// Graph is a graph parsed from the DOT language
+type Graph struct {
+	Directed bool
+	Nodes    []GraphNode
+	Edges    []GraphEdge
+}
+
+// GraphNode is a graph node with its DOT attributes
+type GraphNode struct {
+	ID         string
+	Attributes map[string]string
+}
+
+// GraphEdge is a graph edge with its DOT attributes
+type GraphEdge struct {
+	From       string
+	To         string
+	Attributes map[string]string
+}
+
+// Label gets the node label, defaulting to its identifier
+func (n *GraphNode) Label() string {
+	if label, ok := n.Attributes["label"]; ok {
+		return label
+	}
+	return n.ID
+}
+
+// ParseDOT parses a subset of the DOT language: a single graph or digraph with node, edge and attribute statements.
+// Default node and edge attributes apply to statements that follow them. Subgraphs and ports are not supported
+func ParseDOT(r io.Reader) (*Graph, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := tokenizeDOT(string(content))
+	if err != nil {
+		return nil, err
+	}
+
+	parser := dotParser{
+		tokens:       tokens,
+		graph:        &Graph{},
+		nodes:        make(map[string]int),
+		nodeDefaults: make(map[string]string),
+		edgeDefaults: make(map[string]string),
+	}
+	err = parser.parse()
+	if err != nil {
+		return nil, err
+	}
+	return parser.graph, nil
+}
+
+// DrawGraph draws the graph nodes as shapes in cells and its edges as lines between them.
+// Nodes are placed using the placement map, then their "cell" attribute ("row,column"), then a layered auto-placement
+// where each node sits one row below its deepest predecessor. Labels are drawn when a font face is provided
+func (g *Gridder) DrawGraph(graph *Graph, placement map[string]Cell, fontFace font.Face, graphConfigs ...GraphConfig) error {
+	return g.retain(func() error {
+		return g.drawGraph(graph, placement, fontFace, graphConfigs...)
+	})
+}
+
+func (g *Gridder) drawGraph(graph *Graph, placement map[string]Cell, fontFace font.Face, graphConfigs ...GraphConfig) error {
+	cells, err := placeGraph(graph, placement)
+	if err != nil {
+		return err
+	}
+
+	for _, cell := range cells {
+		err = g.verifyInBounds(cell.Row, cell.Column)
+		if err != nil {
+			return err
+		}
+	}
+
+	graphConfig := getFirstGraphConfig(graphConfigs...)
+	err = graphConfig.validate(g.maxStrokeWidth())
+	if err != nil {
+		return err
+	}
+
+	g.ctx.Push()
+	g.ctx.SetDash()
+	for _, edge := range graph.Edges {
+		from, to := cells[edge.From], cells[edge.To]
+		if from == to {
+			continue
+		}
+
+		start := g.getCellCenter(from.Row, from.Column)
+		end := g.getCellCenter(to.Row, to.Column)
+		angle := math.Atan2(end.Y-start.Y, end.X-start.X)
+		startTrim := g.graphNodeExtent(graph.node(edge.From), from, angle, graphConfig)
+		endTrim := g.graphNodeExtent(graph.node(edge.To), to, angle+math.Pi, graphConfig)
+		x1, y1 := start.X+startTrim*math.Cos(angle), start.Y+startTrim*math.Sin(angle)
+		x2, y2 := end.X-endTrim*math.Cos(angle), end.Y-endTrim*math.Sin(angle)
+
+		edgeColor, err := parseOptionalColor(edge.Attributes["color"])
+		if err != nil {
+			return err
+		}
+		if edgeColor == nil {
+			edgeColor = graphConfig.GetEdgeColor()
+		}
+		g.ctx.SetColor(edgeColor)
+		g.stroke(graphConfig.GetEdgeStrokeWidth(), func() {
+			if graph.Directed {
+				cellWidth, cellHeight := g.getCellDimensions(to.Row, to.Column)
+				addArrow(g.ctx, x1, y1, x2, y2, math.Min(cellWidth, cellHeight)*graphConfig.GetNodeScale()/4)
+				return
+			}
+			g.ctx.MoveTo(x1, y1)
+			g.ctx.LineTo(x2, y2)
+		})
+	}
+	g.ctx.Pop()
+
+	for _, node := range graph.Nodes {
+		cell := cells[node.ID]
+		cellWidth, cellHeight := g.getCellDimensions(cell.Row, cell.Column)
+		fill, err := parseOptionalColor(node.Attributes["fillcolor"])
+		if err != nil {
+			return err
+		}
+		if fill == nil {
+			fill = graphConfig.GetNodeColor()
+		}
+
+		scale := graphConfig.GetNodeScale()
+		if isBoxShape(node.Attributes["shape"]) {
+			err = g.DrawRectangle(cell.Row, cell.Column, RectangleConfig{Width: cellWidth * scale, Height: cellHeight * scale, Color: fill})
+		} else {
+			err = g.DrawCircle(cell.Row, cell.Column, CircleConfig{Radius: math.Min(cellWidth, cellHeight) * scale / 2, Color: fill})
+		}
+		if err != nil {
+			return err
+		}
+
+		if fontFace == nil {
+			continue
+		}
+
+		err = g.DrawString(cell.Row, cell.Column, node.Label(), fontFace, StringConfig{Color: graphConfig.GetLabelColor()})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Gridder) graphNodeExtent(node *GraphNode, cell Cell, angle float64, graphConfig GraphConfig) float64 {
+	cellWidth, cellHeight := g.getCellDimensions(cell.Row, cell.Column)
+	scale := graphConfig.GetNodeScale()
+	if !isBoxShape(node.Attributes["shape"]) {
+		return math.Min(cellWidth, cellHeight) * scale / 2
+	}
+
+	halfWidth, halfHeight := cellWidth*scale/2, cellHeight*scale/2
+	cos, sin := math.Abs(math.Cos(angle)), math.Abs(math.Sin(angle))
+	if halfWidth*sin <= halfHeight*cos {
+		return halfWidth / cos
+	}
+	return halfHeight / sin
+}
+
+func (graph *Graph) node(id string) *GraphNode {
+	for i := range graph.Nodes {
+		if graph.Nodes[i].ID == id {
+			return &graph.Nodes[i]
+		}
+	}
+	return nil
+}
+
+func placeGraph(graph *Graph, placement map[string]Cell) (map[string]Cell, error) {
+	cells := make(map[string]Cell, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		if cell, ok := placement[node.ID]; ok {
+			cells[node.ID] = cell
+			continue
+		}
+
+		position, ok := node.Attributes["cell"]
+		if !ok {
+			continue
+		}
+
+		parts := strings.Split(position, ",")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%w: cell %q of node %q", errInvalidGraph, position, node.ID)
+		}
+
+		row, rowErr := strconv.Atoi(strings.TrimSpace(parts[0]))
+		column, columnErr := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if rowErr != nil || columnErr != nil {
+			return nil, fmt.Errorf("%w: cell %q of node %q", errInvalidGraph, position, node.ID)
+		}
+		cells[node.ID] = Cell{Row: row, Column: column}
+	}
+
+	ranks := make(map[string]int, len(graph.Nodes))
+	for range graph.Nodes {
+		for _, edge := range graph.Edges {
+			if edge.From != edge.To && ranks[edge.To] < ranks[edge.From]+1 && ranks[edge.From]+1 < len(graph.Nodes) {
+				ranks[edge.To] = ranks[edge.From] + 1
+			}
+		}
+	}
+
+	used := make(map[Cell]bool, len(cells))
+	for _, cell := range cells {
+		used[cell] = true
+	}
+	for _, node := range graph.Nodes {
+		if _, ok := cells[node.ID]; ok {
+			continue
+		}
+
+		cell := Cell{Row: ranks[node.ID]}
+		for used[cell] {
+			cell.Column++
+		}
+		cells[node.ID] = cell
+		used[cell] = true
+	}
+	return cells, nil
+}
+
+func isBoxShape(shape string) bool {
+	switch shape {
+	case "box", "rect", "rectangle", "square":
+		return true
+	}
+	return false
+}
+
+type dotParser struct {
+	tokens       []string
+	position     int
+	graph        *Graph
+	nodes        map[string]int
+	nodeDefaults map[string]string
+	edgeDefaults map[string]string
+}
+
+func (p *dotParser) parse() error {
+	if strings.EqualFold(p.peek(), "strict") {
+		p.next()
+	}
+
+	switch strings.ToLower(p.next()) {
+	case "graph":
+	case "digraph":
+		p.graph.Directed = true
+	default:
+		return fmt.Errorf("%w: expected graph or digraph", errInvalidGraph)
+	}
+
+	if p.peek() != "{" {
+		p.next()
+	}
+	if p.next() != "{" {
+		return fmt.Errorf("%w: expected {", errInvalidGraph)
+	}
+
+	for p.peek() != "}" {
+		if p.peek() == "" {
+			return fmt.Errorf("%w: unexpected end of input", errInvalidGraph)
+		}
+
+		err := p.parseStatement()
+		if err != nil {
+			return err
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *dotParser) parseStatement() error {
+	first := p.next()
+	switch {
+	case first == ";":
+		return nil
+	case first == "{" || strings.EqualFold(first, "subgraph"):
+		return fmt.Errorf("%w: subgraphs are not supported", errInvalidGraph)
+	case isDOTPunctuation(first):
+		return fmt.Errorf("%w: unexpected %q", errInvalidGraph, first)
+	}
+
+	if p.peek() == "=" {
+		p.next()
+		p.next()
+		return nil
+	}
+
+	if strings.EqualFold(first, "graph") || strings.EqualFold(first, "node") || strings.EqualFold(first, "edge") {
+		attributes, err := p.parseAttributes()
+		if err != nil {
+			return err
+		}
+
+		defaults := p.edgeDefaults
+		switch strings.ToLower(first) {
+		case "graph":
+			return nil
+		case "node":
+			defaults = p.nodeDefaults
+		}
+		for key, value := range attributes {
+			defaults[key] = value
+		}
+		return nil
+	}
+
+	ids := []string{first}
+	for p.peek() == "->" || p.peek() == "--" {
+		operator := p.next()
+		if (operator == "->") != p.graph.Directed {
+			return fmt.Errorf("%w: edge operator %s does not match graph type", errInvalidGraph, operator)
+		}
+
+		id := p.next()
+		if id == "" || isDOTPunctuation(id) {
+			return fmt.Errorf("%w: expected node after %s", errInvalidGraph, operator)
+		}
+		ids = append(ids, id)
+	}
+
+	attributes, err := p.parseAttributes()
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 1 {
+		node := p.addNode(first)
+		for key, value := range attributes {
+			node.Attributes[key] = value
+		}
+		return nil
+	}
+
+	edgeAttributes := make(map[string]string, len(p.edgeDefaults)+len(attributes))
+	for key, value := range p.edgeDefaults {
+		edgeAttributes[key] = value
+	}
+	for key, value := range attributes {
+		edgeAttributes[key] = value
+	}
+
+	for i := 0; i+1 < len(ids); i++ {
+		p.addNode(ids[i])
+		p.addNode(ids[i+1])
+		p.graph.Edges = append(p.graph.Edges, GraphEdge{From: ids[i], To: ids[i+1], Attributes: edgeAttributes})
+	}
+	return nil
+}
+
+func (p *dotParser) parseAttributes() (map[string]string, error) {
+	attributes := make(map[string]string)
+	for p.peek() == "[" {
+		p.next()
+		for p.peek() != "]" {
+			key := p.next()
+			if key == "" || isDOTPunctuation(key) {
+				return nil, fmt.Errorf("%w: expected attribute name", errInvalidGraph)
+			}
+
+			if p.next() != "=" {
+				return nil, fmt.Errorf("%w: expected = after %s", errInvalidGraph, key)
+			}
+
+			value := p.next()
+			if value == "" || isDOTPunctuation(value) {
+				return nil, fmt.Errorf("%w: expected value for %s", errInvalidGraph, key)
+			}
+			attributes[key] = value
+
+			if p.peek() == "," || p.peek() == ";" {
+				p.next()
+			}
+		}
+		p.next()
+	}
+	return attributes, nil
+}
+
+func (p *dotParser) addNode(id string) *GraphNode {
+	index, ok := p.nodes[id]
+	if !ok {
+		index = len(p.graph.Nodes)
+		p.nodes[id] = index
+		attributes := make(map[string]string, len(p.nodeDefaults))
+		for key, value := range p.nodeDefaults {
+			attributes[key] = value
+		}
+		p.graph.Nodes = append(p.graph.Nodes, GraphNode{ID: id, Attributes: attributes})
+	}
+	return &p.graph.Nodes[index]
+}
+
+func (p *dotParser) peek() string {
+	if p.position >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.position]
+}
+
+func (p *dotParser) next() string {
+	token := p.peek()
+	p.position++
+	return token
+}
+
+func tokenizeDOT(source string) ([]string, error) {
+	var tokens []string
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '#' || (r == '/' && i+1 < len(runes) && runes[i+1] == '/'):
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := strings.Index(string(runes[i+2:]), "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated comment", errInvalidGraph)
+			}
+			i += 2 + len([]rune(string(runes[i+2:])[:end])) + 2
+		case r == '"':
+			var builder strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && runes[i+1] == '"' {
+					i++
+				}
+				builder.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("%w: unterminated string", errInvalidGraph)
+			}
+			tokens = append(tokens, builder.String())
+			i++
+		case r == '-' && i+1 < len(runes) && (runes[i+1] == '>' || runes[i+1] == '-'):
+			tokens = append(tokens, string(runes[i:i+2]))
+			i += 2
+		case strings.ContainsRune("{}[];,=", r):
+			tokens = append(tokens, string(r))
+			i++
+		case isDOTIdentifierRune(r):
+			start := i
+			for i < len(runes) && isDOTIdentifierRune(runes[i]) {
+				if runes[i] == '-' && i+1 < len(runes) && (runes[i+1] == '>' || runes[i+1] == '-') {
+					break
+				}
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		default:
+			return nil, fmt.Errorf("%w: unexpected character %q", errInvalidGraph, r)
+		}
+	}
+	return tokens, nil
+}
+
+func isDOTIdentifierRune(r rune) bool {
+	return r == '_' || r == '.' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isDOTPunctuation(token string) bool {
+	switch token {
+	case "{", "}", "[", "]", ";", ",", "=", "->", "--":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package gridder
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const testDOT = `// pipeline
+digraph "Pipeline" {
+	rankdir = LR;
+	node [shape=box];
+	edge [style=dashed];
+	fetch [label="Fetch data", shape=box, cell="0,1"];
+	parse -> validate -> store /* chain */;
+	fetch -> parse [color="red"];
+	# trailing comment
+}`
+
+func TestParseDOT(t *testing.T) {
+	graph, err := ParseDOT(strings.NewReader(testDOT))
+	assert.Nil(t, err)
+	assert.True(t, graph.Directed)
+	assert.Equal(t, len(graph.Nodes), 4)
+	assert.Equal(t, graph.Nodes[0].Label(), "Fetch data")
+	assert.Equal(t, graph.Nodes[1].Label(), "parse")
+	assert.Equal(t, graph.Nodes[0].Attributes["cell"], "0,1")
+	assert.Equal(t, graph.Nodes[3].Attributes["shape"], "box")
+	assert.Equal(t, graph.Edges, []GraphEdge{
+		{From: "parse", To: "validate", Attributes: map[string]string{"style": "dashed"}},
+		{From: "validate", To: "store", Attributes: map[string]string{"style": "dashed"}},
+		{From: "fetch", To: "parse", Attributes: map[string]string{"color": "red", "style": "dashed"}},
+	})
+
+	graph, err = ParseDOT(strings.NewReader(`graph { a -- b }`))
+	assert.Nil(t, err)
+	assert.False(t, graph.Directed)
+
+	for _, source := range []string{
+		`tree { a }`,
+		`graph { a -> b }`,
+		`digraph { subgraph cluster { a } }`,
+		`digraph { a [label] }`,
+		`digraph { a -> }`,
+		`digraph { "unterminated }`,
+		`digraph { a /* unterminated }`,
+		`digraph { a`,
+		`digraph { a @ b }`,
+	} {
+		_, err = ParseDOT(strings.NewReader(source))
+		assert.NotNil(t, err, source)
+	}
+}
+
+func TestPlaceGraph(t *testing.T) {
+	graph, err := ParseDOT(strings.NewReader(testDOT))
+	assert.Nil(t, err)
+
+	cells, err := placeGraph(graph, map[string]Cell{"store": {Row: 3, Column: 3}})
+	assert.Nil(t, err)
+	assert.Equal(t, cells, map[string]Cell{
+		"fetch":    {Row: 0, Column: 1},
+		"parse":    {Row: 1, Column: 0},
+		"validate": {Row: 2, Column: 0},
+		"store":    {Row: 3, Column: 3},
+	})
+
+	cyclic, err := ParseDOT(strings.NewReader(`digraph { a -> b -> a }`))
+	assert.Nil(t, err)
+
+	cells, err = placeGraph(cyclic, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, len(cells), 2)
+
+	invalid, err := ParseDOT(strings.NewReader(`digraph { a [cell="x"] }`))
+	assert.Nil(t, err)
+
+	_, err = placeGraph(invalid, nil)
+	assert.NotNil(t, err)
+}
+
+func TestDrawGraph(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 400, Height: 400}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+
+	graph, err := ParseDOT(strings.NewReader(testDOT))
+	assert.Nil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 12})
+
+	err = gridder.DrawGraph(graph, map[string]Cell{"store": {Row: 4, Column: 0}}, fontFace)
+	assert.NotNil(t, err)
+
+	err = gridder.DrawGraph(graph, nil, fontFace)
+	assert.Nil(t, err)
+
+	undirected, err := ParseDOT(strings.NewReader(`graph { a -- b; b -- c }`))
+	assert.Nil(t, err)
+
+	err = gridder.DrawGraph(undirected, nil, nil, GraphConfig{NodeScale: 0.8})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,54 @@
+// Package ebitengridder keeps an ebiten image in sync with a Gridder for game boards drawn every frame.
+// It is a separate module so that the gridder module does not depend on ebiten
+package ebitengridder
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/rageofgods/gridder"
+)
+
+// Board holds an *ebiten.Image of a Gridder and uploads only the pixels that changed since the last Update
+type Board struct {
+	gridder *gridder.Gridder
+	pixels  *image.RGBA
+	image   *ebiten.Image
+}
+
+// NewBoard creates a board for a Gridder rendering images of the given size
+func NewBoard(g *gridder.Gridder, width int, height int) *Board {
+	return &Board{
+		gridder: g,
+		pixels:  image.NewRGBA(image.Rect(0, 0, width, height)),
+		image:   ebiten.NewImage(width, height),
+	}
+}
+
+// Update uploads the changed region of the grid to the board image and returns its bounds
+func (b *Board) Update() image.Rectangle {
+	changed := b.gridder.UpdateRGBA(b.pixels)
+	if changed.Empty() {
+		return changed
+	}
+
+	region := b.pixels.SubImage(changed).(*image.RGBA)
+	pix := make([]byte, 0, changed.Dx()*changed.Dy()*4)
+	for y := changed.Min.Y; y < changed.Max.Y; y++ {
+		offset := region.PixOffset(changed.Min.X, y)
+		pix = append(pix, region.Pix[offset:offset+changed.Dx()*4]...)
+	}
+	b.image.SubImage(changed).(*ebiten.Image).WritePixels(pix)
+	return changed
+}
+
+// Image gets the board image, call Update first to bring it up to date
+func (b *Board) Image() *ebiten.Image {
+	return b.image
+}
+
+// Draw updates the board and draws it onto screen with the given options, typically from ebiten.Game.Draw
+func (b *Board) Draw(screen *ebiten.Image, options *ebiten.DrawImageOptions) {
+	b.Update()
+	screen.DrawImage(b.image, options)
+}
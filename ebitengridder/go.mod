@@ -0,0 +1,23 @@
+module github.com/rageofgods/gridder/ebitengridder
+
+go 1.25.0
+
+replace github.com/rageofgods/gridder => ../
+
+require (
+	github.com/hajimehoshi/ebiten/v2 v2.10.4
+	github.com/rageofgods/gridder v0.0.0
+)
+
+require (
+	github.com/ebitengine/gomobile v0.0.0-20260820040257-d11f821a26a6 // indirect
+	github.com/ebitengine/hideconsole v1.0.0 // indirect
+	github.com/ebitengine/purego v0.11.0 // indirect
+	github.com/fogleman/gg v1.3.0 // indirect
+	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0 // indirect
+	golang.org/x/image v0.45.0 // indirect
+	golang.org/x/sync v0.22.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.41.0 // indirect
+	google.golang.org/protobuf v1.36.11 // indirect
+)
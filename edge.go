@@ -0,0 +1,101 @@
+package gridder
+
+import (
+	"fmt"
+)
+
+// DrawEdge draws a line along a side of a cell, such as a wall of a maze or a fence. The line is centered on the
+// side, so that the edges of neighboring cells meet and the sides shared by two cells are drawn once
+func (g *Gridder) DrawEdge(row int, column int, side Side, edgeConfigs ...EdgeConfig) error {
+	return g.retainCells([]Cell{{Row: row, Column: column}}, func(cells []Cell) error {
+		return g.drawEdge(cells[0].Row, cells[0].Column, side, edgeConfigs...)
+	})
+}
+
+func (g *Gridder) drawEdge(row int, column int, side Side, edgeConfigs ...EdgeConfig) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+	if side < SideTop || side > SideLeft {
+		return fmt.Errorf("%w: side %d", errInvalidValue, side)
+	}
+
+	edgeConfig := getFirstEdgeConfig(edgeConfigs...).withStyle(g.getCellStyle(row, column))
+	err = edgeConfig.validate(g.maxStrokeWidth())
+	if err != nil {
+		return err
+	}
+
+	area := g.getCellArea(row, column)
+	strokeWidth, ok, err := g.fitStroke(row, column, area, edgeConfig.GetStrokeWidth())
+	if err != nil || !ok {
+		return err
+	}
+
+	left, top := area.center.X-area.width/2, area.center.Y-area.height/2
+	right, bottom := area.center.X+area.width/2, area.center.Y+area.height/2
+	x1, y1, x2, y2 := left, top, right, top
+	switch side {
+	case SideRight:
+		x1, y1, x2, y2 = right, top, right, bottom
+	case SideBottom:
+		x1, y1, x2, y2 = left, bottom, right, bottom
+	case SideLeft:
+		x1, y1, x2, y2 = left, top, left, bottom
+	}
+
+	g.ctx.Push()
+	dashes := edgeConfig.GetDashes()
+	if dashes > 0 {
+		g.ctx.SetDash(dashes)
+	} else {
+		g.ctx.SetDash()
+	}
+	g.ctx.SetColor(edgeConfig.GetColor())
+	g.stroke(strokeWidth, func() {
+		g.ctx.DrawLine(x1, y1, x2, y2)
+	})
+	g.ctx.Pop()
+	return nil
+}
+
+// DrawCorner draws a square marker centered on a corner of a cell, such as a pillar where the walls of a dungeon meet
+func (g *Gridder) DrawCorner(row int, column int, corner Corner, cornerConfigs ...CornerConfig) error {
+	return g.retainCells([]Cell{{Row: row, Column: column}}, func(cells []Cell) error {
+		return g.drawCorner(cells[0].Row, cells[0].Column, corner, cornerConfigs...)
+	})
+}
+
+func (g *Gridder) drawCorner(row int, column int, corner Corner, cornerConfigs ...CornerConfig) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+	if corner < CornerTopLeft || corner > CornerBottomRight {
+		return fmt.Errorf("%w: corner %d", errInvalidValue, corner)
+	}
+
+	cornerConfig := getFirstCornerConfig(cornerConfigs...).withStyle(g.getCellStyle(row, column))
+	err = cornerConfig.validate()
+	if err != nil {
+		return err
+	}
+
+	area := g.getCellArea(row, column)
+	x, y := area.center.X-area.width/2, area.center.Y-area.height/2
+	if corner == CornerTopRight || corner == CornerBottomRight {
+		x += area.width
+	}
+	if corner == CornerBottomLeft || corner == CornerBottomRight {
+		y += area.height
+	}
+
+	size := cornerConfig.GetSize()
+	g.ctx.Push()
+	g.ctx.SetColor(cornerConfig.GetColor())
+	g.ctx.DrawRectangle(x-size/2, y-size/2, size, size)
+	g.ctx.Fill()
+	g.ctx.Pop()
+	return nil
+}
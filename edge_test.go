@@ -0,0 +1,48 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawEdge(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+
+	red := color.RGBA{R: 255, A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	assert.Nil(t, gridder.DrawEdge(1, 1, SideTop, EdgeConfig{StrokeWidth: 4, Color: red}))
+	assert.Nil(t, gridder.DrawEdge(1, 1, SideRight, EdgeConfig{StrokeWidth: 4, Color: red}))
+	assert.Equal(t, gridder.operations.cellsAt(1), []Cell{{Row: 1, Column: 1}})
+
+	img := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(37, 25)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 40)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(37, 50)), white)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(25, 40)), white)
+
+	assert.ErrorIs(t, gridder.DrawEdge(4, 0, SideTop), errOutOfBounds)
+	assert.ErrorIs(t, gridder.DrawEdge(0, 0, Side(4)), errInvalidValue)
+	assert.ErrorIs(t, gridder.DrawEdge(0, 0, SideLeft, EdgeConfig{StrokeWidth: 1000}), errInvalidValue)
+}
+
+func TestDrawCorner(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+
+	red := color.RGBA{R: 255, A: 255}
+	assert.Nil(t, gridder.DrawCorner(1, 1, CornerBottomRight, CornerConfig{Size: 6, Color: red}))
+	assert.Nil(t, gridder.DrawCorner(0, 0, CornerTopLeft, CornerConfig{Color: red}))
+
+	img := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(49, 49)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(51, 51)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(1, 1)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(45, 45)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+	assert.ErrorIs(t, gridder.DrawCorner(0, 0, Corner(-1)), errInvalidValue)
+	assert.ErrorIs(t, gridder.DrawCorner(0, 0, CornerTopRight, CornerConfig{Size: -1}), errInvalidValue)
+	assert.ErrorIs(t, gridder.DrawCorner(0, 5, CornerTopRight), errOutOfBounds)
+}
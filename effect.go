@@ -0,0 +1,140 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+)
+
+// Blur blurs everything drawn so far in the block of cells between two corner cells with a Gaussian blur of a
+// standard deviation in pixels. Pixels outside of the block are left out of the blur, so that they do not bleed in
+func (g *Gridder) Blur(row1 int, column1 int, row2 int, column2 int, sigma float64) error {
+	err := nonNegative("blur sigma", sigma)
+	if err != nil {
+		return err
+	}
+	return g.applyEffect(row1, column1, row2, column2, func(img *image.RGBA, region image.Rectangle) {
+		if sigma > 0 {
+			blurRegion(img, region, sigma)
+		}
+	})
+}
+
+// Pixelate replaces everything drawn so far in the block of cells between two corner cells with squares of a size in
+// pixels, each in the average color of its pixels, counted from the top left of the block
+func (g *Gridder) Pixelate(row1 int, column1 int, row2 int, column2 int, size int) error {
+	if size <= 0 {
+		return fmt.Errorf("%w: pixelation size %d", errInvalidValue, size)
+	}
+	return g.applyEffect(row1, column1, row2, column2, func(img *image.RGBA, region image.Rectangle) {
+		pixelateRegion(img, region, size)
+	})
+}
+
+// Redact covers everything drawn so far in the block of cells between two corner cells with a solid color, black
+// when it is nil
+func (g *Gridder) Redact(row1 int, column1 int, row2 int, column2 int, fill color.Color) error {
+	if fill == nil {
+		fill = defaultRedactColor
+	}
+	return g.applyEffect(row1, column1, row2, column2, func(img *image.RGBA, region image.Rectangle) {
+		draw.Draw(img, region, image.NewUniform(fill), image.Point{}, draw.Src)
+	})
+}
+
+// applyEffect runs an effect on the pixels of a block of cells as a retained operation, so that it is applied again
+// when the grid is re-rendered
+func (g *Gridder) applyEffect(row1 int, column1 int, row2 int, column2 int, effect func(img *image.RGBA, region image.Rectangle)) error {
+	cells := []Cell{{Row: row1, Column: column1}, {Row: row2, Column: column2}}
+	return g.retainCells(cells, func(cells []Cell) error {
+		for _, cell := range cells {
+			err := g.verifyInBounds(cell.Row, cell.Column)
+			if err != nil {
+				return err
+			}
+		}
+
+		img, ok := g.ctx.Image().(*image.RGBA)
+		if !ok {
+			return nil
+		}
+		region := g.cellsRegion(cells[0], cells[1]).Intersect(img.Bounds())
+		if !region.Empty() {
+			effect(img, region)
+		}
+		return nil
+	})
+}
+
+// cellsRegion gets the pixels of the block of cells between two corner cells, rounded outwards
+func (g *Gridder) cellsRegion(cell1 Cell, cell2 Cell) image.Rectangle {
+	minRow, maxRow := minInt(cell1.Row, cell2.Row), maxInt(cell1.Row, cell2.Row)
+	minColumn, maxColumn := minInt(cell1.Column, cell2.Column), maxInt(cell1.Column, cell2.Column)
+
+	topLeft := g.getCellCenter(minRow, minColumn)
+	width, height := g.getCellDimensions(minRow, minColumn)
+	x0, y0 := topLeft.X-width/2, topLeft.Y-height/2
+
+	bottomRight := g.getCellCenter(maxRow, maxColumn)
+	width, height = g.getCellDimensions(maxRow, maxColumn)
+	x1, y1 := bottomRight.X+width/2, bottomRight.Y+height/2
+	return image.Rect(int(math.Floor(x0)), int(math.Floor(y0)), int(math.Ceil(x1)), int(math.Ceil(y1)))
+}
+
+// blurRegion blurs a region in two passes of a one dimensional Gaussian kernel reaching three standard deviations,
+// repeating the edge pixels of the region
+func blurRegion(img *image.RGBA, region image.Rectangle, sigma float64) {
+	half := int(math.Ceil(3 * sigma))
+	kernel := make([]float64, 2*half+1)
+	var sum float64
+	for i := range kernel {
+		distance := float64(i - half)
+		kernel[i] = math.Exp(-distance * distance / (2 * sigma * sigma))
+		sum += kernel[i]
+	}
+	for i := range kernel {
+		kernel[i] /= sum
+	}
+
+	// premultiplied channels blur without dark fringes around transparent pixels
+	width, height := region.Dx(), region.Dy()
+	buffer := make([]float64, width*height*4)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			for i, weight := range kernel {
+				source := img.PixOffset(region.Min.X+minInt(maxInt(x+i-half, 0), width-1), region.Min.Y+y)
+				for channel := 0; channel < 4; channel++ {
+					buffer[(y*width+x)*4+channel] += weight * float64(img.Pix[source+channel])
+				}
+			}
+		}
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var pixel [4]float64
+			for i, weight := range kernel {
+				source := (minInt(maxInt(y+i-half, 0), height-1)*width + x) * 4
+				for channel := range pixel {
+					pixel[channel] += weight * buffer[source+channel]
+				}
+			}
+			target := img.PixOffset(region.Min.X+x, region.Min.Y+y)
+			for channel, value := range pixel {
+				img.Pix[target+channel] = uint8(math.Min(math.Round(value), 255))
+			}
+		}
+	}
+}
+
+// pixelateRegion fills squares of a region with their average color
+func pixelateRegion(img *image.RGBA, region image.Rectangle, size int) {
+	for y := region.Min.Y; y < region.Max.Y; y += size {
+		for x := region.Min.X; x < region.Max.X; x += size {
+			square := image.Rect(x, y, x+size, y+size).Intersect(region)
+			draw.Draw(img, square, image.NewUniform(averageColor(img, square)), image.Point{}, draw.Src)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.NRGBA{R: 255, A: 255}))
+	assert.Nil(t, gridder.Redact(0, 0, 0, 0, nil))
+	assert.Nil(t, gridder.Redact(1, 1, 1, 1, color.NRGBA{B: 255, A: 255}))
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(25, 25)), color.NRGBA{A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 75)), color.NRGBA{B: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 25)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+
+	// redactions are applied again when the grid is re-rendered
+	assert.Nil(t, gridder.AddRow())
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(25, 15)), color.NRGBA{A: 255})
+}
+
+func TestPixelate(t *testing.T) {
+	gridder, _ := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, gridder.PaintCell(0, 0, color.NRGBA{R: 255, A: 255}))
+	assert.Nil(t, gridder.PaintCell(0, 1, color.NRGBA{B: 255, A: 255}))
+	assert.Nil(t, gridder.Pixelate(0, 1, 0, 0, 100))
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(10, 10)), color.NRGBA{R: 127, B: 127, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(90, 40)), color.NRGBA{R: 127, B: 127, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(10, 60)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+
+	err := gridder.Pixelate(0, 0, 0, 0, 0)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestBlur(t *testing.T) {
+	gridder, _ := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	assert.Nil(t, gridder.PaintCell(1, 0, color.Black))
+	assert.Nil(t, gridder.Blur(0, 0, 0, 1, 4))
+
+	img := gridder.image()
+	edge := color.NRGBAModel.Convert(img.At(50, 25)).(color.NRGBA)
+	assert.True(t, edge.R > 64 && edge.R < 192)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(5, 25)), color.NRGBA{A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(95, 25)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	// the cells below are left out of the blur
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(55, 51)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+
+	err := gridder.Blur(0, 0, 0, 0, -1)
+	assert.True(t, errors.Is(err, errInvalidValue))
+	err = gridder.Blur(0, 0, 2, 2, 1)
+	assert.Equal(t, err, errOutOfBounds)
+}
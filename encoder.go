@@ -0,0 +1,187 @@
+package gridder
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/bmp"
+)
+
+// EncoderFunc encodes an image and writes it to w
+type EncoderFunc func(w io.Writer, img image.Image) error
+
+var encoders = struct {
+	sync.RWMutex
+	formats map[string]EncoderFunc
+}{formats: map[string]EncoderFunc{
+	"png":  encodePNG,
+	"tif":  encodeDefaultTIFF,
+	"tiff": encodeDefaultTIFF,
+	"bmp":  bmp.Encode,
+	"jpg":  encodeDefaultJPEG,
+	"jpeg": encodeDefaultJPEG,
+	"gif":  encodeDefaultGIF,
+}}
+
+// documentEncoders encode formats that carry more than the rendered image, such as links
+var documentEncoders = map[string]func(g *Gridder, w io.Writer) error{
+	"svg": (*Gridder).EncodeSVG,
+	"pdf": (*Gridder).EncodePDF,
+}
+
+// RegisterEncoder registers an encoder for a format. Formats are matched case-insensitively against file extensions,
+// so applications can plug in formats such as AVIF or WebP, which have no encoder in the standard library.
+// Registering an existing format replaces its encoder
+func RegisterEncoder(format string, fn EncoderFunc) {
+	encoders.Lock()
+	defer encoders.Unlock()
+	encoders.formats[normalizeFormat(format)] = fn
+}
+
+// Save renders the grid and saves it to a file, using the encoder registered for the file extension. SVG and PDF
+// files keep the links of cells
+func (g *Gridder) Save(path string) error {
+	encode, err := g.getDocumentEncoder(filepath.Ext(path))
+	if err != nil {
+		return err
+	}
+	return saveFile(path, encode)
+}
+
+// saveFile creates a file and writes it with an encoder
+func saveFile(path string, encode func(w io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = encode(file)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// SaveAuto renders the grid as a PNG and saves it in dir, named from the hash of its content, and returns the path.
+// Identical images share a path, an existing file is kept as is
+func (g *Gridder) SaveAuto(dir string) (string, error) {
+	var buffer bytes.Buffer
+	err := g.EncodePNG(&buffer)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(buffer.Bytes())
+	path := filepath.Join(dir, hex.EncodeToString(hash[:])+".png")
+	_, err = os.Stat(path)
+	if err == nil {
+		return path, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	// write to a temporary file first so that concurrent saves never expose a partial file
+	file, err := ioutil.TempFile(dir, ".gridder-*.png")
+	if err != nil {
+		return "", err
+	}
+	_, err = file.Write(buffer.Bytes())
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return "", err
+	}
+	err = file.Close()
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return path, nil
+}
+
+// Encode renders the grid and writes it to w using the encoder registered for a format. SVG and PDF output keeps
+// the links of cells
+func (g *Gridder) Encode(w io.Writer, format string) error {
+	encode, err := g.getDocumentEncoder(format)
+	if err != nil {
+		return err
+	}
+	return encode(w)
+}
+
+// getDocumentEncoder gets the encoder of a format for the grid, registered encoders replace the built-in SVG and
+// PDF encoders. PNG and JPEG output get the metadata of the grid
+func (g *Gridder) getDocumentEncoder(format string) (func(w io.Writer) error, error) {
+	encoder, err := getEncoder(format)
+	if err == nil {
+		return func(w io.Writer) error { return g.encodeWithMetadata(w, format, encoder, g.image()) }, nil
+	}
+
+	documentEncoder, ok := documentEncoders[normalizeFormat(format)]
+	if !ok {
+		return nil, err
+	}
+	return func(w io.Writer) error { return documentEncoder(g, w) }, nil
+}
+
+// encodeWithMetadata encodes an image of the grid in a format, embedding the metadata of the grid in PNG and JPEG
+// output
+func (g *Gridder) encodeWithMetadata(w io.Writer, format string, encoder EncoderFunc, img image.Image) error {
+	var metadataWriter *segmentWriter
+	switch normalizeFormat(format) {
+	case "png":
+		metadataWriter = g.metadataWriter(w)
+	case "jpg", "jpeg":
+		metadataWriter = g.jpegMetadataWriter(w)
+	default:
+		return encoder(w, img)
+	}
+
+	err := encoder(metadataWriter, img)
+	if err != nil {
+		return err
+	}
+	return metadataWriter.Close()
+}
+
+// EncodeImage writes an image, such as a rendered replay frame, to w using the encoder registered for a format
+func EncodeImage(w io.Writer, img image.Image, format string) error {
+	encoder, err := getEncoder(format)
+	if err != nil {
+		return err
+	}
+	return encoder(w, img)
+}
+
+func encodeDefaultTIFF(w io.Writer, img image.Image) error {
+	return encodeTIFF(w, img, defaultImageDPI)
+}
+
+func getEncoder(format string) (EncoderFunc, error) {
+	encoders.RLock()
+	defer encoders.RUnlock()
+
+	encoder, ok := encoders.formats[normalizeFormat(format)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errUnknownFormat, format)
+	}
+	return encoder, nil
+}
+
+func normalizeFormat(format string) string {
+	return strings.ToLower(strings.TrimPrefix(format, "."))
+}
@@ -0,0 +1,120 @@
+package gridder
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterEncoder(t *testing.T) {
+	errEncode := errors.New("encode")
+	RegisterEncoder(".TEST", func(w io.Writer, img image.Image) error {
+		_, err := w.Write([]byte("test"))
+		return err
+	})
+	RegisterEncoder("fail", func(w io.Writer, img image.Image) error {
+		return errEncode
+	})
+
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	buffer := new(bytes.Buffer)
+	err = gridder.Encode(buffer, "test")
+	assert.Nil(t, err)
+	assert.Equal(t, buffer.String(), "test")
+
+	err = gridder.Encode(buffer, "unknown")
+	assert.NotNil(t, err)
+
+	err = gridder.Encode(buffer, "fail")
+	assert.Equal(t, err, errEncode)
+}
+
+func TestEncodeImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	buffer := new(bytes.Buffer)
+	err := EncodeImage(buffer, img, ".png")
+	assert.Nil(t, err)
+
+	decoded, err := png.Decode(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, decoded.Bounds(), img.Bounds())
+
+	err = EncodeImage(buffer, img, "unknown")
+	assert.True(t, errors.Is(err, errUnknownFormat))
+}
+
+func TestSaveToPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gridder")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	gridder, err := New(ImageConfig{Width: 10, Height: 20}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	err = gridder.Save(filepath.Join(dir, "grid.avif"))
+	assert.NotNil(t, err)
+
+	err = gridder.Save(filepath.Join(dir, "missing", "grid.png"))
+	assert.NotNil(t, err)
+
+	path := filepath.Join(dir, "grid.PNG")
+	err = gridder.Save(path)
+	assert.Nil(t, err)
+
+	file, err := os.Open(path)
+	assert.Nil(t, err)
+	defer file.Close()
+
+	config, err := png.DecodeConfig(file)
+	assert.Nil(t, err)
+	assert.Equal(t, config.Width, 10)
+	assert.Equal(t, config.Height, 20)
+}
+
+func TestSaveAuto(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gridder")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	gridder, err := New(ImageConfig{Width: 10, Height: 20}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	path, err := gridder.SaveAuto(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Dir(path), dir)
+	assert.Equal(t, filepath.Ext(path), ".png")
+	assert.Equal(t, len(filepath.Base(path)), 64+4)
+
+	var buffer bytes.Buffer
+	assert.Nil(t, gridder.EncodePNG(&buffer))
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, data, buffer.Bytes())
+
+	same, err := gridder.SaveAuto(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, same, path)
+
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	other, err := gridder.SaveAuto(dir)
+	assert.Nil(t, err)
+	assert.NotEqual(t, other, path)
+
+	files, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, len(files), 2)
+
+	_, err = gridder.SaveAuto(filepath.Join(dir, "missing"))
+	assert.NotNil(t, err)
+}
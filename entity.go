@@ -0,0 +1,205 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+
+	"github.com/fogleman/gg"
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/math/f64"
+)
+
+// Entity is a piece drawn above the grid at a position of its own, such as a board game piece or an agent.
+// Every shape that is set is drawn, in the order image, rectangle, circle and line. Images are scaled to fit the cell
+// with Filter, use ScaleNearest for pixel art
+type Entity struct {
+	Image     image.Image
+	Filter    ScaleFilter
+	Rectangle *RectangleConfig
+	Circle    *CircleConfig
+	Line      *LineConfig
+}
+
+type entityPosition struct {
+	entity Entity
+	row    float64
+	column float64
+	placed bool
+}
+
+// RegisterEntity registers an entity, replacing the entity registered with the same id.
+// Entities are drawn in registration order once they are placed in a cell
+func (g *Gridder) RegisterEntity(id string, entity Entity) error {
+	if g.parent != nil {
+		return g.parent.RegisterEntity(id, entity)
+	}
+
+	err := g.validateEntity(entity)
+	if err != nil {
+		return err
+	}
+
+	if position, ok := g.entities[id]; ok {
+		position.entity = entity
+	} else {
+		if g.entities == nil {
+			g.entities = make(map[string]*entityPosition)
+		}
+		g.entities[id] = &entityPosition{entity: entity}
+		g.entityOrder = append(g.entityOrder, id)
+	}
+	return g.recordReplay(nil, func() replayCommand { return entityCommand(id, entity) })
+}
+
+// RemoveEntity removes an entity
+func (g *Gridder) RemoveEntity(id string) {
+	if g.parent != nil {
+		g.parent.RemoveEntity(id)
+		return
+	}
+
+	if _, ok := g.entities[id]; !ok {
+		return
+	}
+
+	delete(g.entities, id)
+	for i, entityID := range g.entityOrder {
+		if entityID == id {
+			g.entityOrder = append(g.entityOrder[:i], g.entityOrder[i+1:]...)
+			break
+		}
+	}
+	g.recordReplay(nil, func() replayCommand { return replayCommand{Op: "remove", ID: id} })
+}
+
+// SetEntityCell places an entity in a cell
+func (g *Gridder) SetEntityCell(id string, row int, column int) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+	err = g.setEntityPosition(id, float64(row), float64(column))
+	return g.recordReplay(err, func() replayCommand { return placeCommand(id, row, column) })
+}
+
+// GetEntityCell gets the cell of an entity, rounding positions between cells to the nearest cell
+func (g *Gridder) GetEntityCell(id string) (Cell, error) {
+	position, err := g.getEntity(id)
+	if err != nil {
+		return Cell{}, err
+	}
+
+	if !position.placed {
+		return Cell{}, fmt.Errorf("%w: %q is not placed", errUnknownEntity, id)
+	}
+	return Cell{Row: int(math.Round(position.row)), Column: int(math.Round(position.column))}, nil
+}
+
+func (g *Gridder) setEntityPosition(id string, row float64, column float64) error {
+	position, err := g.getEntity(id)
+	if err != nil {
+		return err
+	}
+
+	position.row, position.column, position.placed = row, column, true
+	return nil
+}
+
+func (g *Gridder) getEntity(id string) (*entityPosition, error) {
+	if g.parent != nil {
+		return g.parent.getEntity(id)
+	}
+
+	position, ok := g.entities[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errUnknownEntity, id)
+	}
+	return position, nil
+}
+
+func (g *Gridder) validateEntity(entity Entity) error {
+	maxStroke := g.maxStrokeWidth()
+	if entity.Rectangle != nil {
+		err := entity.Rectangle.validate(maxStroke)
+		if err != nil {
+			return err
+		}
+	}
+	if entity.Circle != nil {
+		err := entity.Circle.validate(maxStroke)
+		if err != nil {
+			return err
+		}
+	}
+	if entity.Line != nil {
+		return entity.Line.validate(maxStroke)
+	}
+	return nil
+}
+
+// renderEntities draws the placed entities into a transparent layer
+func (g *Gridder) renderEntities() image.Image {
+	ctx := newContext(g.imageConfig, g.gridConfig)
+	for _, id := range g.entityOrder {
+		position := g.entities[id]
+		if !position.placed {
+			continue
+		}
+
+		center := g.getPositionCenter(position.row, position.column)
+		entity := position.entity
+		if entity.Image != nil {
+			cellWidth, cellHeight := g.getCellDimensions(int(math.Round(position.row)), int(math.Round(position.column)))
+			paintImage(ctx, center, entity.Image, cellWidth, cellHeight, entity.Filter)
+		}
+		if entity.Rectangle != nil {
+			paintRectangle(ctx, g.imageConfig.GetRough(), center, *entity.Rectangle)
+		}
+		if entity.Circle != nil {
+			paintCircle(ctx, g.imageConfig.GetRough(), center, *entity.Circle)
+		}
+		if entity.Line != nil {
+			paintLine(ctx, g.imageConfig.GetRough(), center, *entity.Line)
+		}
+	}
+	return ctx.Image()
+}
+
+// getPositionCenter gets the center of a position between cells, interpolating between the cell centers around it
+func (g *Gridder) getPositionCenter(row float64, column float64) *gg.Point {
+	row0, column0 := math.Floor(row), math.Floor(column)
+	center0 := g.getCellCenter(int(row0), int(column0))
+	center1 := g.getCellCenter(int(row0)+1, int(column0)+1)
+	return &gg.Point{
+		X: center0.X + (center1.X-center0.X)*(column-column0),
+		Y: center0.Y + (center1.Y-center0.Y)*(row-row0),
+	}
+}
+
+// paintImage draws an image centered on a point, scaled to fit width and height
+func paintImage(ctx *gg.Context, center *gg.Point, img image.Image, width float64, height float64, filter ScaleFilter) {
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return
+	}
+
+	scale := math.Min(width/float64(bounds.Dx()), height/float64(bounds.Dy()))
+	if filter == ScaleNearest {
+		// gg always interpolates bilinearly, so nearest neighbor scaling draws directly into the context image
+		x, y := ctx.TransformPoint(center.X, center.Y)
+		x -= scale * (float64(bounds.Min.X) + float64(bounds.Dx())/2)
+		y -= scale * (float64(bounds.Min.Y) + float64(bounds.Dy())/2)
+		transform := f64.Aff3{scale, 0, x, 0, scale, y}
+		xdraw.NearestNeighbor.Transform(ctx.Image().(draw.Image), transform, img, bounds, xdraw.Over, nil)
+		return
+	}
+
+	ctx.Push()
+	ctx.Translate(center.X, center.Y)
+	ctx.Scale(scale, scale)
+	ctx.Translate(-float64(bounds.Min.X), -float64(bounds.Min.Y))
+	ctx.DrawImageAnchored(img, 0, 0, 0.5, 0.5)
+	ctx.Pop()
+}
@@ -0,0 +1,97 @@
+package gridder
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntities(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.SetEntityCell("piece", 0, 0)
+	assert.True(t, errors.Is(err, errUnknownEntity))
+
+	err = gridder.RegisterEntity("piece", Entity{Circle: &CircleConfig{Radius: -1}})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.RegisterEntity("piece", Entity{Circle: &CircleConfig{Radius: 10, Color: color.Black}})
+	assert.Nil(t, err)
+
+	_, err = gridder.GetEntityCell("piece")
+	assert.True(t, errors.Is(err, errUnknownEntity))
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(25, 25)), color.Gray{Y: 255})
+
+	assert.Equal(t, gridder.SetEntityCell("piece", 2, 0), errOutOfBounds)
+	assert.Nil(t, gridder.SetEntityCell("piece", 1, 1))
+	cell, err := gridder.GetEntityCell("piece")
+	assert.Nil(t, err)
+	assert.Equal(t, cell, Cell{Row: 1, Column: 1})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(75, 75)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(gridder.ctx.Image().At(75, 75)), color.Gray{Y: 255})
+
+	assert.Nil(t, gridder.InsertColumn(0))
+	cell, err = gridder.GetEntityCell("piece")
+	assert.Nil(t, err)
+	assert.Equal(t, cell, Cell{Row: 1, Column: 2})
+
+	gridder.RemoveEntity("piece")
+	gridder.RemoveEntity("piece")
+	assert.Equal(t, len(gridder.entityOrder), 0)
+	_, err = gridder.GetEntityCell("piece")
+	assert.True(t, errors.Is(err, errUnknownEntity))
+}
+
+func TestEntityImage(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	sprite := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for x := 0; x < 2; x++ {
+		for y := 0; y < 2; y++ {
+			sprite.Set(x, y, color.Black)
+		}
+	}
+
+	assert.Nil(t, gridder.Overlay().RegisterEntity("sprite", Entity{Image: sprite}))
+	assert.Nil(t, gridder.Overlay().SetEntityCell("sprite", 0, 1))
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(60, 10)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(25, 25)), color.Gray{Y: 255})
+
+	assert.Nil(t, gridder.RegisterEntity("sprite", Entity{Rectangle: &RectangleConfig{Color: color.Black}}))
+	assert.Equal(t, gridder.entityOrder, []string{"sprite"})
+}
+
+func TestEntityImageFilter(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	checker := image.NewGray(image.Rect(0, 0, 2, 2))
+	checker.Pix = []uint8{0, 255, 255, 0}
+
+	assert.Nil(t, gridder.RegisterEntity("pixel", Entity{Image: checker, Filter: ScaleNearest}))
+	assert.Nil(t, gridder.SetEntityCell("pixel", 0, 1))
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(73, 23)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(76, 23)), color.Gray{Y: 255})
+
+	assert.Nil(t, gridder.RegisterEntity("pixel", Entity{Image: checker}))
+	gray := color.GrayModel.Convert(gridder.image().At(73, 23)).(color.Gray)
+	assert.True(t, gray.Y > 0 && gray.Y < 255)
+}
+
+func TestGetPositionCenter(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	center := gridder.getPositionCenter(0.5, 0.25)
+	assert.Equal(t, center.X, 37.5)
+	assert.Equal(t, center.Y, 50.0)
+
+	center = gridder.getPositionCenter(1, 1)
+	assert.Equal(t, center.X, 75.0)
+	assert.Equal(t, center.Y, 75.0)
+}
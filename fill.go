@@ -0,0 +1,40 @@
+package gridder
+
+import (
+	"image/color"
+)
+
+// FloodFill paints the region of cells connected by edges to a cell that satisfy the predicate.
+// Nothing is painted if the starting cell does not match
+func (g *Gridder) FloodFill(row int, column int, match func(row int, column int) bool, color color.Color) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	start := Cell{Row: row, Column: column}
+	if !match(start.Row, start.Column) {
+		return nil
+	}
+
+	visited := map[Cell]bool{start: true}
+	queue := CellSet{start}
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+
+		err = g.PaintCell(cell.Row, cell.Column, color)
+		if err != nil {
+			return err
+		}
+
+		for _, neighbor := range g.neighbors(cell.Row, cell.Column, offsets4) {
+			if visited[neighbor] || !match(neighbor.Row, neighbor.Column) {
+				continue
+			}
+			visited[neighbor] = true
+			queue = append(queue, neighbor)
+		}
+	}
+	return nil
+}
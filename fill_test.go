@@ -0,0 +1,42 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloodFill(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 300, Height: 300}, GridConfig{Rows: 3, Columns: 3})
+	assert.Nil(t, err)
+
+	wall := func(row int, column int) bool {
+		return column != 1 || row == 2
+	}
+
+	err = gridder.FloodFill(-1, 0, wall, color.Black)
+	assert.NotNil(t, err)
+
+	err = gridder.FloodFill(0, 1, wall, color.Black)
+	assert.Nil(t, err)
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(50, 50)), color.RGBAModel.Convert(color.White))
+
+	err = gridder.FloodFill(0, 0, wall, color.Black)
+	assert.Nil(t, err)
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(50, 50)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(250, 50)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(150, 50)), color.RGBAModel.Convert(color.White))
+}
+
+func TestFloodFillMasked(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 300, Height: 300}, GridConfig{
+		Rows: 3, Columns: 3, CellMask: [][]bool{{true, false, true}, {true, false, true}, {true, true, true}},
+	})
+	assert.Nil(t, err)
+
+	err = gridder.FloodFill(0, 0, func(row int, column int) bool { return true }, color.Black)
+	assert.Nil(t, err)
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(250, 50)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, len(gridder.operations.order), 7)
+}
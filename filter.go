@@ -0,0 +1,107 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// Filter post-processes the rendered image
+type Filter func(img image.Image) image.Image
+
+// AddFilter appends a filter to the chain applied to the rendered image before it is encoded or exported, in the
+// order the filters were added
+func (g *Gridder) AddFilter(filter Filter) {
+	if g.parent != nil {
+		g.parent.AddFilter(filter)
+		return
+	}
+	g.filters = append(g.filters, filter)
+}
+
+// ClearFilters removes every filter
+func (g *Gridder) ClearFilters() {
+	if g.parent != nil {
+		g.parent.ClearFilters()
+		return
+	}
+	g.filters = nil
+}
+
+// applyFilters runs the filter chain
+func (g *Gridder) applyFilters(img image.Image) image.Image {
+	for _, filter := range g.filters {
+		img = filter(img)
+	}
+	return img
+}
+
+// Grayscale creates a filter converting colors to their luma
+func Grayscale() Filter {
+	return pixelFilter(func(x int, y int, c color.NRGBA) color.NRGBA {
+		luma := clampChannel(0.299*float64(c.R) + 0.587*float64(c.G) + 0.114*float64(c.B))
+		return color.NRGBA{R: luma, G: luma, B: luma, A: c.A}
+	})
+}
+
+// Sepia creates a filter toning colors in brown, like old photographs
+func Sepia() Filter {
+	return pixelFilter(func(x int, y int, c color.NRGBA) color.NRGBA {
+		r, g, b := float64(c.R), float64(c.G), float64(c.B)
+		return color.NRGBA{
+			R: clampChannel(0.393*r + 0.769*g + 0.189*b),
+			G: clampChannel(0.349*r + 0.686*g + 0.168*b),
+			B: clampChannel(0.272*r + 0.534*g + 0.131*b),
+			A: c.A,
+		}
+	})
+}
+
+// BrightnessContrast creates a filter shifting the brightness by a fraction of full brightness between -1 and 1, and
+// scaling the contrast around mid gray by 1 plus the contrast, so that -1 flattens colors to gray and 0 keeps them
+func BrightnessContrast(brightness float64, contrast float64) Filter {
+	adjust := func(value uint8) uint8 {
+		return clampChannel((float64(value)-127.5)*(1+contrast) + 127.5 + brightness*255)
+	}
+	return pixelFilter(func(x int, y int, c color.NRGBA) color.NRGBA {
+		return color.NRGBA{R: adjust(c.R), G: adjust(c.G), B: adjust(c.B), A: c.A}
+	})
+}
+
+// Vignette creates a filter darkening the image towards its corners, by the strength between 0 and 1 at the corners
+func Vignette(strength float64) Filter {
+	return func(img image.Image) image.Image {
+		bounds := img.Bounds()
+		centerX, centerY := float64(bounds.Min.X+bounds.Max.X)/2, float64(bounds.Min.Y+bounds.Max.Y)/2
+		corner := math.Hypot(float64(bounds.Dx())/2, float64(bounds.Dy())/2)
+		return pixelFilter(func(x int, y int, c color.NRGBA) color.NRGBA {
+			distance := math.Hypot(float64(x)+0.5-centerX, float64(y)+0.5-centerY) / corner
+			factor := 1 - strength*distance*distance
+			return color.NRGBA{
+				R: clampChannel(float64(c.R) * factor),
+				G: clampChannel(float64(c.G) * factor),
+				B: clampChannel(float64(c.B) * factor),
+				A: c.A,
+			}
+		})(img)
+	}
+}
+
+// pixelFilter creates a filter mapping every pixel of the image independently
+func pixelFilter(mapPixel func(x int, y int, c color.NRGBA) color.NRGBA) Filter {
+	return func(img image.Image) image.Image {
+		bounds := img.Bounds()
+		filtered := image.NewNRGBA(bounds)
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				filtered.SetNRGBA(x, y, mapPixel(x, y, color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)))
+			}
+		}
+		return filtered
+	}
+}
+
+// clampChannel rounds a color channel value and clamps it between 0 and 255
+func clampChannel(value float64) uint8 {
+	return uint8(math.Max(0, math.Min(255, math.Round(value))))
+}
@@ -0,0 +1,51 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilters(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.NRGBA{R: 255, A: 255}))
+
+	gridder.AddFilter(Grayscale())
+	gridder.AddFilter(BrightnessContrast(0.1, 0))
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(25, 25)), color.NRGBA{R: 102, G: 102, B: 102, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 75)), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+
+	gridder.ClearFilters()
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(25, 25)), color.NRGBA{R: 255, A: 255})
+}
+
+func TestSepia(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 100, G: 100, B: 100, A: 128})
+	assert.Equal(t, Sepia()(img).At(0, 0), color.NRGBA{R: 135, G: 120, B: 94, A: 128})
+}
+
+func TestBrightnessContrast(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 0, G: 100, B: 255, A: 255})
+	assert.Equal(t, BrightnessContrast(0, -1)(img).At(0, 0), color.NRGBA{R: 128, G: 128, B: 128, A: 255})
+	assert.Equal(t, BrightnessContrast(0, 1)(img).At(0, 0), color.NRGBA{R: 0, G: 73, B: 255, A: 255})
+	assert.Equal(t, BrightnessContrast(-1, 0)(img).At(0, 0), color.NRGBA{A: 255})
+}
+
+func TestVignette(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 100, 100))
+	for i := range img.Pix {
+		img.Pix[i] = 255
+	}
+	filtered := Vignette(0.5)(img)
+	center := color.NRGBAModel.Convert(filtered.At(50, 50)).(color.NRGBA)
+	corner := color.NRGBAModel.Convert(filtered.At(0, 0)).(color.NRGBA)
+	assert.Equal(t, center.R, uint8(255))
+	assert.Equal(t, corner.R, uint8(130))
+	assert.Equal(t, corner.A, uint8(255))
+}
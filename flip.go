@@ -0,0 +1,53 @@
+package gridder
+
+import (
+	"image"
+	"image/draw"
+)
+
+// FlipHorizontal mirrors the rendered grid from left to right. Calling it again restores the original orientation.
+// The whole image is mirrored, strings included, so that the grid reads as seen in a mirror. Images and sprites are
+// mirrored in their cells with the flips of their configs
+func (g *Gridder) FlipHorizontal() {
+	g.flipHorizontal = !g.flipHorizontal
+}
+
+// FlipVertical mirrors the rendered grid from top to bottom. Calling it again restores the original orientation.
+// Like FlipHorizontal, it mirrors strings too
+func (g *Gridder) FlipVertical() {
+	g.flipVertical = !g.flipVertical
+}
+
+func flipImage(src image.Image, horizontal bool, vertical bool) image.Image {
+	if !horizontal && !vertical {
+		return src
+	}
+
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(bounds)
+	draw.Draw(rgba, bounds, src, bounds.Min, draw.Src)
+
+	width, height := bounds.Dx(), bounds.Dy()
+	if horizontal {
+		for y := 0; y < height; y++ {
+			row := rgba.Pix[y*rgba.Stride : y*rgba.Stride+width*4]
+			for left, right := 0, width-1; left < right; left, right = left+1, right-1 {
+				for i := 0; i < 4; i++ {
+					row[left*4+i], row[right*4+i] = row[right*4+i], row[left*4+i]
+				}
+			}
+		}
+	}
+
+	if vertical {
+		buffer := make([]byte, width*4)
+		for top, bottom := 0, height-1; top < bottom; top, bottom = top+1, bottom-1 {
+			topRow := rgba.Pix[top*rgba.Stride : top*rgba.Stride+width*4]
+			bottomRow := rgba.Pix[bottom*rgba.Stride : bottom*rgba.Stride+width*4]
+			copy(buffer, topRow)
+			copy(topRow, bottomRow)
+			copy(bottomRow, buffer)
+		}
+	}
+	return rgba
+}
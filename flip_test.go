@@ -0,0 +1,55 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestFlipHorizontal(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.PaintCell(0, 0, color.Black)
+	assert.Nil(t, err)
+
+	gridder.FlipHorizontal()
+	image := gridder.image()
+	assert.Equal(t, color.RGBAModel.Convert(image.At(75, 25)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(image.At(25, 25)), color.RGBAModel.Convert(color.White))
+
+	gridder.FlipHorizontal()
+	image = gridder.image()
+	assert.Equal(t, color.RGBAModel.Convert(image.At(25, 25)), color.RGBAModel.Convert(color.Black))
+}
+
+func TestFlipVertical(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.PaintCell(0, 1, color.Black)
+	assert.Nil(t, err)
+
+	gridder.FlipVertical()
+	image := gridder.image()
+	assert.Equal(t, color.RGBAModel.Convert(image.At(75, 75)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(image.At(75, 25)), color.RGBAModel.Convert(color.White))
+}
+
+func TestFlipMirrorsStrings(t *testing.T) {
+	font, err := truetype.Parse(goregular.TTF)
+	assert.Nil(t, err)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 20})
+
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.DrawString(0, 0, "R", fontFace))
+	img := gridder.image()
+
+	// the grid is mirrored as a whole, so the string is mirrored with its cell
+	gridder.FlipHorizontal()
+	assert.Equal(t, gridder.image(), flipImage(img, true, false))
+}
@@ -0,0 +1,63 @@
+package gridder
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+)
+
+const defaultFontCellFraction = 0.6
+
+// FontFace derives a font face whose height is a fraction of the smallest cell height, taking the image DPI into account
+func (g *Gridder) FontFace(ttf *truetype.Font, fraction float64) font.Face {
+	if fraction <= 0 {
+		fraction = defaultFontCellFraction
+	}
+
+	dpi := g.imageConfig.GetDPI()
+	pixels := g.minCellHeight() * fraction
+	return truetype.NewFace(ttf, &truetype.Options{
+		Size: pixels * 72 / dpi,
+		DPI:  dpi,
+	})
+}
+
+// LoadFontFace loads a TrueType font file and sizes it with FontFace
+func (g *Gridder) LoadFontFace(path string, fraction float64) (font.Face, error) {
+	fontBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ttf, err := truetype.Parse(fontBytes)
+	if err != nil {
+		return nil, err
+	}
+	return g.FontFace(ttf, fraction), nil
+}
+
+// LoadFontFaceFS loads a TrueType font file from a file system, such as an embedded one, and sizes it with FontFace
+func (g *Gridder) LoadFontFaceFS(fsys fs.FS, name string, fraction float64) (font.Face, error) {
+	fontBytes, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	ttf, err := truetype.Parse(fontBytes)
+	if err != nil {
+		return nil, err
+	}
+	return g.FontFace(ttf, fraction), nil
+}
+
+func (g *Gridder) minCellHeight() float64 {
+	minHeight := math.Inf(1)
+	for row := 0; row < g.gridConfig.GetRows(); row++ {
+		_, cellHeight := g.getCellDimensions(row, 0)
+		minHeight = math.Min(minHeight, cellHeight)
+	}
+	return minHeight
+}
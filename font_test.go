@@ -0,0 +1,49 @@
+package gridder
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestFontFace(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	ttf, _ := truetype.Parse(goregular.TTF)
+	fontFace := gridder.FontFace(ttf, 0.5)
+	assert.Equal(t, fontFace.Metrics().Height.Round(), 25)
+
+	gridder, err = New(ImageConfig{Width: 100, Height: 100, DPI: 144}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	fontFace = gridder.FontFace(ttf, 0.5)
+	assert.Equal(t, fontFace.Metrics().Height.Round(), 25)
+}
+
+func TestLoadFontFace(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	_, err = gridder.LoadFontFace("missing.ttf", 0.5)
+	assert.NotNil(t, err)
+}
+
+func TestLoadFontFaceFS(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	fsys := fstest.MapFS{"fonts/regular.ttf": {Data: goregular.TTF}, "fonts/broken.ttf": {Data: []byte("ttf")}}
+	fontFace, err := gridder.LoadFontFaceFS(fsys, "fonts/regular.ttf", 0.5)
+	assert.Nil(t, err)
+	assert.Equal(t, fontFace.Metrics().Height.Round(), 25)
+
+	_, err = gridder.LoadFontFaceFS(fsys, "fonts/missing.ttf", 0.5)
+	assert.NotNil(t, err)
+
+	_, err = gridder.LoadFontFaceFS(fsys, "fonts/broken.ttf", 0.5)
+	assert.NotNil(t, err)
+}
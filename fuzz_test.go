@@ -0,0 +1,54 @@
+//go:build go1.18
+// +build go1.18
+
+package gridder
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func FuzzDrawShapes(f *testing.F) {
+	f.Add(3, 3, 1, 1, 10.0, 2.0, 45.0, 1.0)
+	f.Add(1, 1, 0, 0, -1.0, 1e9, 0.0, -3.0)
+	f.Fuzz(func(t *testing.T, rows int, columns int, row int, column int, size float64, stroke float64, rotate float64, dashes float64) {
+		if rows > 64 || columns > 64 {
+			return
+		}
+
+		gridder, err := New(ImageConfig{Width: 64, Height: 64}, GridConfig{Rows: rows, Columns: columns})
+		if err != nil {
+			return
+		}
+
+		gridder.DrawCircle(row, column, CircleConfig{Radius: size, StrokeWidth: stroke, Dashes: dashes, Stroke: true})
+		gridder.DrawRectangle(row, column, RectangleConfig{Width: size, Height: size, Rotate: rotate, StrokeWidth: stroke})
+		gridder.DrawLine(row, column, LineConfig{Length: size, Rotate: rotate, StrokeWidth: stroke, Dashes: dashes})
+		gridder.DrawPath(0, 0, row, column, PathConfig{StrokeWidth: stroke, Dashes: dashes})
+		gridder.EncodePNG(new(bytes.Buffer))
+	})
+}
+
+func FuzzParseColor(f *testing.F) {
+	f.Add("red")
+	f.Add("#abc")
+	f.Add("#11223344")
+	f.Fuzz(func(t *testing.T, value string) {
+		ParseColor(value)
+	})
+}
+
+func FuzzParseDiagram(f *testing.F) {
+	f.Add("A1: rect red 'Label'; A1 -> B3 arrow blue")
+	f.Fuzz(func(t *testing.T, source string) {
+		ParseDiagram(source)
+	})
+}
+
+func FuzzParseDOT(f *testing.F) {
+	f.Add("digraph { node [shape=box]; a -> b [color=red]; }")
+	f.Fuzz(func(t *testing.T, source string) {
+		ParseDOT(strings.NewReader(source))
+	})
+}
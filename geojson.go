@@ -0,0 +1,341 @@
+package gridder
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+)
+
+// geoEpsilon absorbs floating point error when a bounding box is a whole number of cells
+const geoEpsilon = 1e-9
+
+// GeoGrid bins geographic coordinates into square cells of Resolution degrees. Row 0 is the northernmost row and
+// column 0 the westernmost column
+type GeoGrid struct {
+	West       float64
+	South      float64
+	East       float64
+	North      float64
+	Resolution float64
+}
+
+// Dimensions gets the number of rows and columns covering the bounding box, the last row and column may extend
+// past it
+func (b GeoGrid) Dimensions() (rows int, columns int) {
+	rows = int(math.Ceil((b.North-b.South)/b.Resolution - geoEpsilon))
+	columns = int(math.Ceil((b.East-b.West)/b.Resolution - geoEpsilon))
+	return maxInt(rows, 1), maxInt(columns, 1)
+}
+
+// CellAt gets the cell containing a longitude and latitude, reporting false outside the bounding box
+func (b GeoGrid) CellAt(longitude float64, latitude float64) (Cell, bool) {
+	if longitude < b.West || longitude > b.East || latitude < b.South || latitude > b.North {
+		return Cell{}, false
+	}
+
+	rows, columns := b.Dimensions()
+	row := minInt(int((b.North-latitude)/b.Resolution), rows-1)
+	column := minInt(int((longitude-b.West)/b.Resolution), columns-1)
+	return Cell{Row: row, Column: column}, true
+}
+
+func (b GeoGrid) cellCenter(row int, column int) (longitude float64, latitude float64) {
+	return b.West + (float64(column)+0.5)*b.Resolution, b.North - (float64(row)+0.5)*b.Resolution
+}
+
+func (b GeoGrid) validate() error {
+	err := validateValues(
+		finite("west", b.West),
+		finite("south", b.South),
+		finite("east", b.East),
+		finite("north", b.North),
+		nonNegative("resolution", b.Resolution),
+	)
+	if err != nil {
+		return err
+	}
+
+	if b.Resolution == 0 || b.East <= b.West || b.North <= b.South {
+		return fmt.Errorf("%w: bounding box %v,%v,%v,%v with resolution %v", errInvalidValue, b.West, b.South, b.East, b.North, b.Resolution)
+	}
+	return nil
+}
+
+// geoJSONObject holds the members of every GeoJSON object type, unused members stay empty
+type geoJSONObject struct {
+	Type        string                 `json:"type"`
+	Features    []geoJSONObject        `json:"features"`
+	Geometry    *geoJSONObject         `json:"geometry"`
+	Geometries  []geoJSONObject        `json:"geometries"`
+	Properties  map[string]interface{} `json:"properties"`
+	Coordinates json.RawMessage        `json:"coordinates"`
+}
+
+// geoFeature is a feature flattened to its points and polygons, polygons are lists of rings
+type geoFeature struct {
+	properties map[string]interface{}
+	points     [][2]float64
+	polygons   [][][][2]float64
+}
+
+// DrawGeoJSON bins the points and polygons of a GeoJSON document into the cells of a geographic grid and paints
+// them. Points count in the cell containing them and polygons in every cell whose center they contain. Cells are
+// painted by count, or with the color of their most common category when a category property is configured.
+// The gridder must have the dimensions of the geographic grid, line strings are ignored
+func (g *Gridder) DrawGeoJSON(r io.Reader, geoGrid GeoGrid, geoJSONConfigs ...GeoJSONConfig) error {
+	err := geoGrid.validate()
+	if err != nil {
+		return err
+	}
+
+	rows, columns := geoGrid.Dimensions()
+	if rows != g.gridConfig.GetRows() || columns != g.gridConfig.GetColumns() {
+		return errMatrixDimensions
+	}
+
+	var document geoJSONObject
+	err = json.NewDecoder(r).Decode(&document)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidGeoJSON, err)
+	}
+
+	var features []geoFeature
+	err = collectGeoFeatures(document, &features)
+	if err != nil {
+		return err
+	}
+
+	geoJSONConfig := getFirstGeoJSONConfig(geoJSONConfigs...)
+	fills := binGeoFeatures(features, geoGrid, geoJSONConfig)
+	return g.retain(func() error {
+		for row := 0; row < rows; row++ {
+			for column := 0; column < columns; column++ {
+				fill, ok := fills[Cell{Row: row, Column: column}]
+				if !ok {
+					continue
+				}
+
+				err := g.paintCell(row, column, fill)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// binGeoFeatures counts features per cell and picks the color of every cell covered by at least one feature
+func binGeoFeatures(features []geoFeature, geoGrid GeoGrid, geoJSONConfig GeoJSONConfig) map[Cell]color.Color {
+	counts := make(map[Cell]int)
+	categories := make(map[Cell]map[string]int)
+	firstSeen := make(map[string]int)
+	add := func(cell Cell, feature geoFeature) {
+		if geoJSONConfig.Property == "" {
+			counts[cell]++
+			return
+		}
+
+		value, ok := feature.properties[geoJSONConfig.Property]
+		if !ok || value == nil {
+			return
+		}
+		category := fmt.Sprint(value)
+		if _, ok := firstSeen[category]; !ok {
+			firstSeen[category] = len(firstSeen)
+		}
+		if categories[cell] == nil {
+			categories[cell] = make(map[string]int)
+		}
+		categories[cell][category]++
+	}
+
+	for _, feature := range features {
+		for _, point := range feature.points {
+			cell, ok := geoGrid.CellAt(point[0], point[1])
+			if ok {
+				add(cell, feature)
+			}
+		}
+		for _, polygon := range feature.polygons {
+			for _, cell := range geoGrid.polygonCells(polygon) {
+				add(cell, feature)
+			}
+		}
+	}
+
+	fills := make(map[Cell]color.Color)
+	if geoJSONConfig.Property == "" {
+		var maxCount int
+		for _, count := range counts {
+			maxCount = maxInt(maxCount, count)
+		}
+		for cell, count := range counts {
+			fills[cell] = geoJSONConfig.GetColor(float64(count) / float64(maxCount))
+		}
+		return fills
+	}
+
+	for cell, tally := range categories {
+		best := ""
+		for category, count := range tally {
+			if best == "" || count > tally[best] || count == tally[best] && firstSeen[category] < firstSeen[best] {
+				best = category
+			}
+		}
+
+		fill := geoJSONConfig.GetCategoryColor(best)
+		if fill != nil {
+			fills[cell] = fill
+		}
+	}
+	return fills
+}
+
+// polygonCells gets the cells whose center lies inside a polygon, holes are excluded by the even-odd rule
+func (b GeoGrid) polygonCells(polygon [][][2]float64) []Cell {
+	if len(polygon) == 0 || len(polygon[0]) == 0 {
+		return nil
+	}
+
+	west, south, east, north := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, point := range polygon[0] {
+		west, east = math.Min(west, point[0]), math.Max(east, point[0])
+		south, north = math.Min(south, point[1]), math.Max(north, point[1])
+	}
+
+	rows, columns := b.Dimensions()
+	firstRow := maxInt(int(math.Floor((b.North-north)/b.Resolution)), 0)
+	lastRow := minInt(int(math.Floor((b.North-south)/b.Resolution)), rows-1)
+	firstColumn := maxInt(int(math.Floor((west-b.West)/b.Resolution)), 0)
+	lastColumn := minInt(int(math.Floor((east-b.West)/b.Resolution)), columns-1)
+
+	var cells []Cell
+	for row := firstRow; row <= lastRow; row++ {
+		for column := firstColumn; column <= lastColumn; column++ {
+			longitude, latitude := b.cellCenter(row, column)
+			if polygonContains(polygon, longitude, latitude) {
+				cells = append(cells, Cell{Row: row, Column: column})
+			}
+		}
+	}
+	return cells
+}
+
+func polygonContains(polygon [][][2]float64, x float64, y float64) bool {
+	inside := false
+	for _, ring := range polygon {
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			a, b := ring[i], ring[j]
+			if (a[1] > y) != (b[1] > y) && x < (b[0]-a[0])*(y-a[1])/(b[1]-a[1])+a[0] {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// collectGeoFeatures flattens feature collections, features and bare geometries into features
+func collectGeoFeatures(object geoJSONObject, features *[]geoFeature) error {
+	switch object.Type {
+	case "FeatureCollection":
+		for _, feature := range object.Features {
+			if feature.Type != "Feature" {
+				return fmt.Errorf("%w: %q in feature collection", errInvalidGeoJSON, feature.Type)
+			}
+			err := collectGeoFeatures(feature, features)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case "Feature":
+		feature := geoFeature{properties: object.Properties}
+		if object.Geometry != nil {
+			err := feature.addGeometry(*object.Geometry)
+			if err != nil {
+				return err
+			}
+		}
+		*features = append(*features, feature)
+		return nil
+	}
+
+	var feature geoFeature
+	err := feature.addGeometry(object)
+	if err != nil {
+		return err
+	}
+	*features = append(*features, feature)
+	return nil
+}
+
+func (f *geoFeature) addGeometry(geometry geoJSONObject) error {
+	var err error
+	switch geometry.Type {
+	case "Point":
+		var point [2]float64
+		err = decodeGeoCoordinates(geometry.Coordinates, &point)
+		f.points = append(f.points, point)
+	case "MultiPoint":
+		var points [][2]float64
+		err = decodeGeoCoordinates(geometry.Coordinates, &points)
+		f.points = append(f.points, points...)
+	case "Polygon":
+		var polygon [][][2]float64
+		err = decodeGeoCoordinates(geometry.Coordinates, &polygon)
+		f.polygons = append(f.polygons, polygon)
+	case "MultiPolygon":
+		var polygons [][][][2]float64
+		err = decodeGeoCoordinates(geometry.Coordinates, &polygons)
+		f.polygons = append(f.polygons, polygons...)
+	case "GeometryCollection":
+		for _, child := range geometry.Geometries {
+			err = f.addGeometry(child)
+			if err != nil {
+				return err
+			}
+		}
+	case "LineString", "MultiLineString":
+	default:
+		err = fmt.Errorf("unknown type %q", geometry.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidGeoJSON, err)
+	}
+	return nil
+}
+
+// decodeGeoCoordinates decodes coordinates, positions may carry an altitude which is dropped
+func decodeGeoCoordinates(data json.RawMessage, coordinates interface{}) error {
+	var positions interface{}
+	err := json.Unmarshal(data, &positions)
+	if err != nil {
+		return err
+	}
+
+	trimmed, err := json.Marshal(trimGeoPositions(positions))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(trimmed, coordinates)
+}
+
+func trimGeoPositions(value interface{}) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+
+	if len(list) > 2 {
+		if _, ok := list[0].(float64); ok {
+			return list[:2]
+		}
+	}
+	for i := range list {
+		list[i] = trimGeoPositions(list[i])
+	}
+	return list
+}
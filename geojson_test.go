@@ -0,0 +1,107 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testGeoJSON = `{"type":"FeatureCollection","features":[
+	{"type":"Feature","properties":{"kind":"a"},"geometry":{"type":"MultiPoint","coordinates":[[0.5,1.5],[0.4,1.6,100]]}},
+	{"type":"Feature","properties":{"kind":"b"},"geometry":{"type":"Point","coordinates":[4,0]}},
+	{"type":"Feature","properties":{"kind":"b"},"geometry":{"type":"GeometryCollection","geometries":[
+		{"type":"Polygon","coordinates":[[[1.2,0.2],[2.8,0.2],[2.8,1.8],[1.2,1.8],[1.2,0.2]],[[2.3,0.3],[2.7,0.3],[2.7,0.7],[2.3,0.7],[2.3,0.3]]]},
+		{"type":"LineString","coordinates":[[0,0],[4,2]]}]}},
+	{"type":"Feature","properties":{"kind":"c"},"geometry":{"type":"Point","coordinates":[10,10]}},
+	{"type":"Feature","properties":{},"geometry":null}]}`
+
+func TestGeoGrid(t *testing.T) {
+	geoGrid := GeoGrid{West: -1, South: -1, East: 1, North: 0.5, Resolution: 0.5}
+	rows, columns := geoGrid.Dimensions()
+	assert.Equal(t, rows, 3)
+	assert.Equal(t, columns, 4)
+
+	geoGrid = GeoGrid{West: 0, South: 0, East: 1, North: 1, Resolution: 0.3}
+	rows, columns = geoGrid.Dimensions()
+	assert.Equal(t, rows, 4)
+	assert.Equal(t, columns, 4)
+
+	cell, ok := geoGrid.CellAt(0.1, 0.95)
+	assert.True(t, ok)
+	assert.Equal(t, cell, Cell{Row: 0, Column: 0})
+
+	cell, ok = geoGrid.CellAt(1, 0)
+	assert.True(t, ok)
+	assert.Equal(t, cell, Cell{Row: 3, Column: 3})
+
+	_, ok = geoGrid.CellAt(1.1, 0)
+	assert.False(t, ok)
+}
+
+func TestDrawGeoJSONCounts(t *testing.T) {
+	geoGrid := GeoGrid{West: 0, South: 0, East: 4, North: 2, Resolution: 1}
+	gridder, err := New(ImageConfig{Width: 400, Height: 200}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+
+	err = gridder.DrawGeoJSON(strings.NewReader(testGeoJSON), geoGrid)
+	assert.Nil(t, err)
+
+	img := gridder.ctx.Image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 50)), defaultGeoJSONColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 50)), color.NRGBAModel.Convert(img.At(350, 150)))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 150)), color.NRGBAModel.Convert(img.At(250, 50)))
+	assert.NotEqual(t, color.NRGBAModel.Convert(img.At(150, 50)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(250, 150)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 150)), color.NRGBAModel.Convert(color.White))
+}
+
+func TestDrawGeoJSONCategories(t *testing.T) {
+	geoGrid := GeoGrid{West: 0, South: 0, East: 4, North: 2, Resolution: 1}
+	gridder, err := New(ImageConfig{Width: 400, Height: 200}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+
+	geoJSONConfig := GeoJSONConfig{Property: "kind", Categories: map[string]color.Color{"a": color.Black}}
+	err = gridder.DrawGeoJSON(strings.NewReader(testGeoJSON), geoGrid, geoJSONConfig)
+	assert.Nil(t, err)
+
+	img := gridder.ctx.Image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 50)), color.NRGBAModel.Convert(color.Black))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 50)), color.NRGBAModel.Convert(color.White))
+
+	geoJSONConfig.Categories["b"] = color.NRGBA{B: 255, A: 255}
+	err = gridder.DrawGeoJSON(strings.NewReader(`{"type":"Polygon","coordinates":[[[0,0],[4,0],[4,2],[0,2],[0,0]]]}`), geoGrid, geoJSONConfig)
+	assert.Nil(t, err)
+	err = gridder.DrawGeoJSON(strings.NewReader(testGeoJSON), geoGrid, geoJSONConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 50)), color.NRGBA{B: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(350, 150)), color.NRGBA{B: 255, A: 255})
+}
+
+func TestDrawGeoJSONErrors(t *testing.T) {
+	geoGrid := GeoGrid{West: 0, South: 0, East: 4, North: 2, Resolution: 1}
+	gridder, err := New(ImageConfig{Width: 400, Height: 200}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+
+	for _, source := range []string{
+		`{`,
+		`{"type":"Circle"}`,
+		`{"type":"FeatureCollection","features":[{"type":"Point","coordinates":[0,0]}]}`,
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":"x"}}`,
+		`{"type":"GeometryCollection","geometries":[{"type":"MultiPolygon","coordinates":[0]}]}`,
+	} {
+		err = gridder.DrawGeoJSON(strings.NewReader(source), geoGrid)
+		assert.True(t, errors.Is(err, errInvalidGeoJSON), source)
+	}
+
+	err = gridder.DrawGeoJSON(strings.NewReader(testGeoJSON), GeoGrid{West: 0, South: 0, East: 4, North: 4, Resolution: 1})
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+
+	err = gridder.DrawGeoJSON(strings.NewReader(testGeoJSON), GeoGrid{West: 4, South: 0, East: 0, North: 2, Resolution: 1})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawGeoJSON(strings.NewReader(testGeoJSON), GeoGrid{East: 4, North: 2})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
@@ -0,0 +1,69 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"io"
+	"sort"
+)
+
+// EncodeGIF renders the grid and writes it to w as a GIF. The palette holds the most frequent colors of the grid, so
+// grids of flat colors keep them exactly, and other colors are mapped to the nearest palette color or dithered
+func (g *Gridder) EncodeGIF(w io.Writer, gifConfigs ...GIFConfig) error {
+	gifConfig := getFirstGIFConfig(gifConfigs...)
+	return encodeGIF(w, g.image(), gifConfig)
+}
+
+func encodeDefaultGIF(w io.Writer, img image.Image) error {
+	return encodeGIF(w, img, GIFConfig{})
+}
+
+func encodeGIF(w io.Writer, img image.Image, gifConfig GIFConfig) error {
+	var drawer draw.Drawer = draw.Src
+	if gifConfig.IsDither() {
+		drawer = draw.FloydSteinberg
+	}
+	return gif.Encode(w, img, &gif.Options{
+		NumColors: gifConfig.GetColors(),
+		Quantizer: popularityQuantizer{},
+		Drawer:    drawer,
+	})
+}
+
+// popularityQuantizer builds palettes of the most frequent colors of an image, ties broken by color value so that
+// palettes are deterministic
+type popularityQuantizer struct{}
+
+// Quantize appends the most frequent colors of an image to a palette up to its capacity
+func (popularityQuantizer) Quantize(p color.Palette, m image.Image) color.Palette {
+	counts := make(map[color.RGBA]int)
+	bounds := m.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			counts[color.RGBAModel.Convert(m.At(x, y)).(color.RGBA)]++
+		}
+	}
+
+	colors := make([]color.RGBA, 0, len(counts))
+	for c := range counts {
+		colors = append(colors, c)
+	}
+	sort.Slice(colors, func(i int, j int) bool {
+		ci, cj := colors[i], colors[j]
+		if counts[ci] != counts[cj] {
+			return counts[ci] > counts[cj]
+		}
+		return uint32(ci.R)<<24|uint32(ci.G)<<16|uint32(ci.B)<<8|uint32(ci.A) <
+			uint32(cj.R)<<24|uint32(cj.G)<<16|uint32(cj.B)<<8|uint32(cj.A)
+	})
+
+	for _, c := range colors {
+		if len(p) == cap(p) {
+			break
+		}
+		p = append(p, c)
+	}
+	return p
+}
@@ -0,0 +1,47 @@
+package gridder
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeGIF(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.RGBA{R: 200, G: 30, B: 90, A: 255}))
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(t, gridder.EncodeGIF(buffer))
+	img, err := gif.Decode(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, img.Bounds(), image.Rect(0, 0, 100, 100))
+	assert.Equal(t, color.RGBAModel.Convert(img.At(25, 25)), color.RGBA{R: 200, G: 30, B: 90, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(img.At(75, 75)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+	buffer.Reset()
+	assert.Nil(t, gridder.EncodeGIF(buffer, GIFConfig{Colors: 2, Dither: true}))
+	config, err := gif.DecodeConfig(buffer)
+	assert.Nil(t, err)
+	assert.Len(t, config.ColorModel.(color.Palette), 2)
+
+	buffer.Reset()
+	assert.Nil(t, gridder.Encode(buffer, "gif"))
+	_, err = gif.Decode(buffer)
+	assert.Nil(t, err)
+}
+
+func TestPopularityQuantizer(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 1))
+	img.Set(0, 0, color.RGBA{B: 255, A: 255})
+	img.Set(1, 0, color.RGBA{R: 255, A: 255})
+	img.Set(2, 0, color.RGBA{R: 255, A: 255})
+	img.Set(3, 0, color.RGBA{G: 255, A: 255})
+
+	palette := popularityQuantizer{}.Quantize(make(color.Palette, 0, 2), img)
+	assert.Equal(t, palette, color.Palette{color.RGBA{R: 255, A: 255}, color.RGBA{B: 255, A: 255}})
+}
@@ -1,6 +1,6 @@
 module github.com/rageofgods/gridder
 
-go 1.14
+go 1.25.0
 
 require (
 	github.com/fogleman/gg v1.3.0
@@ -8,4 +8,12 @@ require (
 	github.com/shomali11/gridder v0.0.0-20210930173142-5f3b82d74585
 	github.com/stretchr/testify v1.8.0
 	golang.org/x/image v0.0.0-20200119044424-58c23975cae1
+	golang.org/x/text v0.41.0
+	google.golang.org/protobuf v1.36.11
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
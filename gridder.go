@@ -1,18 +1,43 @@
 package gridder
 
 import (
+	"bytes"
 	"errors"
+	"image"
 	"image/color"
 	"io"
+	"os"
+	"sync/atomic"
 
 	"github.com/fogleman/gg"
 	"golang.org/x/image/font"
+	"golang.org/x/text/language"
 )
 
 var (
 	errNoRows      = errors.New("no rows provided")
 	errNoColumns   = errors.New("no columns provided")
 	errOutOfBounds = errors.New("out of bounds")
+
+	errMatrixDimensions = errors.New("matrix does not match grid dimensions")
+	errNoFontFace       = errors.New("no font face provided")
+	errInvalidColor     = errors.New("invalid color")
+	errInvalidValue     = errors.New("invalid value")
+	errCellTooSmall     = errors.New("cell too small")
+
+	errInvalidSpreadsheet = errors.New("invalid spreadsheet")
+	errSheetNotFound      = errors.New("sheet not found")
+	errInvalidGraph       = errors.New("invalid graph")
+	errInvalidDiagram     = errors.New("invalid diagram")
+	errUnknownFormat      = errors.New("unknown image format")
+	errUnknownEntity      = errors.New("unknown entity")
+	errInvalidReplay      = errors.New("invalid replay")
+	errLimitExceeded      = errors.New("render limit exceeded")
+	errNoSpriteSheet      = errors.New("no sprite sheet loaded")
+	errInvalidTiledMap    = errors.New("invalid tiled map")
+	errInvalidGeoJSON     = errors.New("invalid geojson")
+	errMaskedCell         = errors.New("cell is masked")
+	errNoPages            = errors.New("no pages provided")
 )
 
 // New creates a new gridder and sets it up with its configuration
@@ -27,10 +52,24 @@ func New(imageConfig ImageConfig, gridConfig GridConfig) (*Gridder, error) {
 		return nil, errNoColumns
 	}
 
+	err := imageConfig.Limits.validate(imageConfig.GetWidth(), imageConfig.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	err = verifyCells(gridConfig.GetRows(), columns, imageConfig.Limits.GetMaxCells())
+	if err != nil {
+		return nil, err
+	}
+
+	err = gridConfig.validate(imageConfig.GetWidth(), imageConfig.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+
 	gridder := Gridder{
 		imageConfig: imageConfig,
-		gridConfig:  gridConfig,
-		ctx:         gg.NewContext(imageConfig.GetWidth(), imageConfig.GetHeight()),
+		gridConfig:  cloneGridConfig(gridConfig),
+		ctx:         newContext(imageConfig, gridConfig),
 	}
 	gridder.paintBackground()
 	return &gridder, nil
@@ -38,104 +77,285 @@ func New(imageConfig ImageConfig, gridConfig GridConfig) (*Gridder, error) {
 
 // Gridder gridder structure
 type Gridder struct {
-	imageConfig ImageConfig
-	gridConfig  GridConfig
-	ctx         *gg.Context
+	imageConfig    ImageConfig
+	gridConfig     GridConfig
+	ctx            *gg.Context
+	flipHorizontal bool
+	flipVertical   bool
+	states         map[Cell]string
+	styleMap       StyleMap
+	overlay        *Gridder
+	parent         *Gridder
+	cellStyles     map[Cell]CellStyle
+	locale         language.Tag
+	labels         map[Cell][]string
+	links          map[Cell]string
+	tooltips       map[Cell]string
+	metadata       map[string]string
+	layout         *layout
+	operations     operationStore
+	retaining      bool
+	operationKey   string
+	entities       map[string]*entityPosition
+	entityOrder    []string
+	replay         *Replay
+	spriteSheet    *spriteSheet
+	filters        []Filter
+	mask           image.Image
+
+	coordinateTransform CoordinateTransform
+	encodedSize         int64
+	labelDeclutter      LabelDeclutterConfig
+	labelBoxes          []labelBox
+	pathRouting         *PathRoutingConfig
+	routedPaths         []routedPath
+
+	skipBoundsCheck bool
+}
+
+// SkipBoundsCheck disables the per call cell bounds check for trusted bulk loops. Cells outside the grid
+// are then drawn outside of it instead of returning an error
+func (g *Gridder) SkipBoundsCheck(skip bool) {
+	g.skipBoundsCheck = skip
 }
 
-// SavePNG saves to PNG
+// SavePNG saves to PNG at the path of the image name, use EncodePNG where no file system is writable
+//
+// Deprecated: use Save, which takes the path and picks the format from its extension
 func (g *Gridder) SavePNG() error {
-	g.paintGrid()
-	g.paintBorder()
-	return g.ctx.SavePNG(g.imageConfig.GetName())
+	file, err := os.Create(g.imageConfig.GetName())
+	if err != nil {
+		return err
+	}
+
+	err = g.EncodePNG(file)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
 }
 
 // EncodePNG encodes the image as a PNG and writes it to the provided io.Writer.
 func (g *Gridder) EncodePNG(w io.Writer) error {
-	g.paintGrid()
-	g.paintBorder()
-	return g.ctx.EncodePNG(w)
+	metadataWriter := g.metadataWriter(w)
+	err := encodePNG(metadataWriter, g.image())
+	if err != nil {
+		return err
+	}
+	return metadataWriter.Close()
+}
+
+// EncodePNGBuffer encodes the image as a PNG into a buffer, replacing its content. Reusing a buffer across encodes,
+// such as one per worker of a service, avoids growing a new one every time: the buffer is grown to the size of the
+// previous encode up front, and the encoder state is pooled
+func (g *Gridder) EncodePNGBuffer(buffer *bytes.Buffer) error {
+	buffer.Reset()
+	buffer.Grow(int(atomic.LoadInt64(&g.encodedSize)))
+	err := g.EncodePNG(buffer)
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt64(&g.encodedSize, int64(buffer.Len()))
+	return nil
+}
+
+// Image renders the grid, as EncodePNG encodes it, into an image for other imaging pipelines. The image is a copy:
+// drawing on the grid afterwards does not change it, and rendering again gets the same image
+func (g *Gridder) Image() image.Image {
+	return g.image()
+}
+
+// Context gets the drawing context of this gridder for custom drawing with gg, with coordinates inside the margin.
+// The context stays the same when the grid is re-laid out, with its transform reset to the new margin. Drawing on it
+// directly is not retained, so it is lost when the grid is re-rendered
+func (g *Gridder) Context() *gg.Context {
+	return g.ctx
 }
 
 // PaintCell paints Cell
 func (g *Gridder) PaintCell(row int, column int, color color.Color) error {
+	err := g.retainPaint(newPaintOperation(Cell{Row: row, Column: column}, color))
+	return g.recordReplay(err, func() replayCommand { return paintCommand(row, column, color) })
+}
+
+func (g *Gridder) paintCell(row int, column int, color color.Color) error {
 	err := g.verifyInBounds(row, column)
 	if err != nil {
 		return err
 	}
 
+	if color == nil {
+		color = g.getCellStyle(row, column).Fill
+	}
+
 	cellWidth, cellHeight := g.getCellDimensions(row, column)
-	paintWidth := cellWidth - g.gridConfig.GetLineStrokeWidth()
-	paintHeight := cellHeight - g.gridConfig.GetLineStrokeWidth()
-	return g.DrawRectangle(row, column, RectangleConfig{Width: paintWidth, Height: paintHeight, Color: color})
+	lineStrokeWidth := g.getLineStrokeWidth()
+	paintWidth := cellWidth - lineStrokeWidth
+	paintHeight := cellHeight - lineStrokeWidth
+	paintRectangle(g.ctx, g.imageConfig.GetRough(), g.getCellCenter(row, column), RectangleConfig{Width: paintWidth, Height: paintHeight, Color: color})
+	return nil
 }
 
-// DrawRectangle draws a rectangle in a cell
+// DrawRectangle draws a rectangle in a cell, or centered on the cells of its span
 func (g *Gridder) DrawRectangle(row int, column int, rectangleConfigs ...RectangleConfig) error {
+	rectangleConfig := getFirstRectangleConfig(rectangleConfigs...)
+	err := g.retainDescribed("DrawRectangle", []interface{}{row, column, rectangleConfigs}, func() error {
+		return g.retainSpan(row, column, rectangleConfig.GetSpan(), func(row int, column int, area cellArea) error {
+			return g.drawRectangleAt(row, column, area, rectangleConfigs...)
+		})
+	})
+	return g.recordReplay(err, func() replayCommand {
+		return shapeCommand(rectangleShape(rectangleConfig), Cell{Row: row, Column: column})
+	})
+}
+
+func (g *Gridder) drawRectangle(row int, column int, rectangleConfigs ...RectangleConfig) error {
+	return g.drawRectangleAt(row, column, g.getCellArea(row, column), rectangleConfigs...)
+}
+
+// drawRectangleAt draws a rectangle of a cell centered on an area
+func (g *Gridder) drawRectangleAt(row int, column int, area cellArea, rectangleConfigs ...RectangleConfig) error {
 	err := g.verifyInBounds(row, column)
 	if err != nil {
 		return err
 	}
 
-	center := g.getCellCenter(row, column)
-	rectangleConfig := getFirstRectangleConfig(rectangleConfigs...)
+	rectangleConfig := getFirstRectangleConfig(rectangleConfigs...).withStyle(g.getCellStyle(row, column))
+	err = rectangleConfig.validate(g.maxStrokeWidth())
+	if err != nil {
+		return err
+	}
+
+	if rectangleConfig.GetSpan().IsMerged() {
+		// merged cells are filled up to the grid lines around them, as painted cells
+		if rectangleConfig.Width <= 0 {
+			rectangleConfig.Width = area.width - g.getLineStrokeWidth()
+		}
+		if rectangleConfig.Height <= 0 {
+			rectangleConfig.Height = area.height - g.getLineStrokeWidth()
+		}
+	}
+
+	if rectangleConfig.IsStroke() {
+		strokeWidth, ok, err := g.fitStroke(row, column, area, rectangleConfig.GetStrokeWidth())
+		if err != nil || !ok {
+			return err
+		}
+		rectangleConfig.StrokeWidth = strokeWidth
+	}
+
+	paintRectangle(g.ctx, g.imageConfig.GetRough(), area.center, rectangleConfig)
+	return nil
+}
+
+func paintRectangle(ctx *gg.Context, rough *RoughStyle, center *gg.Point, rectangleConfig RectangleConfig) {
 	rectangleWidth := rectangleConfig.GetWidth()
 	rectangleHeight := rectangleConfig.GetHeight()
 
 	x := center.X - rectangleWidth/2
 	y := center.Y - rectangleHeight/2
 
-	g.ctx.Push()
+	// unrotated fills, the bulk of painted cells, skip the path rasterizer
+	if !rectangleConfig.IsStroke() && rectangleConfig.GetRotate() == 0 && fillRectangle(ctx, x, y, rectangleWidth, rectangleHeight, rectangleConfig.GetColor()) {
+		return
+	}
+
+	ctx.Push()
 	dashes := rectangleConfig.GetDashes()
 	if dashes > 0 {
-		g.ctx.SetDash(dashes)
+		ctx.SetDash(dashes)
 	} else {
-		g.ctx.SetDash()
+		ctx.SetDash()
 	}
-	g.ctx.RotateAbout(gg.Radians(rectangleConfig.GetRotate()), center.X, center.Y)
-	g.ctx.DrawRectangle(x, y, rectangleWidth, rectangleHeight)
-	g.ctx.SetLineWidth(rectangleConfig.GetStrokeWidth())
-	g.ctx.SetColor(rectangleConfig.GetColor())
+	ctx.RotateAbout(gg.Radians(rectangleConfig.GetRotate()), center.X, center.Y)
+	ctx.SetColor(rectangleConfig.GetColor())
 	if rectangleConfig.IsStroke() {
-		g.ctx.Stroke()
+		strokePath(ctx, rough, rectangleConfig.GetStrokeWidth(), func() {
+			ctx.DrawRectangle(x, y, rectangleWidth, rectangleHeight)
+		})
 	} else {
-		g.ctx.Fill()
+		ctx.DrawRectangle(x, y, rectangleWidth, rectangleHeight)
+		ctx.Fill()
 	}
-	g.ctx.Pop()
-	return nil
+	ctx.Pop()
 }
 
 // DrawCircle draws a circle in a cell
 func (g *Gridder) DrawCircle(row int, column int, circleConfigs ...CircleConfig) error {
+	err := g.retainDescribed("DrawCircle", []interface{}{row, column, circleConfigs}, func() error {
+		return g.retainAnchored([]Cell{{Row: row, Column: column}}, func(cells []Cell, areas []cellArea) error {
+			return g.drawCircleAt(cells[0].Row, cells[0].Column, areas[0], circleConfigs...)
+		})
+	})
+	return g.recordReplay(err, func() replayCommand {
+		return shapeCommand(circleShape(getFirstCircleConfig(circleConfigs...)), Cell{Row: row, Column: column})
+	})
+}
+
+func (g *Gridder) drawCircle(row int, column int, circleConfigs ...CircleConfig) error {
+	return g.drawCircleAt(row, column, g.getCellArea(row, column), circleConfigs...)
+}
+
+// drawCircleAt draws a circle of a cell centered on an area
+func (g *Gridder) drawCircleAt(row int, column int, area cellArea, circleConfigs ...CircleConfig) error {
 	err := g.verifyInBounds(row, column)
 	if err != nil {
 		return err
 	}
 
-	center := g.getCellCenter(row, column)
-	circleConfig := getFirstCircleConfig(circleConfigs...)
+	circleConfig := getFirstCircleConfig(circleConfigs...).withStyle(g.getCellStyle(row, column))
+	err = circleConfig.validate(g.maxStrokeWidth())
+	if err != nil {
+		return err
+	}
 
-	g.ctx.Push()
+	if circleConfig.IsStroke() {
+		strokeWidth, ok, err := g.fitStroke(row, column, area, circleConfig.GetStrokeWidth())
+		if err != nil || !ok {
+			return err
+		}
+		circleConfig.StrokeWidth = strokeWidth
+	}
+
+	paintCircle(g.ctx, g.imageConfig.GetRough(), area.center, circleConfig)
+	return nil
+}
+
+func paintCircle(ctx *gg.Context, rough *RoughStyle, center *gg.Point, circleConfig CircleConfig) {
+	ctx.Push()
 	dashes := circleConfig.GetDashes()
 	if dashes > 0 {
-		g.ctx.SetDash(dashes)
+		ctx.SetDash(dashes)
 	} else {
-		g.ctx.SetDash()
+		ctx.SetDash()
 	}
-	g.ctx.DrawPoint(center.X, center.Y, circleConfig.GetRadius())
-	g.ctx.SetLineWidth(circleConfig.GetStrokeWidth())
-	g.ctx.SetColor(circleConfig.GetColor())
+	ctx.SetColor(circleConfig.GetColor())
 	if circleConfig.IsStroke() {
-		g.ctx.Stroke()
+		strokePath(ctx, rough, circleConfig.GetStrokeWidth(), func() {
+			ctx.DrawPoint(center.X, center.Y, circleConfig.GetRadius())
+		})
 	} else {
-		g.ctx.Fill()
+		ctx.DrawPoint(center.X, center.Y, circleConfig.GetRadius())
+		ctx.Fill()
 	}
-	g.ctx.Pop()
-	return nil
+	ctx.Pop()
 }
 
 // DrawPath draws a path between two cells
 func (g *Gridder) DrawPath(row1 int, column1 int, row2 int, column2 int, pathConfigs ...PathConfig) error {
+	cells := []Cell{{Row: row1, Column: column1}, {Row: row2, Column: column2}}
+	err := g.retainDescribed("DrawPath", []interface{}{cells, pathConfigs}, func() error {
+		return g.retainAnchored(cells, func(cells []Cell, areas []cellArea) error {
+			return g.drawPathAt(cells[0].Row, cells[0].Column, areas[0], areas[1], pathConfigs...)
+		})
+	})
+	return g.recordReplay(err, func() replayCommand {
+		return shapeCommand(pathShape(getFirstPathConfig(pathConfigs...)), cells...)
+	})
+}
+
+func (g *Gridder) drawPath(row1 int, column1 int, row2 int, column2 int, pathConfigs ...PathConfig) error {
 	err := g.verifyInBounds(row1, column1)
 	if err != nil {
 		return err
@@ -146,9 +366,19 @@ func (g *Gridder) DrawPath(row1 int, column1 int, row2 int, column2 int, pathCon
 		return err
 	}
 
-	center1 := g.getCellCenter(row1, column1)
-	center2 := g.getCellCenter(row2, column2)
-	pathConfig := getFirstPathConfig(pathConfigs...)
+	return g.drawPathAt(row1, column1, g.getCellArea(row1, column1), g.getCellArea(row2, column2), pathConfigs...)
+}
+
+// drawPathAt draws a path between the centers or the ports of two areas, styled as the cell of the first one
+func (g *Gridder) drawPathAt(row int, column int, area1 cellArea, area2 cellArea, pathConfigs ...PathConfig) error {
+	pathConfig := getFirstPathConfig(pathConfigs...).withStyle(g.getCellStyle(row, column))
+	err := pathConfig.validate(g.maxStrokeWidth())
+	if err != nil {
+		return err
+	}
+
+	start := portPoint(pathConfig.GetStartPort(), area1)
+	end := portPoint(pathConfig.GetEndPort(), area2)
 
 	g.ctx.Push()
 	dashes := pathConfig.GetDashes()
@@ -158,91 +388,306 @@ func (g *Gridder) DrawPath(row1 int, column1 int, row2 int, column2 int, pathCon
 		g.ctx.SetDash()
 	}
 	g.ctx.SetColor(pathConfig.GetColor())
-	g.ctx.SetLineWidth(pathConfig.GetStrokeWidth())
-	g.ctx.DrawLine(center1.X, center1.Y, center2.X, center2.Y)
-	g.ctx.Stroke()
+	if g.pathRouting == nil {
+		g.stroke(pathConfig.GetStrokeWidth(), func() {
+			g.ctx.DrawLine(start.X, start.Y, end.X, end.Y)
+		})
+	} else {
+		segment, jumps := g.routePath(start, end)
+		g.stroke(pathConfig.GetStrokeWidth(), func() {
+			g.traceRoutedPath(segment, jumps)
+		})
+	}
 	g.ctx.Pop()
 	return nil
 }
 
 // DrawLine draws a line in a cell
 func (g *Gridder) DrawLine(row int, column int, lineConfigs ...LineConfig) error {
+	err := g.retainDescribed("DrawLine", []interface{}{row, column, lineConfigs}, func() error {
+		return g.retainAnchored([]Cell{{Row: row, Column: column}}, func(cells []Cell, areas []cellArea) error {
+			return g.drawLineAt(cells[0].Row, cells[0].Column, areas[0], lineConfigs...)
+		})
+	})
+	return g.recordReplay(err, func() replayCommand {
+		return shapeCommand(lineShape(getFirstLineConfig(lineConfigs...)), Cell{Row: row, Column: column})
+	})
+}
+
+func (g *Gridder) drawLine(row int, column int, lineConfigs ...LineConfig) error {
+	return g.drawLineAt(row, column, g.getCellArea(row, column), lineConfigs...)
+}
+
+// drawLineAt draws a line of a cell centered on an area
+func (g *Gridder) drawLineAt(row int, column int, area cellArea, lineConfigs ...LineConfig) error {
 	err := g.verifyInBounds(row, column)
 	if err != nil {
 		return err
 	}
 
-	center := g.getCellCenter(row, column)
-	lineConfig := getFirstLineConfig(lineConfigs...)
-	length := lineConfig.GetLength()
+	lineConfig := getFirstLineConfig(lineConfigs...).withStyle(g.getCellStyle(row, column))
+	err = lineConfig.validate(g.maxStrokeWidth())
+	if err != nil {
+		return err
+	}
 
+	strokeWidth, ok, err := g.fitStroke(row, column, area, lineConfig.GetStrokeWidth())
+	if err != nil || !ok {
+		return err
+	}
+	lineConfig.StrokeWidth = strokeWidth
+
+	paintLine(g.ctx, g.imageConfig.GetRough(), area.center, lineConfig)
+	return nil
+}
+
+func paintLine(ctx *gg.Context, rough *RoughStyle, center *gg.Point, lineConfig LineConfig) {
+	length := lineConfig.GetLength()
 	x1 := center.X - length/2
 	x2 := center.X + length/2
 	y := center.Y
 
-	g.ctx.Push()
+	ctx.Push()
 	dashes := lineConfig.GetDashes()
 	if dashes > 0 {
-		g.ctx.SetDash(dashes)
+		ctx.SetDash(dashes)
 	} else {
-		g.ctx.SetDash()
+		ctx.SetDash()
 	}
-	g.ctx.RotateAbout(gg.Radians(lineConfig.GetRotate()), center.X, center.Y)
-	g.ctx.DrawLine(x1, y, x2, y)
-	g.ctx.SetLineWidth(lineConfig.GetStrokeWidth())
-	g.ctx.SetColor(lineConfig.GetColor())
-	g.ctx.Stroke()
-	g.ctx.Pop()
-	return nil
+	ctx.RotateAbout(gg.Radians(lineConfig.GetRotate()), center.X, center.Y)
+	ctx.SetColor(lineConfig.GetColor())
+	strokePath(ctx, rough, lineConfig.GetStrokeWidth(), func() {
+		ctx.DrawLine(x1, y, x2, y)
+	})
+	ctx.Pop()
 }
 
-// DrawString draws a string in a cell
+// DrawString draws a string in a cell, or in the cells of its span. Strings are centered unless aligned, and broken
+// into lines at new lines and when wrapped
 func (g *Gridder) DrawString(row int, column int, text string, fontFace font.Face, stringConfigs ...StringConfig) error {
+	stringConfig := getFirstStringConfig(stringConfigs...)
+	return g.retainDescribed("DrawString", []interface{}{row, column, text, fontFace, stringConfigs}, func() error {
+		return g.retainSpan(row, column, stringConfig.GetSpan(), func(row int, column int, area cellArea) error {
+			return g.drawStringAt(row, column, area, text, fontFace, stringConfigs...)
+		})
+	})
+}
+
+func (g *Gridder) drawString(row int, column int, text string, fontFace font.Face, stringConfigs ...StringConfig) error {
+	return g.drawStringAt(row, column, g.getCellArea(row, column), text, fontFace, stringConfigs...)
+}
+
+// drawStringAt draws a string of a cell centered on an area
+func (g *Gridder) drawStringAt(row int, column int, area cellArea, text string, fontFace font.Face, stringConfigs ...StringConfig) error {
 	err := g.verifyInBounds(row, column)
 	if err != nil {
 		return err
 	}
 
-	center := g.getCellCenter(row, column)
-	stringConfig := getFirstStringConfig(stringConfigs...)
+	err = g.verifyTextLength(text)
+	if err != nil {
+		return err
+	}
+
+	style := g.getCellStyle(row, column)
+	if fontFace == nil {
+		fontFace = style.FontFace
+	}
+	if fontFace == nil {
+		return errNoFontFace
+	}
+
+	stringConfig := getFirstStringConfig(stringConfigs...).withStyle(style)
+	err = stringConfig.validate()
+	if err != nil {
+		return err
+	}
+
+	clip, ok, err := g.fitFont(row, column, area, fontFace)
+	if err != nil || !ok {
+		return err
+	}
+
+	center := area.center
 	g.ctx.Push()
+	if clip {
+		g.clipToArea(area)
+	}
 	g.ctx.SetFontFace(fontFace)
 	g.ctx.SetColor(stringConfig.GetColor())
 	g.ctx.RotateAbout(gg.Radians(stringConfig.GetRotate()), center.X, center.Y)
-	g.ctx.DrawStringAnchored(text, center.X, center.Y, 0.5, 0.35)
+	g.drawText(row, column, area, text, stringConfig)
 	g.ctx.Pop()
+	g.addLabel(row, column, text)
 	return nil
 }
 
-func (g *Gridder) paintBackground() {
+// DrawStrings draws every non-empty string of a matrix in its cell, sharing the font face and color setup
+func (g *Gridder) DrawStrings(texts [][]string, fontFace font.Face, stringConfigs ...StringConfig) error {
+	stringConfig := getFirstStringConfig(stringConfigs...)
+	return g.retainDescribed("DrawStrings", []interface{}{texts, fontFace, stringConfig}, func() error {
+		return g.retainStrings(texts, fontFace, stringConfig, nil)
+	})
+}
+
+func (g *Gridder) drawStrings(texts [][]string, fontFace font.Face, stringConfig StringConfig, colorAt func(row int, column int) color.Color) error {
+	for row := range texts {
+		for column := range texts[row] {
+			if texts[row][column] == "" {
+				continue
+			}
+
+			err := g.verifyInBounds(row, column)
+			if err != nil {
+				return err
+			}
+
+			err = g.verifyTextLength(texts[row][column])
+			if err != nil {
+				return err
+			}
+
+			style := g.getCellStyle(row, column)
+			cellFontFace := fontFace
+			if cellFontFace == nil {
+				cellFontFace = style.FontFace
+			}
+			if cellFontFace == nil {
+				return errNoFontFace
+			}
+
+			cellConfig := stringConfig.withStyle(style)
+			err = cellConfig.validate()
+			if err != nil {
+				return err
+			}
+
+			_, _, err = g.fitFont(row, column, g.getCellArea(row, column), cellFontFace)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	g.ctx.Push()
+	if fontFace != nil {
+		g.ctx.SetFontFace(fontFace)
+	}
+	for row := range texts {
+		for column, text := range texts[row] {
+			if text == "" {
+				continue
+			}
+
+			style := g.getCellStyle(row, column)
+			cellConfig := stringConfig.withStyle(style)
+			cellFontFace := fontFace
+			if cellFontFace == nil {
+				cellFontFace = style.FontFace
+				g.ctx.SetFontFace(cellFontFace)
+			}
+			area := g.getCellArea(row, column)
+			clip, ok, _ := g.fitFont(row, column, area, cellFontFace)
+			if !ok {
+				continue
+			}
+
+			if colorAt != nil {
+				g.ctx.SetColor(colorAt(row, column))
+			} else {
+				g.ctx.SetColor(cellConfig.GetColor())
+			}
+
+			g.addLabel(row, column, text)
+			rotate := cellConfig.GetRotate()
+			if rotate == 0 && !clip {
+				g.drawText(row, column, area, text, cellConfig)
+				continue
+			}
+
+			g.ctx.Push()
+			if clip {
+				g.clipToArea(area)
+			}
+			g.ctx.RotateAbout(gg.Radians(rotate), area.center.X, area.center.Y)
+			g.drawText(row, column, area, text, cellConfig)
+			g.ctx.Pop()
+		}
+	}
+	g.ctx.Pop()
+	return nil
+}
+
+func (g *Gridder) image() image.Image {
+	return g.composeImage()
+}
+
+// composeImage renders the grid with its entities, the frame layers and the overlay on top, in that order, clips
+// the result to the mask and runs the filters over it
+func (g *Gridder) composeImage(frameLayers ...image.Image) image.Image {
+	if g.parent != nil {
+		return g.parent.composeImage(frameLayers...)
+	}
+
+	img := g.paintLines()
+	var layers []image.Image
+	if len(g.entityOrder) > 0 {
+		layers = append(layers, g.renderEntities())
+	}
+	layers = append(layers, frameLayers...)
+	if g.overlay != nil {
+		layers = append(layers, g.overlay.ctx.Image())
+	}
+	if len(layers) > 0 {
+		img = composeLayers(img, layers...)
+	}
+	img = flipImage(img, g.flipHorizontal, g.flipVertical)
+	img = g.applyMask(img)
+	img = g.applyFilters(img)
+	if g.imageConfig.IsDeterministic() {
+		img = toNRGBA(img)
+	}
+	return img
+}
+
+// paintLines paints the grid lines and the border over a copy of the drawing of the grid, so that rendering again does
+// not paint them over the lines painted before
+func (g *Gridder) paintLines() image.Image {
+	drawing := g.ctx.Image().(*image.RGBA)
+	img := image.NewRGBA(drawing.Bounds())
+	copy(img.Pix, drawing.Pix)
+
+	ctx := g.ctx
+	g.ctx = gg.NewContextForRGBA(img)
 	margin := float64(g.gridConfig.GetMarginWidth())
 	g.ctx.Translate(margin, margin)
-	g.ctx.SetColor(g.gridConfig.GetBackgroundColor())
+	g.paintGrid()
+	g.paintBorder()
+	g.ctx = ctx
+	return img
+}
+
+func (g *Gridder) paintBackground() {
+	if g.hidesMaskedCells() {
+		g.ctx.Push()
+		g.ctx.SetColor(color.Transparent)
+		g.ctx.Clear()
+		g.ctx.Pop()
+		g.paintMaskedBackground()
+		return
+	}
+
+	g.ctx.Push()
+	g.ctx.SetColor(g.getBackgroundColor())
 	g.ctx.Clear()
+	g.ctx.Pop()
 }
 
 func (g *Gridder) paintGrid() {
 	canvasWidth, canvasHeight := g.getGridDimensions()
-	columns := g.gridConfig.GetColumns()
+	columnEdges, rowEdges := g.getLineEdges()
 
 	g.ctx.Push()
-	var xPosition float64
-	for i := 0; i < columns; i++ {
-		cellWidth, _ := g.getCellDimensions(0, i)
-		xPosition += cellWidth
-		g.ctx.MoveTo(xPosition, 0)
-		g.ctx.LineTo(xPosition, canvasHeight)
-	}
-
-	rows := g.gridConfig.GetRows()
-	var yPosition float64
-	for i := 0; i < rows; i++ {
-		_, cellHeight := g.getCellDimensions(i, 0)
-		yPosition += cellHeight
-		g.ctx.MoveTo(0, yPosition)
-		g.ctx.LineTo(canvasWidth, yPosition)
-	}
-
 	dashes := g.gridConfig.GetLineDashes()
 	if dashes > 0 {
 		g.ctx.SetDash(dashes)
@@ -250,8 +695,20 @@ func (g *Gridder) paintGrid() {
 		g.ctx.SetDash()
 	}
 	g.ctx.SetColor(g.gridConfig.GetLineColor())
-	g.ctx.SetLineWidth(g.gridConfig.GetLineStrokeWidth())
-	g.ctx.Stroke()
+	g.stroke(g.getLineStrokeWidth(), func() {
+		if g.hidesMaskedCells() {
+			g.traceMaskedLines()
+			return
+		}
+
+		for _, xPosition := range columnEdges {
+			g.traceLine(xPosition, 0, xPosition, canvasHeight)
+		}
+
+		for _, yPosition := range rowEdges {
+			g.traceLine(0, yPosition, canvasWidth, yPosition)
+		}
+	})
 	g.ctx.Pop()
 }
 
@@ -260,25 +717,25 @@ func (g *Gridder) paintBorder() {
 	gridWidth, gridHeight := g.getGridDimensions()
 
 	g.ctx.Push()
-	g.ctx.MoveTo(0, 0)
-	g.ctx.LineTo(0, canvasHeight)
-	g.ctx.MoveTo(gridWidth, 0)
-	g.ctx.LineTo(gridWidth, canvasHeight)
-
-	g.ctx.MoveTo(0, 0)
-	g.ctx.LineTo(canvasWidth, 0)
-	g.ctx.MoveTo(0, gridHeight)
-	g.ctx.LineTo(canvasWidth, gridHeight)
-
 	dashes := g.gridConfig.GetBorderDashes()
 	if dashes > 0 {
 		g.ctx.SetDash(dashes)
 	} else {
 		g.ctx.SetDash()
 	}
-	g.ctx.SetLineWidth(g.gridConfig.GetBorderStrokeWidth())
 	g.ctx.SetColor(g.gridConfig.GetBorderColor())
-	g.ctx.Stroke()
+	g.stroke(g.gridConfig.GetBorderStrokeWidth(), func() {
+		if g.hidesMaskedCells() {
+			g.traceMaskedBorder()
+			return
+		}
+
+		g.traceLine(0, 0, 0, canvasHeight)
+		g.traceLine(gridWidth, 0, gridWidth, canvasHeight)
+
+		g.traceLine(0, 0, canvasWidth, 0)
+		g.traceLine(0, gridHeight, canvasWidth, gridHeight)
+	})
 	g.ctx.Pop()
 }
 
@@ -289,7 +746,7 @@ func (g *Gridder) getCellDimensions(row, column int) (float64, float64) {
 
 	cellWidth := (gridWidth-sumWidthOffset)/float64(columns) + g.gridConfig.ColumnOffset(column)
 	cellHeight := (gridHeight-sumHeightOffset)/float64(rows) + g.gridConfig.RowOffset(row)
-	return cellWidth, cellHeight
+	return g.snap(cellWidth), g.snap(cellHeight)
 }
 
 func (g Gridder) sumWidthOffset() float64 {
@@ -319,40 +776,31 @@ func (g *Gridder) getGridDimensions() (float64, float64) {
 
 func (g *Gridder) getCellCenter(row, column int) *gg.Point {
 	cellWidth, cellHeight := g.getCellDimensions(row, column)
-	var xPosition, yPosition float64
-
-	for i := 0; i <= column; i++ {
-		cellWidth, _ := g.getCellDimensions(0, i)
-		xPosition += cellWidth
-	}
-
-	for i := 0; i <= row; i++ {
-		_, cellHeight := g.getCellDimensions(i, 0)
-		yPosition += cellHeight
-	}
+	layout := g.getLayout()
+	xPosition := cellEdge(layout.columnEdges, column, cellWidth)
+	yPosition := cellEdge(layout.rowEdges, row, cellHeight)
 
 	return &gg.Point{
-		X: xPosition - (cellWidth / 2),
-		Y: yPosition - (cellHeight / 2),
+		X: g.snap(xPosition - (cellWidth / 2)),
+		Y: g.snap(yPosition - (cellHeight / 2)),
 	}
 }
 
-func (g *Gridder) verifyInBounds(row, column int) error {
-	columns, rows := g.gridConfig.GetColumns(), g.gridConfig.GetRows()
-	gridWidth, gridHeight := g.getGridDimensions()
+// getCellArea gets the area of a cell
+func (g *Gridder) getCellArea(row, column int) cellArea {
+	cellWidth, cellHeight := g.getCellDimensions(row, column)
+	return cellArea{center: g.getCellCenter(row, column), width: cellWidth, height: cellHeight}
+}
 
-	if row < 0 || row >= rows || column < 0 || column >= columns {
-		return errOutOfBounds
+func (g *Gridder) verifyInBounds(row, column int) error {
+	if g.skipBoundsCheck {
+		return nil
 	}
-	for _, v := range g.gridConfig.ColumnsWidthOffset {
-		if v.Column >= columns || v.Offset >= gridWidth {
-			return errOutOfBounds
-		}
+	if row < 0 || row >= g.gridConfig.GetRows() || column < 0 || column >= g.gridConfig.GetColumns() {
+		return errOutOfBounds
 	}
-	for _, v := range g.gridConfig.RowsHeightOffset {
-		if v.Row >= rows || v.Offset >= gridHeight {
-			return errOutOfBounds
-		}
+	if g.gridConfig.IsMasked(row, column) {
+		return errMaskedCell
 	}
 	return nil
 }
@@ -2,6 +2,7 @@ package gridder
 
 import (
 	"bytes"
+	"image"
 	"image/color"
 	"testing"
 
@@ -19,6 +20,28 @@ func TestNew(t *testing.T) {
 
 	_, err = New(ImageConfig{}, GridConfig{Rows: 10, Columns: 10})
 	assert.Nil(t, err)
+
+	_, err = New(ImageConfig{}, GridConfig{Rows: 10, Columns: 10, ColumnsWidthOffset: []*ColumnWidthOffset{{Column: 10, Offset: 1}}})
+	assert.Equal(t, err, errOutOfBounds)
+
+	_, err = New(ImageConfig{Height: 100}, GridConfig{Rows: 10, Columns: 10, RowsHeightOffset: []*RowHeightOffset{{Row: 1, Offset: 100}}})
+	assert.Equal(t, err, errOutOfBounds)
+}
+
+func TestSkipBoundsCheck(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.DrawCircle(2, 2)
+	assert.Equal(t, err, errOutOfBounds)
+
+	gridder.SkipBoundsCheck(true)
+	err = gridder.DrawCircle(2, 2)
+	assert.Nil(t, err)
+
+	gridder.SkipBoundsCheck(false)
+	err = gridder.DrawCircle(2, 2)
+	assert.Equal(t, err, errOutOfBounds)
 }
 
 func TestPaintCell(t *testing.T) {
@@ -90,6 +113,23 @@ func TestDrawString(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestDrawStrings(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 24})
+
+	err = gridder.DrawStrings([][]string{{"A", "B", "C"}}, fontFace)
+	assert.NotNil(t, err)
+
+	err = gridder.DrawStrings([][]string{{"A", "B", ""}}, fontFace)
+	assert.Nil(t, err)
+
+	err = gridder.DrawStrings([][]string{{"A"}, {"B", "C"}}, fontFace, StringConfig{Rotate: 45})
+	assert.Nil(t, err)
+}
+
 func TestSave(t *testing.T) {
 	gridder, err := New(ImageConfig{}, GridConfig{Rows: 1, Columns: 1})
 	assert.Nil(t, err)
@@ -107,3 +147,103 @@ func TestWriter(t *testing.T) {
 	err = gridder.EncodePNG(bImage)
 	assert.Nil(t, err)
 }
+
+func TestEncodePNGBuffer(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 2, BorderStrokeWidth: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(1, 2, color.Black))
+
+	expected := new(bytes.Buffer)
+	assert.Nil(t, gridder.EncodePNG(expected))
+
+	buffer := bytes.NewBufferString("stale content")
+	assert.Nil(t, gridder.EncodePNGBuffer(buffer))
+	assert.Equal(t, buffer.Bytes(), expected.Bytes())
+
+	assert.Nil(t, gridder.EncodePNGBuffer(buffer))
+	assert.Equal(t, buffer.Bytes(), expected.Bytes())
+	assert.Equal(t, gridder.encodedSize, int64(expected.Len()))
+}
+
+func BenchmarkEncodePNG(b *testing.B) {
+	gridder := newEncodeBenchmarkGridder(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := gridder.EncodePNG(new(bytes.Buffer))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodePNGBuffer(b *testing.B) {
+	gridder := newEncodeBenchmarkGridder(b)
+	buffer := new(bytes.Buffer)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := gridder.EncodePNGBuffer(buffer)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func newEncodeBenchmarkGridder(b *testing.B) *Gridder {
+	gridder, err := New(ImageConfig{Width: 500, Height: 500}, GridConfig{Rows: 50, Columns: 50})
+	if err != nil {
+		b.Fatal(err)
+	}
+	for row := 0; row < 50; row++ {
+		err = gridder.PaintCell(row, row, color.Black)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	return gridder
+}
+
+func TestImage(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(1, 2, color.Black))
+
+	img := gridder.Image()
+	assert.Equal(t, img.Bounds(), image.Rect(0, 0, 100, 100))
+	assert.Equal(t, color.RGBAModel.Convert(img.At(62, 37)), color.RGBA{A: 255})
+
+	assert.Nil(t, gridder.PaintCell(0, 0, color.White))
+	assert.Nil(t, gridder.PaintCell(1, 2, color.White))
+	assert.Equal(t, color.RGBAModel.Convert(img.At(62, 37)), color.RGBA{A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(gridder.Overlay().Image().At(62, 37)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+}
+
+func TestContext(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, MarginWidth: 10})
+	assert.Nil(t, err)
+
+	ctx := gridder.Context()
+	ctx.SetColor(color.Black)
+	ctx.DrawRectangle(0, 0, 5, 5)
+	ctx.Fill()
+	assert.Equal(t, color.RGBAModel.Convert(gridder.Image().At(12, 12)), color.RGBA{A: 255})
+	assert.NotEqual(t, gridder.Overlay().Context(), ctx)
+
+	// the context is kept when the grid is re-laid out, with the new margin
+	gridConfig := gridder.GridConfig()
+	gridConfig.MarginWidth = 20
+	assert.Nil(t, gridder.SetGridConfig(gridConfig))
+	assert.Nil(t, gridder.InsertRow(0))
+	assert.Same(t, gridder.Context(), ctx)
+	ctx.DrawRectangle(0, 0, 5, 5)
+	ctx.Fill()
+	assert.Equal(t, color.RGBAModel.Convert(gridder.Image().At(22, 22)), color.RGBA{A: 255})
+}
+
+func TestImageRenderedAgain(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 3, Columns: 3, LineStrokeWidth: 2, BorderStrokeWidth: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(1, 1, color.Black))
+	assert.Equal(t, gridder.Image(), gridder.Image())
+}
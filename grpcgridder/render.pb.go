@@ -0,0 +1,232 @@
+// Render service turning gridder scenes into images, for deploying gridder as a standalone rendering service.
+// Regenerate the Go code with protoc-gen-go and protoc-gen-go-grpc using paths=source_relative, with this directory
+// and the root of the gridder module as import paths
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: render.proto
+
+package grpcgridder
+
+import (
+	replaypb "github.com/rageofgods/gridder/proto/replaypb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SceneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// scene is the replay of proto/replay.proto in the gridder module, older versions of the format are migrated
+	Scene *replaypb.Replay `protobuf:"bytes,1,opt,name=scene,proto3" json:"scene,omitempty"`
+	// width and height of the rendered images in pixels, the recorded size is used when unset
+	Width  int32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// format is an image format registered with gridder, png when unset
+	Format        string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SceneRequest) Reset() {
+	*x = SceneRequest{}
+	mi := &file_render_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SceneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SceneRequest) ProtoMessage() {}
+
+func (x *SceneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_render_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SceneRequest.ProtoReflect.Descriptor instead.
+func (*SceneRequest) Descriptor() ([]byte, []int) {
+	return file_render_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SceneRequest) GetScene() *replaypb.Replay {
+	if x != nil {
+		return x.Scene
+	}
+	return nil
+}
+
+func (x *SceneRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *SceneRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SceneRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type Image struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Data   []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// frame is the index of the frame in the scene
+	Frame         int32 `protobuf:"varint,3,opt,name=frame,proto3" json:"frame,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_render_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_render_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_render_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Image) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Image) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Image) GetFrame() int32 {
+	if x != nil {
+		return x.Frame
+	}
+	return 0
+}
+
+var File_render_proto protoreflect.FileDescriptor
+
+const file_render_proto_rawDesc = "" +
+	"\n" +
+	"\frender.proto\x12\x0egridder.render\x1a\x12proto/replay.proto\"\x82\x01\n" +
+	"\fSceneRequest\x12,\n" +
+	"\x05scene\x18\x01 \x01(\v2\x16.gridder.replay.ReplayR\x05scene\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\"I\n" +
+	"\x05Image\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x14\n" +
+	"\x05frame\x18\x03 \x01(\x05R\x05frame2\x9a\x01\n" +
+	"\rRenderService\x12B\n" +
+	"\vSubmitScene\x12\x1c.gridder.render.SceneRequest\x1a\x15.gridder.render.Image\x12E\n" +
+	"\fStreamFrames\x12\x1c.gridder.render.SceneRequest\x1a\x15.gridder.render.Image0\x01B+Z)github.com/rageofgods/gridder/grpcgridderb\x06proto3"
+
+var (
+	file_render_proto_rawDescOnce sync.Once
+	file_render_proto_rawDescData []byte
+)
+
+func file_render_proto_rawDescGZIP() []byte {
+	file_render_proto_rawDescOnce.Do(func() {
+		file_render_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_render_proto_rawDesc), len(file_render_proto_rawDesc)))
+	})
+	return file_render_proto_rawDescData
+}
+
+var file_render_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_render_proto_goTypes = []any{
+	(*SceneRequest)(nil),    // 0: gridder.render.SceneRequest
+	(*Image)(nil),           // 1: gridder.render.Image
+	(*replaypb.Replay)(nil), // 2: gridder.replay.Replay
+}
+var file_render_proto_depIdxs = []int32{
+	2, // 0: gridder.render.SceneRequest.scene:type_name -> gridder.replay.Replay
+	0, // 1: gridder.render.RenderService.SubmitScene:input_type -> gridder.render.SceneRequest
+	0, // 2: gridder.render.RenderService.StreamFrames:input_type -> gridder.render.SceneRequest
+	1, // 3: gridder.render.RenderService.SubmitScene:output_type -> gridder.render.Image
+	1, // 4: gridder.render.RenderService.StreamFrames:output_type -> gridder.render.Image
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_render_proto_init() }
+func file_render_proto_init() {
+	if File_render_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_render_proto_rawDesc), len(file_render_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_render_proto_goTypes,
+		DependencyIndexes: file_render_proto_depIdxs,
+		MessageInfos:      file_render_proto_msgTypes,
+	}.Build()
+	File_render_proto = out.File
+	file_render_proto_goTypes = nil
+	file_render_proto_depIdxs = nil
+}
@@ -0,0 +1,171 @@
+// Render service turning gridder scenes into images, for deploying gridder as a standalone rendering service.
+// Regenerate the Go code with protoc-gen-go and protoc-gen-go-grpc using paths=source_relative, with this directory
+// and the root of the gridder module as import paths
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: render.proto
+
+package grpcgridder
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RenderService_SubmitScene_FullMethodName  = "/gridder.render.RenderService/SubmitScene"
+	RenderService_StreamFrames_FullMethodName = "/gridder.render.RenderService/StreamFrames"
+)
+
+// RenderServiceClient is the client API for RenderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RenderServiceClient interface {
+	// SubmitScene renders a scene and returns its last recorded frame
+	SubmitScene(ctx context.Context, in *SceneRequest, opts ...grpc.CallOption) (*Image, error)
+	// StreamFrames renders a scene and streams every recorded frame in order
+	StreamFrames(ctx context.Context, in *SceneRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Image], error)
+}
+
+type renderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRenderServiceClient(cc grpc.ClientConnInterface) RenderServiceClient {
+	return &renderServiceClient{cc}
+}
+
+func (c *renderServiceClient) SubmitScene(ctx context.Context, in *SceneRequest, opts ...grpc.CallOption) (*Image, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Image)
+	err := c.cc.Invoke(ctx, RenderService_SubmitScene_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *renderServiceClient) StreamFrames(ctx context.Context, in *SceneRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Image], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RenderService_ServiceDesc.Streams[0], RenderService_StreamFrames_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SceneRequest, Image]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RenderService_StreamFramesClient = grpc.ServerStreamingClient[Image]
+
+// RenderServiceServer is the server API for RenderService service.
+// All implementations must embed UnimplementedRenderServiceServer
+// for forward compatibility.
+type RenderServiceServer interface {
+	// SubmitScene renders a scene and returns its last recorded frame
+	SubmitScene(context.Context, *SceneRequest) (*Image, error)
+	// StreamFrames renders a scene and streams every recorded frame in order
+	StreamFrames(*SceneRequest, grpc.ServerStreamingServer[Image]) error
+	mustEmbedUnimplementedRenderServiceServer()
+}
+
+// UnimplementedRenderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRenderServiceServer struct{}
+
+func (UnimplementedRenderServiceServer) SubmitScene(context.Context, *SceneRequest) (*Image, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitScene not implemented")
+}
+func (UnimplementedRenderServiceServer) StreamFrames(*SceneRequest, grpc.ServerStreamingServer[Image]) error {
+	return status.Error(codes.Unimplemented, "method StreamFrames not implemented")
+}
+func (UnimplementedRenderServiceServer) mustEmbedUnimplementedRenderServiceServer() {}
+func (UnimplementedRenderServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeRenderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RenderServiceServer will
+// result in compilation errors.
+type UnsafeRenderServiceServer interface {
+	mustEmbedUnimplementedRenderServiceServer()
+}
+
+func RegisterRenderServiceServer(s grpc.ServiceRegistrar, srv RenderServiceServer) {
+	// If the following call panics, it indicates UnimplementedRenderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RenderService_ServiceDesc, srv)
+}
+
+func _RenderService_SubmitScene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SceneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RenderServiceServer).SubmitScene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RenderService_SubmitScene_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RenderServiceServer).SubmitScene(ctx, req.(*SceneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RenderService_StreamFrames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SceneRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RenderServiceServer).StreamFrames(m, &grpc.GenericServerStream[SceneRequest, Image]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RenderService_StreamFramesServer = grpc.ServerStreamingServer[Image]
+
+// RenderService_ServiceDesc is the grpc.ServiceDesc for RenderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RenderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gridder.render.RenderService",
+	HandlerType: (*RenderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitScene",
+			Handler:    _RenderService_SubmitScene_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFrames",
+			Handler:       _RenderService_StreamFrames_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "render.proto",
+}
@@ -0,0 +1,112 @@
+// Package grpcgridder serves gridder scenes over gRPC, so that gridder can be deployed as a standalone rendering
+// service. It is a separate module so that the gridder module does not depend on gRPC
+package grpcgridder
+
+import (
+	"bytes"
+	"context"
+	"image"
+
+	"github.com/rageofgods/gridder"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const defaultFormat = "png"
+
+// Server implements RenderService
+type Server struct {
+	UnimplementedRenderServiceServer
+
+	limits gridder.RenderLimits
+}
+
+// NewServer creates a render service applying limits to every scene, scenes are untrusted input so limits should
+// always be set for services open to other teams
+func NewServer(limits gridder.RenderLimits) *Server {
+	return &Server{limits: limits}
+}
+
+// Register registers the render service with a gRPC server along with a health service reporting it as serving.
+// The returned health server can be used to report the service as not serving during shutdown
+func Register(registrar grpc.ServiceRegistrar, server *Server) *health.Server {
+	RegisterRenderServiceServer(registrar, server)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(RenderService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(registrar, healthServer)
+	return healthServer
+}
+
+// SubmitScene renders a scene and returns its last recorded frame
+func (s *Server) SubmitScene(ctx context.Context, request *SceneRequest) (*Image, error) {
+	frames, err := s.render(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(frames) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "scene has no recorded frames")
+	}
+	return encodeFrame(frames[len(frames)-1], len(frames)-1, request.GetFormat())
+}
+
+// StreamFrames renders a scene and streams every recorded frame in order
+func (s *Server) StreamFrames(request *SceneRequest, stream RenderService_StreamFramesServer) error {
+	frames, err := s.render(request)
+	if err != nil {
+		return err
+	}
+
+	for i, frame := range frames {
+		err = stream.Context().Err()
+		if err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		img, err := encodeFrame(frame, i, request.GetFormat())
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(img)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) render(request *SceneRequest) ([]image.Image, error) {
+	replay, err := gridder.ReplayFromProto(request.GetScene())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	width, height := int(request.GetWidth()), int(request.GetHeight())
+	if width <= 0 || height <= 0 {
+		width, height = replay.Width, replay.Height
+	}
+
+	frames, err := replay.Render(gridder.ImageConfig{Width: width, Height: height, Limits: s.limits})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return frames, nil
+}
+
+func encodeFrame(frame image.Image, index int, format string) (*Image, error) {
+	if format == "" {
+		format = defaultFormat
+	}
+
+	buffer := new(bytes.Buffer)
+	err := gridder.EncodeImage(buffer, frame, format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &Image{Data: buffer.Bytes(), Format: format, Frame: int32(index)}, nil
+}
@@ -0,0 +1,110 @@
+package grpcgridder
+
+import (
+	"bytes"
+	"context"
+	"image/color"
+	"image/png"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/rageofgods/gridder"
+	"github.com/rageofgods/gridder/proto/replaypb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestClient(t *testing.T, limits gridder.RenderLimits) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	Register(server, NewServer(limits))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func testScene(t *testing.T) *replaypb.Replay {
+	g, err := gridder.New(gridder.ImageConfig{Width: 40, Height: 20}, gridder.GridConfig{Rows: 1, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, err)
+
+	g.StartReplay()
+	g.RecordFrame()
+	assert.Nil(t, g.PaintCell(0, 1, color.Black))
+	g.RecordFrame()
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(t, g.ExportReplayProto(buffer))
+	var scene replaypb.Replay
+	assert.Nil(t, proto.Unmarshal(buffer.Bytes(), &scene))
+	return &scene
+}
+
+func TestSubmitScene(t *testing.T) {
+	client := NewRenderServiceClient(newTestClient(t, gridder.RenderLimits{}))
+
+	img, err := client.SubmitScene(context.Background(), &SceneRequest{Scene: testScene(t), Width: 80, Height: 40})
+	assert.Nil(t, err)
+	assert.Equal(t, img.GetFormat(), "png")
+	assert.Equal(t, img.GetFrame(), int32(1))
+
+	decoded, err := png.Decode(bytes.NewReader(img.GetData()))
+	assert.Nil(t, err)
+	assert.Equal(t, decoded.Bounds().Dx(), 80)
+	assert.Equal(t, color.GrayModel.Convert(decoded.At(60, 20)), color.Gray{})
+
+	_, err = client.SubmitScene(context.Background(), &SceneRequest{Scene: &replaypb.Replay{Version: 99}})
+	assert.Equal(t, status.Code(err), codes.InvalidArgument)
+	_, err = client.SubmitScene(context.Background(), &SceneRequest{})
+	assert.Equal(t, status.Code(err), codes.InvalidArgument)
+	_, err = client.SubmitScene(context.Background(), &SceneRequest{Scene: testScene(t), Format: "avif"})
+	assert.Equal(t, status.Code(err), codes.InvalidArgument)
+}
+
+func TestSubmitSceneLimits(t *testing.T) {
+	client := NewRenderServiceClient(newTestClient(t, gridder.RenderLimits{MaxPixels: 1000}))
+
+	_, err := client.SubmitScene(context.Background(), &SceneRequest{Scene: testScene(t)})
+	assert.Nil(t, err)
+	_, err = client.SubmitScene(context.Background(), &SceneRequest{Scene: testScene(t), Width: 1000, Height: 1000})
+	assert.Equal(t, status.Code(err), codes.InvalidArgument)
+}
+
+func TestStreamFrames(t *testing.T) {
+	client := NewRenderServiceClient(newTestClient(t, gridder.RenderLimits{}))
+
+	stream, err := client.StreamFrames(context.Background(), &SceneRequest{Scene: testScene(t), Format: "bmp"})
+	assert.Nil(t, err)
+
+	var frames []int32
+	for {
+		img, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, img.GetFormat(), "bmp")
+		frames = append(frames, img.GetFrame())
+	}
+	assert.Equal(t, frames, []int32{0, 1})
+}
+
+func TestHealth(t *testing.T) {
+	client := healthpb.NewHealthClient(newTestClient(t, gridder.RenderLimits{}))
+
+	response, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: RenderService_ServiceDesc.ServiceName})
+	assert.Nil(t, err)
+	assert.Equal(t, response.GetStatus(), healthpb.HealthCheckResponse_SERVING)
+}
@@ -0,0 +1,61 @@
+package gridder
+
+import (
+	"math"
+)
+
+// traceLine traces a horizontal or vertical line of the grid, wobbling it across its direction when the grid is
+// jittered. The wobble depends on the absolute position along the line, so that the pieces of a line traced
+// separately join up, and repeated tracing wobbles the same way
+func (g *Gridder) traceLine(x1 float64, y1 float64, x2 float64, y2 float64) {
+	jitter := g.gridConfig.GetJitter()
+	if jitter <= 0 {
+		g.ctx.MoveTo(x1, y1)
+		g.ctx.LineTo(x2, y2)
+		return
+	}
+
+	vertical := x1 == x2
+	from, to, across := x1, x2, y1
+	if vertical {
+		from, to, across = y1, y2, x1
+	}
+	seed := uint64(g.gridConfig.GetJitterSeed())
+	if vertical {
+		seed = ^seed
+	}
+	point := func(along float64) (float64, float64) {
+		offset := jitter * jitterNoise(seed, math.Round(across), along/defaultJitterWavelength)
+		if vertical {
+			return across + offset, along
+		}
+		return along, across + offset
+	}
+
+	steps := int(math.Ceil(math.Abs(to-from) / defaultJitterStep))
+	g.ctx.MoveTo(point(from))
+	for i := 1; i <= steps; i++ {
+		g.ctx.LineTo(point(from + (to-from)*float64(i)/float64(steps)))
+	}
+}
+
+// jitterNoise gets smooth value noise between -1 and 1 along a line, interpolating random values at whole positions
+func jitterNoise(seed uint64, line float64, position float64) float64 {
+	value := func(knot float64) float64 {
+		hash := splitMix64(seed ^ splitMix64(math.Float64bits(line)^splitMix64(math.Float64bits(knot))))
+		return float64(hash>>11)/float64(1<<53)*2 - 1
+	}
+
+	knot := math.Floor(position)
+	t := position - knot
+	t = t * t * (3 - 2*t)
+	return value(knot)*(1-t) + value(knot+1)*t
+}
+
+// splitMix64 scrambles the bits of a value, as the SplitMix64 generator does with its state
+func splitMix64(value uint64) uint64 {
+	value += 0x9e3779b97f4a7c15
+	value = (value ^ value>>30) * 0xbf58476d1ce4e5b9
+	value = (value ^ value>>27) * 0x94d049bb133111eb
+	return value ^ value>>31
+}
@@ -0,0 +1,43 @@
+package gridder
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJitterNoise(t *testing.T) {
+	for _, position := range []float64{0, 0.3, 1, 2.5, -4.2} {
+		noise := jitterNoise(1, 10, position)
+		assert.True(t, noise >= -1 && noise <= 1)
+		assert.Equal(t, jitterNoise(1, 10, position), noise)
+	}
+	assert.InDelta(t, jitterNoise(1, 10, 1-1e-9), jitterNoise(1, 10, 1), 1e-6)
+	assert.NotEqual(t, jitterNoise(1, 10, 0.5), jitterNoise(2, 10, 0.5))
+	assert.NotEqual(t, jitterNoise(1, 10, 0.5), jitterNoise(1, 20, 0.5))
+}
+
+func TestJitter(t *testing.T) {
+	encode := func(gridConfig GridConfig) []byte {
+		gridder, err := New(ImageConfig{Width: 100, Height: 100}, gridConfig)
+		assert.Nil(t, err)
+		var buffer bytes.Buffer
+		assert.Nil(t, gridder.EncodePNG(&buffer))
+		return buffer.Bytes()
+	}
+
+	straight := encode(GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 2})
+	jittered := encode(GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 2, Jitter: 3, JitterSeed: 1})
+	assert.NotEqual(t, jittered, straight)
+	assert.Equal(t, encode(GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 2, Jitter: 3, JitterSeed: 1}), jittered)
+	assert.NotEqual(t, encode(GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 2, Jitter: 3, JitterSeed: 2}), jittered)
+
+	gridder, _ := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, Jitter: 3})
+	assert.Equal(t, gridder.getCellCenter(1, 2).X, 62.5)
+	assert.Equal(t, gridder.getCellCenter(1, 2).Y, 37.5)
+
+	_, err := New(ImageConfig{}, GridConfig{Rows: 4, Columns: 4, Jitter: -1})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
@@ -0,0 +1,52 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+)
+
+// EncodeJPEG renders the grid and writes it to w as a JPEG of a quality from 1 to 100. JPEG has no transparency,
+// transparent areas are flattened onto white. The metadata is embedded as an Exif segment
+func (g *Gridder) EncodeJPEG(w io.Writer, quality int) error {
+	if quality < 1 || quality > 100 {
+		return fmt.Errorf("%w: JPEG quality %d", errInvalidValue, quality)
+	}
+	metadataWriter := g.jpegMetadataWriter(w)
+	err := encodeJPEG(metadataWriter, g.image(), quality)
+	if err != nil {
+		return err
+	}
+	return metadataWriter.Close()
+}
+
+// SaveJPEG renders the grid and saves it to a file as a JPEG of a quality from 1 to 100
+func (g *Gridder) SaveJPEG(path string, quality int) error {
+	return saveFile(path, func(w io.Writer) error {
+		return g.EncodeJPEG(w, quality)
+	})
+}
+
+func encodeDefaultJPEG(w io.Writer, img image.Image) error {
+	return encodeJPEG(w, img, jpeg.DefaultQuality)
+}
+
+func encodeJPEG(w io.Writer, img image.Image, quality int) error {
+	return jpeg.Encode(w, flattenImage(img, color.White), &jpeg.Options{Quality: quality})
+}
+
+// flattenImage composes an image with transparent areas over an opaque background color
+func flattenImage(img image.Image, background color.Color) image.Image {
+	if opaque, ok := img.(interface{ Opaque() bool }); ok && opaque.Opaque() {
+		return img
+	}
+
+	bounds := img.Bounds()
+	flattened := image.NewRGBA(bounds)
+	draw.Draw(flattened, bounds, image.NewUniform(background), image.Point{}, draw.Src)
+	draw.Draw(flattened, bounds, img, bounds.Min, draw.Over)
+	return flattened
+}
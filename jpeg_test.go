@@ -0,0 +1,52 @@
+package gridder
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeJPEG(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+
+	assert.ErrorIs(t, gridder.EncodeJPEG(new(bytes.Buffer), 0), errInvalidValue)
+	assert.ErrorIs(t, gridder.EncodeJPEG(new(bytes.Buffer), 101), errInvalidValue)
+
+	low, high := new(bytes.Buffer), new(bytes.Buffer)
+	assert.Nil(t, gridder.EncodeJPEG(low, 10))
+	assert.Nil(t, gridder.EncodeJPEG(high, 100))
+	assert.Less(t, low.Len(), high.Len())
+
+	img, err := jpeg.Decode(high)
+	assert.Nil(t, err)
+	assert.Equal(t, img.Bounds(), image.Rect(0, 0, 100, 100))
+	r, _, _, _ := img.At(25, 25).RGBA()
+	assert.Less(t, r, uint32(0x1000))
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(t, gridder.Encode(buffer, "JPG"))
+	_, err = jpeg.Decode(buffer)
+	assert.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "grid.jpg")
+	assert.Nil(t, gridder.SaveJPEG(path, 80))
+	assert.FileExists(t, path)
+}
+
+func TestFlattenImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	flattened := flattenImage(img, color.White)
+	assert.Equal(t, flattened.At(0, 0), color.RGBA{R: 255, A: 255})
+	assert.Equal(t, flattened.At(1, 0), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+	img.Set(1, 0, color.Black)
+	assert.Equal(t, flattenImage(img, color.White), image.Image(img))
+}
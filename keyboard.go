@@ -0,0 +1,153 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/fogleman/gg"
+	"golang.org/x/image/font"
+)
+
+// Key is a key of a keyboard layout row
+type Key struct {
+	// Legend is centered on the keycap, with a line per newline
+	Legend string
+	// Width is in key units, 1 by default, in steps of a quarter unit
+	Width float64
+	// Color overrides the configured key color
+	Color color.Color
+	// Spacer leaves the space of the key empty, such as the gap before the function keys
+	Spacer bool
+}
+
+// KeyboardLayout is a keyboard or button matrix, as rows of keys from the left
+type KeyboardLayout [][]Key
+
+// Render creates the layout image on a grid of a row per key row and a column per quarter key unit, so that every
+// key spans the columns of its width. Keys are drawn as rounded keycaps, a darker rim around the top face, with their
+// legend centered when a font is configured. The image is sized for square keys of one unit unless its dimensions
+// are set
+func (k KeyboardLayout) Render(imageConfig ImageConfig, keyboardConfigs ...KeyboardConfig) (*Gridder, error) {
+	keyboardConfig := getFirstKeyboardConfig(keyboardConfigs...)
+	if len(k) == 0 {
+		return nil, errNoRows
+	}
+
+	spans := make([][]int, len(k))
+	columns := 0
+	for row, keys := range k {
+		spans[row] = make([]int, len(keys))
+		width := 0
+		for i, key := range keys {
+			span, err := keySpan(key.Width)
+			if err != nil {
+				return nil, fmt.Errorf("%w of key %d of row %d", err, i, row)
+			}
+			spans[row][i] = span
+			width += span
+		}
+		columns = maxInt(columns, width)
+	}
+	if columns == 0 {
+		return nil, errNoColumns
+	}
+
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultKeyboardUnitSize / defaultKeyboardDivisions
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = len(k) * defaultKeyboardUnitSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            len(k),
+		Columns:         columns,
+		LineColor:       color.Transparent,
+		BorderColor:     color.Transparent,
+		BackgroundColor: keyboardConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if keyboardConfig.Font != nil {
+		fontFace = g.FontFace(keyboardConfig.Font, keyboardConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		for row, keys := range k {
+			column := 0
+			for i, key := range keys {
+				span := spans[row][i]
+				if !key.Spacer && span > 0 {
+					fill := key.Color
+					if fill == nil {
+						fill = keyboardConfig.GetKeyColor()
+					}
+					g.drawKeycap(row, column, span, key.Legend, fill, keyboardConfig.GetCornerRadius(), fontFace)
+				}
+				column += span
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// drawKeycap draws a key over a span of columns of a row, as a rim darkened from the key color around a top face
+// raised towards the back, and centers its legend on the top face
+func (g *Gridder) drawKeycap(row int, column int, span int, legend string, fill color.Color, radius float64, fontFace font.Face) {
+	first := g.getCellCenter(row, column)
+	width, height := g.getCellDimensions(row, column)
+	x0, y0 := first.X-width/2+defaultKeyboardGap/2, first.Y-height/2+defaultKeyboardGap/2
+
+	last := g.getCellCenter(row, column+span-1)
+	width, height = g.getCellDimensions(row, column+span-1)
+	x1, y1 := last.X+width/2-defaultKeyboardGap/2, last.Y+height/2-defaultKeyboardGap/2
+
+	g.ctx.Push()
+	defer g.ctx.Pop()
+	keyWidth, keyHeight := x1-x0, y1-y0
+	g.ctx.DrawRoundedRectangle(x0, y0, keyWidth, keyHeight, math.Min(radius, math.Min(keyWidth, keyHeight)/2))
+	g.ctx.SetColor(LinearColormap(fill, color.Black)(0.25))
+	g.ctx.Fill()
+
+	// the top face is inset by the gap at the sides and twice the gap at the front
+	faceX, faceY := x0+defaultKeyboardGap, y0+defaultKeyboardGap/2
+	faceWidth, faceHeight := keyWidth-2*defaultKeyboardGap, keyHeight-2.5*defaultKeyboardGap
+	if faceWidth <= 0 || faceHeight <= 0 {
+		return
+	}
+	g.ctx.DrawRoundedRectangle(faceX, faceY, faceWidth, faceHeight, math.Min(radius, math.Min(faceWidth, faceHeight)/2))
+	g.ctx.SetColor(fill)
+	g.ctx.Fill()
+
+	if fontFace == nil || legend == "" {
+		return
+	}
+	g.ctx.SetFontFace(fontFace)
+	g.ctx.SetColor(contrastColor(fill))
+	g.ctx.DrawStringWrapped(legend, faceX+faceWidth/2, faceY+faceHeight/2, 0.5, 0.5, faceWidth, 1.1, gg.AlignCenter)
+	g.addLabel(row, column, legend)
+}
+
+// keySpan gets the number of columns of a key width in key units
+func keySpan(width float64) (int, error) {
+	err := nonNegative("width", width)
+	if err != nil {
+		return 0, err
+	}
+	if width == 0 {
+		width = 1
+	}
+	span := width * defaultKeyboardDivisions
+	if span != math.Trunc(span) {
+		return 0, fmt.Errorf("%w: width %v is not a multiple of %v units", errInvalidValue, width, 1.0/defaultKeyboardDivisions)
+	}
+	return int(span), nil
+}
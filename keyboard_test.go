@@ -0,0 +1,66 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+var testKeyboardLayout = KeyboardLayout{
+	{{Legend: "Esc"}, {Spacer: true, Width: 0.5}, {Legend: "F1"}},
+	{{Legend: "Tab", Width: 1.5}, {Legend: "Q"}, {Legend: "W", Color: color.NRGBA{R: 255, A: 255}}},
+}
+
+func TestKeyboardLayoutRender(t *testing.T) {
+	gridder, err := testKeyboardLayout.Render(ImageConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 210)
+	assert.Equal(t, gridder.ctx.Height(), 120)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 25)), defaultKeyboardKeyColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 55)), color.NRGBAModel.Convert(LinearColormap(defaultKeyboardKeyColor, color.Black)(0.25)))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 30)), defaultKeyboardBackgroundColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(80, 85)), defaultKeyboardKeyColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(90, 85)), defaultKeyboardBackgroundColor)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(180, 85)), color.NRGBA{R: 255, A: 255})
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestKeyboardLayoutRenderLabels(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	gridder, err := testKeyboardLayout.Render(ImageConfig{Width: 420, Height: 240}, KeyboardConfig{Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 0}], []string{"Esc"})
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 6}], []string{"F1"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 6}], []string{"Q"})
+	assert.Equal(t, len(gridder.labels), 5)
+}
+
+func TestKeyboardLayoutRenderErrors(t *testing.T) {
+	_, err := KeyboardLayout{}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = KeyboardLayout{{}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errNoColumns))
+
+	_, err = KeyboardLayout{{{Width: -1}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = KeyboardLayout{{{Width: 1.3}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestKeySpan(t *testing.T) {
+	span, err := keySpan(0)
+	assert.Nil(t, err)
+	assert.Equal(t, span, 4)
+	span, _ = keySpan(2.25)
+	assert.Equal(t, span, 9)
+	_, err = keySpan(0.1)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
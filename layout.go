@@ -0,0 +1,180 @@
+package gridder
+
+import (
+	"math"
+
+	"github.com/fogleman/gg"
+)
+
+// layout caches the far edge of every row and column. It is rebuilt lazily after the grid configuration changes
+type layout struct {
+	columnEdges []float64
+	rowEdges    []float64
+	// minCellSize is the smallest width or height of the cells
+	minCellSize float64
+}
+
+// OuterLineMode is how the lines after the last row and column meet the border
+type OuterLineMode int
+
+const (
+	// OuterLinesDraw draws the outer lines at the far edges of the last cells, which can be a fraction of a pixel
+	// off the border when cell sizes are rounded
+	OuterLinesDraw OuterLineMode = iota
+	// OuterLinesFlush draws the outer lines exactly on the border
+	OuterLinesFlush
+	// OuterLinesBorder leaves the outer lines out, the border replaces them
+	OuterLinesBorder
+)
+
+// GridConfig returns a copy of the grid configuration, changes to it have no effect until passed to SetGridConfig
+func (g *Gridder) GridConfig() GridConfig {
+	return cloneGridConfig(g.gridConfig)
+}
+
+// SetGridConfig replaces the grid configuration and re-renders everything drawn so far with the new layout.
+// Drawing operations that no longer fit the grid are dropped. Operations are replayed with the current cell
+// styles and with the arguments they were called with, so slices passed to them should not be modified
+func (g *Gridder) SetGridConfig(gridConfig GridConfig) error {
+	return g.restructure(gridConfig, nil)
+}
+
+// AddRow appends a row to the grid and re-renders it. Cells of the new row are valid in a cell mask
+func (g *Gridder) AddRow() error {
+	gridConfig := g.GridConfig()
+	gridConfig.Rows = gridConfig.GetRows() + 1
+	remapCellMask(&gridConfig, keepCell)
+	return g.SetGridConfig(gridConfig)
+}
+
+// AddColumn appends a column to the grid and re-renders it. Cells of the new column are valid in a cell mask
+func (g *Gridder) AddColumn() error {
+	gridConfig := g.GridConfig()
+	gridConfig.Columns = gridConfig.GetColumns() + 1
+	remapCellMask(&gridConfig, keepCell)
+	return g.SetGridConfig(gridConfig)
+}
+
+func (g *Gridder) getLayout() *layout {
+	if g.layout != nil {
+		return g.layout
+	}
+
+	columns, rows := g.gridConfig.GetColumns(), g.gridConfig.GetRows()
+	l := &layout{
+		columnEdges: make([]float64, columns),
+		rowEdges:    make([]float64, rows),
+	}
+
+	var position float64
+	l.minCellSize = math.Inf(1)
+	for i := 0; i < columns; i++ {
+		cellWidth, _ := g.getCellDimensions(0, i)
+		position += cellWidth
+		l.columnEdges[i] = position
+		l.minCellSize = math.Min(l.minCellSize, cellWidth)
+	}
+
+	position = 0
+	for i := 0; i < rows; i++ {
+		_, cellHeight := g.getCellDimensions(i, 0)
+		position += cellHeight
+		l.rowEdges[i] = position
+		l.minCellSize = math.Min(l.minCellSize, cellHeight)
+	}
+
+	g.layout = l
+	return l
+}
+
+// getLineEdges gets the positions of the lines after every column and row, with the outer lines moved onto the
+// border or left out as the outer line mode sets
+func (g *Gridder) getLineEdges() ([]float64, []float64) {
+	layout := g.getLayout()
+	columnEdges, rowEdges := layout.columnEdges, layout.rowEdges
+	switch g.gridConfig.OuterLines {
+	case OuterLinesFlush:
+		gridWidth, gridHeight := g.getGridDimensions()
+		columnEdges = append(append([]float64{}, columnEdges[:len(columnEdges)-1]...), gridWidth)
+		rowEdges = append(append([]float64{}, rowEdges[:len(rowEdges)-1]...), gridHeight)
+	case OuterLinesBorder:
+		columnEdges, rowEdges = columnEdges[:len(columnEdges)-1], rowEdges[:len(rowEdges)-1]
+	}
+	return columnEdges, rowEdges
+}
+
+// cellEdge returns the far edge of a cell along one axis, extrapolating with the cell size outside of the grid
+func cellEdge(edges []float64, index int, size float64) float64 {
+	if index < 0 {
+		return float64(index+1) * size
+	}
+	if index >= len(edges) {
+		return edges[len(edges)-1] + float64(index-len(edges)+1)*size
+	}
+	return edges[index]
+}
+
+func newContext(imageConfig ImageConfig, gridConfig GridConfig) *gg.Context {
+	ctx := gg.NewContext(imageConfig.GetWidth(), imageConfig.GetHeight())
+	margin := float64(gridConfig.GetMarginWidth())
+	ctx.Translate(margin, margin)
+	return ctx
+}
+
+// resetContext resets a context of the grid to the transform of a new one after the margin changed, without a clip or
+// a path, keeping it for the callers that got it with Context
+func resetContext(ctx *gg.Context, gridConfig GridConfig) {
+	ctx.Identity()
+	ctx.ResetClip()
+	ctx.ClearPath()
+	margin := float64(gridConfig.GetMarginWidth())
+	ctx.Translate(margin, margin)
+}
+
+func cloneGridConfig(gridConfig GridConfig) GridConfig {
+	clone := gridConfig
+	if gridConfig.RowsHeightOffset != nil {
+		clone.RowsHeightOffset = make([]*RowHeightOffset, len(gridConfig.RowsHeightOffset))
+		for i, offset := range gridConfig.RowsHeightOffset {
+			copied := *offset
+			clone.RowsHeightOffset[i] = &copied
+		}
+	}
+
+	if gridConfig.ColumnsWidthOffset != nil {
+		clone.ColumnsWidthOffset = make([]*ColumnWidthOffset, len(gridConfig.ColumnsWidthOffset))
+		for i, offset := range gridConfig.ColumnsWidthOffset {
+			copied := *offset
+			clone.ColumnsWidthOffset[i] = &copied
+		}
+	}
+
+	if gridConfig.RowStyles != nil {
+		clone.RowStyles = make([]*RowStyle, len(gridConfig.RowStyles))
+		for i, style := range gridConfig.RowStyles {
+			copied := *style
+			clone.RowStyles[i] = &copied
+		}
+	}
+
+	if gridConfig.ColumnStyles != nil {
+		clone.ColumnStyles = make([]*ColumnStyle, len(gridConfig.ColumnStyles))
+		for i, style := range gridConfig.ColumnStyles {
+			copied := *style
+			clone.ColumnStyles[i] = &copied
+		}
+	}
+
+	if gridConfig.CellStyle != nil {
+		cellStyle := *gridConfig.CellStyle
+		clone.CellStyle = &cellStyle
+	}
+
+	if gridConfig.CellMask != nil {
+		clone.CellMask = make([][]bool, len(gridConfig.CellMask))
+		for i, row := range gridConfig.CellMask {
+			clone.CellMask[i] = append([]bool(nil), row...)
+		}
+	}
+	return clone
+}
@@ -0,0 +1,143 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGridConfigSnapshot(t *testing.T) {
+	offsets := []*ColumnWidthOffset{{Column: 0, Offset: 10}}
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, ColumnsWidthOffset: offsets})
+	assert.Nil(t, err)
+
+	offsets[0].Offset = 20
+	gridConfig := gridder.GridConfig()
+	assert.Equal(t, gridConfig.ColumnsWidthOffset[0].Offset, 10.0)
+
+	gridConfig.ColumnsWidthOffset[0].Offset = 30
+	assert.Equal(t, gridder.GridConfig().ColumnsWidthOffset[0].Offset, 10.0)
+}
+
+func TestSetGridConfig(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.PaintCell(1, 1, color.Black))
+	assert.Nil(t, gridder.Overlay().PaintCell(0, 1, color.Black))
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(75, 75)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(75, 25)), color.Gray{})
+
+	err = gridder.SetGridConfig(GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.GridConfig().Rows, 4)
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(37, 37)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(75, 75)), color.Gray{Y: 255})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(37, 12)), color.Gray{})
+
+	err = gridder.SetGridConfig(GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.operations.len(), 0)
+	assert.Equal(t, gridder.overlay.operations.len(), 0)
+
+	err = gridder.SetGridConfig(GridConfig{Rows: 0, Columns: 1})
+	assert.Equal(t, err, errNoRows)
+
+	err = gridder.SetGridConfig(GridConfig{Rows: 1, Columns: 0})
+	assert.Equal(t, err, errNoColumns)
+
+	err = gridder.SetGridConfig(GridConfig{Rows: 1, Columns: 1, ColumnsWidthOffset: []*ColumnWidthOffset{{Column: 1}}})
+	assert.Equal(t, err, errOutOfBounds)
+}
+
+func TestAddRowAndColumn(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+
+	assert.Nil(t, gridder.AddRow())
+	assert.Nil(t, gridder.AddColumn())
+	assert.Nil(t, gridder.Overlay().AddColumn())
+
+	gridConfig := gridder.GridConfig()
+	assert.Equal(t, gridConfig.Rows, 2)
+	assert.Equal(t, gridConfig.Columns, 3)
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(16, 25)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(16, 75)), color.Gray{Y: 255})
+}
+
+func TestRetain(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.DrawDiagram("A1: rect red; A1 -> B2 line", nil))
+	assert.Equal(t, gridder.operations.len(), 1)
+
+	assert.NotNil(t, gridder.DrawCircle(5, 5))
+	assert.Equal(t, gridder.operations.len(), 1)
+
+	assert.Nil(t, gridder.Render())
+	assert.Equal(t, gridder.operations.len(), 1)
+}
+
+func TestCellEdge(t *testing.T) {
+	edges := []float64{10, 30}
+	assert.Equal(t, cellEdge(edges, 0, 10), 10.0)
+	assert.Equal(t, cellEdge(edges, 1, 20), 30.0)
+	assert.Equal(t, cellEdge(edges, 3, 10), 50.0)
+	assert.Equal(t, cellEdge(edges, -1, 10), 0.0)
+}
+
+func TestLineEdges(t *testing.T) {
+	_, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1, OuterLines: OuterLinesBorder + 1})
+	assert.ErrorIs(t, err, errInvalidValue)
+
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 43, Columns: 17})
+	assert.Nil(t, err)
+	columnEdges, rowEdges := gridder.getLineEdges()
+	assert.Len(t, columnEdges, 17)
+	assert.Len(t, rowEdges, 43)
+	assert.NotEqual(t, columnEdges[16], 100.0)
+	assert.NotEqual(t, rowEdges[42], 100.0)
+
+	gridder, err = New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 43, Columns: 17, OuterLines: OuterLinesFlush})
+	assert.Nil(t, err)
+	columnEdges, rowEdges = gridder.getLineEdges()
+	assert.Equal(t, columnEdges[16], 100.0)
+	assert.Equal(t, rowEdges[42], 100.0)
+	assert.Equal(t, columnEdges[:16], gridder.getLayout().columnEdges[:16])
+	assert.NotEqual(t, gridder.getLayout().columnEdges[16], 100.0)
+
+	gridder, err = New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 43, Columns: 17, OuterLines: OuterLinesBorder})
+	assert.Nil(t, err)
+	columnEdges, rowEdges = gridder.getLineEdges()
+	assert.Equal(t, columnEdges, gridder.getLayout().columnEdges[:16])
+	assert.Equal(t, rowEdges, gridder.getLayout().rowEdges[:42])
+}
+
+func TestOuterLinesBorder(t *testing.T) {
+	gridConfig := GridConfig{
+		Rows:              2,
+		Columns:           2,
+		MarginWidth:       10,
+		LineStrokeWidth:   6,
+		BorderStrokeWidth: 2,
+		LineColor:         color.RGBA{R: 255, A: 255},
+		BorderColor:       color.RGBA{B: 255, A: 255},
+	}
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, gridConfig)
+	assert.Nil(t, err)
+	img := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(88, 30)), color.RGBA{R: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(img.At(30, 88)), color.RGBA{R: 255, A: 255})
+
+	gridConfig.OuterLines = OuterLinesBorder
+	gridder, err = New(ImageConfig{Width: 100, Height: 100}, gridConfig)
+	assert.Nil(t, err)
+	img = gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 30)), color.RGBA{R: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(img.At(88, 30)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(img.At(30, 88)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(img.At(90, 30)), color.RGBA{B: 255, A: 255})
+}
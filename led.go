@@ -0,0 +1,218 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/fogleman/gg"
+)
+
+const (
+	ledGlyphWidth  = 5
+	ledGlyphHeight = 7
+)
+
+// ledGlyphs is a 5x7 dot matrix font of the printable ASCII characters without lowercase letters, with a row of
+// dots per entry from the top and the leftmost dot in the highest of the five bits
+var ledGlyphs = map[rune][ledGlyphHeight]uint8{
+	' ':  {0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	'!':  {0x04, 0x04, 0x04, 0x04, 0x00, 0x00, 0x04},
+	'"':  {0x0a, 0x0a, 0x0a, 0x00, 0x00, 0x00, 0x00},
+	'#':  {0x0a, 0x0a, 0x1f, 0x0a, 0x1f, 0x0a, 0x0a},
+	'%':  {0x18, 0x19, 0x02, 0x04, 0x08, 0x13, 0x03},
+	'\'': {0x0c, 0x04, 0x08, 0x00, 0x00, 0x00, 0x00},
+	'(':  {0x02, 0x04, 0x08, 0x08, 0x08, 0x04, 0x02},
+	')':  {0x08, 0x04, 0x02, 0x02, 0x02, 0x04, 0x08},
+	'*':  {0x00, 0x04, 0x15, 0x0e, 0x15, 0x04, 0x00},
+	'+':  {0x00, 0x04, 0x04, 0x1f, 0x04, 0x04, 0x00},
+	',':  {0x00, 0x00, 0x00, 0x00, 0x0c, 0x04, 0x08},
+	'-':  {0x00, 0x00, 0x00, 0x1f, 0x00, 0x00, 0x00},
+	'.':  {0x00, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x0c},
+	'/':  {0x00, 0x01, 0x02, 0x04, 0x08, 0x10, 0x00},
+	'0':  {0x0e, 0x11, 0x13, 0x15, 0x19, 0x11, 0x0e},
+	'1':  {0x04, 0x0c, 0x04, 0x04, 0x04, 0x04, 0x0e},
+	'2':  {0x0e, 0x11, 0x01, 0x02, 0x04, 0x08, 0x1f},
+	'3':  {0x1f, 0x02, 0x04, 0x02, 0x01, 0x11, 0x0e},
+	'4':  {0x02, 0x06, 0x0a, 0x12, 0x1f, 0x02, 0x02},
+	'5':  {0x1f, 0x10, 0x1e, 0x01, 0x01, 0x11, 0x0e},
+	'6':  {0x06, 0x08, 0x10, 0x1e, 0x11, 0x11, 0x0e},
+	'7':  {0x1f, 0x01, 0x02, 0x04, 0x08, 0x08, 0x08},
+	'8':  {0x0e, 0x11, 0x11, 0x0e, 0x11, 0x11, 0x0e},
+	'9':  {0x0e, 0x11, 0x11, 0x0f, 0x01, 0x02, 0x0c},
+	':':  {0x00, 0x0c, 0x0c, 0x00, 0x0c, 0x0c, 0x00},
+	';':  {0x00, 0x0c, 0x0c, 0x00, 0x0c, 0x04, 0x08},
+	'<':  {0x02, 0x04, 0x08, 0x10, 0x08, 0x04, 0x02},
+	'=':  {0x00, 0x00, 0x1f, 0x00, 0x1f, 0x00, 0x00},
+	'>':  {0x08, 0x04, 0x02, 0x01, 0x02, 0x04, 0x08},
+	'?':  {0x0e, 0x11, 0x01, 0x02, 0x04, 0x00, 0x04},
+	'A':  {0x0e, 0x11, 0x11, 0x11, 0x1f, 0x11, 0x11},
+	'B':  {0x1e, 0x11, 0x11, 0x1e, 0x11, 0x11, 0x1e},
+	'C':  {0x0e, 0x11, 0x10, 0x10, 0x10, 0x11, 0x0e},
+	'D':  {0x1c, 0x12, 0x11, 0x11, 0x11, 0x12, 0x1c},
+	'E':  {0x1f, 0x10, 0x10, 0x1e, 0x10, 0x10, 0x1f},
+	'F':  {0x1f, 0x10, 0x10, 0x1e, 0x10, 0x10, 0x10},
+	'G':  {0x0e, 0x11, 0x10, 0x17, 0x11, 0x11, 0x0f},
+	'H':  {0x11, 0x11, 0x11, 0x1f, 0x11, 0x11, 0x11},
+	'I':  {0x0e, 0x04, 0x04, 0x04, 0x04, 0x04, 0x0e},
+	'J':  {0x07, 0x02, 0x02, 0x02, 0x02, 0x12, 0x0c},
+	'K':  {0x11, 0x12, 0x14, 0x18, 0x14, 0x12, 0x11},
+	'L':  {0x10, 0x10, 0x10, 0x10, 0x10, 0x10, 0x1f},
+	'M':  {0x11, 0x1b, 0x15, 0x15, 0x11, 0x11, 0x11},
+	'N':  {0x11, 0x11, 0x19, 0x15, 0x13, 0x11, 0x11},
+	'O':  {0x0e, 0x11, 0x11, 0x11, 0x11, 0x11, 0x0e},
+	'P':  {0x1e, 0x11, 0x11, 0x1e, 0x10, 0x10, 0x10},
+	'Q':  {0x0e, 0x11, 0x11, 0x11, 0x15, 0x12, 0x0d},
+	'R':  {0x1e, 0x11, 0x11, 0x1e, 0x14, 0x12, 0x11},
+	'S':  {0x0f, 0x10, 0x10, 0x0e, 0x01, 0x01, 0x1e},
+	'T':  {0x1f, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04},
+	'U':  {0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x0e},
+	'V':  {0x11, 0x11, 0x11, 0x11, 0x11, 0x0a, 0x04},
+	'W':  {0x11, 0x11, 0x11, 0x15, 0x15, 0x15, 0x0a},
+	'X':  {0x11, 0x11, 0x0a, 0x04, 0x0a, 0x11, 0x11},
+	'Y':  {0x11, 0x11, 0x11, 0x0a, 0x04, 0x04, 0x04},
+	'Z':  {0x1f, 0x01, 0x02, 0x04, 0x08, 0x10, 0x1f},
+	'_':  {0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1f},
+}
+
+// LEDText converts a text to the dots of a 5x7 dot matrix font for RenderLEDMatrix, with a blank column between
+// characters and a blank row between lines. Lowercase letters are drawn as uppercase, and lines are padded to the
+// widest
+func LEDText(text string) ([][]bool, error) {
+	lines := strings.Split(text, "\n")
+	width := 0
+	for _, line := range lines {
+		width = maxInt(width, len([]rune(line))*(ledGlyphWidth+1)-1)
+	}
+
+	dots := make([][]bool, 0, len(lines)*(ledGlyphHeight+1)-1)
+	for n, line := range lines {
+		if n > 0 {
+			dots = append(dots, make([]bool, width))
+		}
+
+		rows := make([][]bool, ledGlyphHeight)
+		for i := range rows {
+			rows[i] = make([]bool, width)
+		}
+		for i, r := range []rune(line) {
+			glyph, ok := ledGlyphs[unicode.ToUpper(r)]
+			if !ok {
+				return nil, fmt.Errorf("%w: character %q without a glyph", errInvalidValue, r)
+			}
+			for row, bits := range glyph {
+				for column := 0; column < ledGlyphWidth; column++ {
+					rows[row][i*(ledGlyphWidth+1)+column] = bits&(1<<(ledGlyphWidth-1-column)) != 0
+				}
+			}
+		}
+		dots = append(dots, rows...)
+	}
+	return dots, nil
+}
+
+// RenderLEDMatrix simulates a dot matrix display, such as a scoreboard, with a round LED per cell that is lit for
+// true dots. Unlit LEDs are dimmed towards the background and lit ones glow, and the image is sized for square
+// cells unless its dimensions are set
+func RenderLEDMatrix(dots [][]bool, ledMatrixConfigs ...LEDMatrixConfig) (*Gridder, error) {
+	ledMatrixConfig := getFirstLEDMatrixConfig(ledMatrixConfigs...)
+	if len(dots) == 0 {
+		return nil, errNoRows
+	}
+
+	rows, columns := len(dots), 0
+	for _, row := range dots {
+		columns = maxInt(columns, len(row))
+	}
+	if columns == 0 {
+		return nil, errNoColumns
+	}
+
+	imageConfig := ledMatrixConfig.ImageConfig
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultLEDMatrixCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = rows * defaultLEDMatrixCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            rows,
+		Columns:         columns,
+		LineColor:       color.Transparent,
+		BorderColor:     color.Transparent,
+		BackgroundColor: ledMatrixConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	onColor := ledMatrixConfig.GetOnColor()
+	offColor := LinearColormap(ledMatrixConfig.GetBackgroundColor(), onColor)(ledMatrixConfig.GetOffBrightness())
+	lit := func(row int, column int) bool {
+		return column < len(dots[row]) && dots[row][column]
+	}
+
+	eachLED := func(on bool, draw func(center *gg.Point, radius float64)) {
+		for row := 0; row < rows; row++ {
+			for column := 0; column < columns; column++ {
+				if lit(row, column) != on {
+					continue
+				}
+				width, height := g.getCellDimensions(row, column)
+				draw(g.getCellCenter(row, column), math.Min(width, height)/2*ledMatrixConfig.GetSize())
+			}
+		}
+	}
+
+	err = g.retain(func() error {
+		// glows spill over the unlit neighbors, but are covered by the lit LEDs
+		eachLED(false, func(center *gg.Point, radius float64) {
+			g.drawLED(center, radius, offColor)
+		})
+		eachLED(true, func(center *gg.Point, radius float64) {
+			g.drawLEDGlow(center, radius, ledMatrixConfig.GetGlow(), onColor)
+		})
+		eachLED(true, func(center *gg.Point, radius float64) {
+			g.drawLED(center, radius, onColor)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// drawLED fills the disc of an LED
+func (g *Gridder) drawLED(center *gg.Point, radius float64, fill color.Color) {
+	g.ctx.Push()
+	defer g.ctx.Pop()
+	g.ctx.DrawCircle(center.X, center.Y, radius)
+	g.ctx.SetColor(fill)
+	g.ctx.Fill()
+}
+
+// drawLEDGlow fills a ring around an LED, fading out from half the opacity of its color at the LED edge over the
+// glow width relative to the radius
+func (g *Gridder) drawLEDGlow(center *gg.Point, radius float64, glow float64, onColor color.Color) {
+	if glow <= 0 {
+		return
+	}
+
+	inner := color.NRGBAModel.Convert(onColor).(color.NRGBA)
+	outer := inner
+	inner.A /= 2
+	outer.A = 0
+	gradient := gg.NewRadialGradient(center.X, center.Y, radius, center.X, center.Y, radius*(1+glow))
+	gradient.AddColorStop(0, inner)
+	gradient.AddColorStop(1, outer)
+
+	g.ctx.Push()
+	defer g.ctx.Pop()
+	g.ctx.DrawCircle(center.X, center.Y, radius*(1+glow))
+	g.ctx.SetFillStyle(gradient)
+	g.ctx.Fill()
+}
@@ -0,0 +1,60 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLEDText(t *testing.T) {
+	dots, err := LEDText("1")
+	assert.Nil(t, err)
+	assert.Equal(t, len(dots), 7)
+	assert.Equal(t, dots[0], []bool{false, false, true, false, false})
+	assert.Equal(t, dots[6], []bool{false, true, true, true, false})
+
+	dots, _ = LEDText("-a")
+	assert.Equal(t, len(dots[0]), 11)
+	assert.Equal(t, dots[3][:6], []bool{true, true, true, true, true, false})
+	assert.Equal(t, dots[4][6:], []bool{true, true, true, true, true})
+
+	dots, _ = LEDText("HI\n1")
+	assert.Equal(t, len(dots), 15)
+	assert.Equal(t, len(dots[7]), 11)
+	assert.Equal(t, dots[7], make([]bool, 11))
+	assert.Equal(t, dots[8][2], true)
+
+	_, err = LEDText("é")
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestRenderLEDMatrix(t *testing.T) {
+	gridder, err := RenderLEDMatrix([][]bool{{true, false, false}, {false}})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 36)
+	assert.Equal(t, gridder.ctx.Height(), 24)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(6, 6)), defaultLEDMatrixOnColor)
+	off := color.NRGBAModel.Convert(LinearColormap(defaultLEDMatrixBackgroundColor, defaultLEDMatrixOnColor)(defaultLEDMatrixOffBrightness))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 6)), off)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(18, 18)), off)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(35, 23)), color.NRGBAModel.Convert(defaultLEDMatrixBackgroundColor))
+
+	// the glow around the lit LED
+	glow := color.NRGBAModel.Convert(img.At(6, 11)).(color.NRGBA)
+	assert.True(t, glow.R > 0 && glow.R < 255)
+
+	gridder, _ = RenderLEDMatrix([][]bool{{true}}, LEDMatrixConfig{ImageConfig: ImageConfig{Width: 12, Height: 12}, Glow: -1, OffBrightness: -1})
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(6, 11)), color.NRGBAModel.Convert(defaultLEDMatrixBackgroundColor))
+}
+
+func TestRenderLEDMatrixErrors(t *testing.T) {
+	_, err := RenderLEDMatrix(nil)
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = RenderLEDMatrix([][]bool{{}})
+	assert.True(t, errors.Is(err, errNoColumns))
+}
@@ -0,0 +1,60 @@
+package gridder
+
+import "fmt"
+
+func (g *RenderLimits) validate(imageWidth int, imageHeight int) error {
+	err := validateValues(
+		nonNegative("max pixels", float64(g.MaxPixels)),
+		nonNegative("max operations", float64(g.MaxOperations)),
+		nonNegative("max text length", float64(g.MaxTextLength)),
+		nonNegative("max cells", float64(g.MaxCells)),
+	)
+	if err != nil {
+		return err
+	}
+
+	pixels := int64(imageWidth) * int64(imageHeight)
+	if g.MaxPixels > 0 && pixels > int64(g.MaxPixels) {
+		return fmt.Errorf("%w: image of %dx%d pixels exceeds %d pixels", errLimitExceeded, imageWidth, imageHeight, g.MaxPixels)
+	}
+	return nil
+}
+
+// verifyCells fails when a grid of rows and columns has more cells than the limit, zero for no limit
+func verifyCells(rows int, columns int, maxCells int) error {
+	if maxCells > 0 && int64(rows)*int64(columns) > int64(maxCells) {
+		return fmt.Errorf("%w: %dx%d cells exceed %d cells", errLimitExceeded, rows, columns, maxCells)
+	}
+	return nil
+}
+
+// verifyOperationCount fails when retaining a number of operations more would exceed the maximum number of
+// operations of the grid and its overlay
+func (g *Gridder) verifyOperationCount(added int) error {
+	root := g
+	if g.parent != nil {
+		root = g.parent
+	}
+
+	maxOperations := root.imageConfig.Limits.GetMaxOperations()
+	if maxOperations == 0 {
+		return nil
+	}
+
+	count := root.operations.len()
+	if root.overlay != nil {
+		count += root.overlay.operations.len()
+	}
+	if count+added > maxOperations {
+		return fmt.Errorf("%w: more than %d operations", errLimitExceeded, maxOperations)
+	}
+	return nil
+}
+
+func (g *Gridder) verifyTextLength(text string) error {
+	maxTextLength := g.imageConfig.Limits.GetMaxTextLength()
+	if maxTextLength > 0 && len(text) > maxTextLength {
+		return fmt.Errorf("%w: text of %d bytes exceeds %d bytes", errLimitExceeded, len(text), maxTextLength)
+	}
+	return nil
+}
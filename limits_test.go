@@ -0,0 +1,82 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderLimitsPixels(t *testing.T) {
+	_, err := New(ImageConfig{Width: 100, Height: 100, Limits: RenderLimits{MaxPixels: 9999}}, GridConfig{Rows: 1, Columns: 1})
+	assert.True(t, errors.Is(err, errLimitExceeded))
+	assert.Equal(t, err.Error(), "render limit exceeded: image of 100x100 pixels exceeds 9999 pixels")
+
+	_, err = New(ImageConfig{Width: 100, Height: 100, Limits: RenderLimits{MaxPixels: 10000}}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	_, err = New(ImageConfig{Limits: RenderLimits{MaxTextLength: -1}}, GridConfig{Rows: 1, Columns: 1})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestRenderLimitsOperations(t *testing.T) {
+	gridder, err := New(ImageConfig{Limits: RenderLimits{MaxOperations: 3}}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	assert.Nil(t, gridder.DrawDiagram("A1: rect; B1: circle", nil))
+	assert.Nil(t, gridder.Overlay().DrawCircle(1, 1))
+
+	err = gridder.PaintCell(1, 1, color.Black)
+	assert.True(t, errors.Is(err, errLimitExceeded))
+	err = gridder.Overlay().PaintCell(1, 1, color.Black)
+	assert.True(t, errors.Is(err, errLimitExceeded))
+
+	gridder.ClearOverlay()
+	assert.Nil(t, gridder.PaintCell(1, 1, color.Black))
+	assert.Nil(t, gridder.InsertRow(0))
+}
+
+func TestRenderLimitsCopyCell(t *testing.T) {
+	gridder, err := New(ImageConfig{Limits: RenderLimits{MaxOperations: 4}}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	assert.Nil(t, gridder.DrawCircle(0, 0))
+	assert.Nil(t, gridder.CopyCell(0, 0, 0, 1))
+
+	err = gridder.CopyCell(0, 0, 1, 0)
+	assert.True(t, errors.Is(err, errLimitExceeded))
+	assert.Equal(t, gridder.operations.len(), 4)
+}
+
+func TestRenderLimitsText(t *testing.T) {
+	gridder, err := New(ImageConfig{Limits: RenderLimits{MaxTextLength: 4}}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	fontFace := testFontFace()
+	assert.Nil(t, gridder.DrawString(0, 0, "four", fontFace))
+	err = gridder.DrawString(0, 0, "fives", fontFace)
+	assert.True(t, errors.Is(err, errLimitExceeded))
+	err = gridder.DrawStrings([][]string{{"a", strings.Repeat("b", 5)}}, fontFace)
+	assert.True(t, errors.Is(err, errLimitExceeded))
+	err = gridder.DrawDiagram("A1: text 'labels'", fontFace)
+	assert.True(t, errors.Is(err, errLimitExceeded))
+}
+
+func TestRenderLimitsCells(t *testing.T) {
+	_, err := New(ImageConfig{Limits: RenderLimits{MaxCells: 5}}, GridConfig{Rows: 2, Columns: 3})
+	assert.True(t, errors.Is(err, errLimitExceeded))
+	assert.Equal(t, err.Error(), "render limit exceeded: 2x3 cells exceed 5 cells")
+
+	gridder, err := New(ImageConfig{Limits: RenderLimits{MaxCells: 6}}, GridConfig{Rows: 2, Columns: 3})
+	assert.Nil(t, err)
+	err = gridder.InsertRow(0)
+	assert.True(t, errors.Is(err, errLimitExceeded))
+	assert.Equal(t, gridder.GridConfig().Rows, 2)
+
+	_, err = New(ImageConfig{Limits: RenderLimits{MaxCells: -1}}, GridConfig{Rows: 1, Columns: 1})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
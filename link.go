@@ -0,0 +1,99 @@
+package gridder
+
+import (
+	"sort"
+)
+
+// cellRegion is the area of a cell in the rendered image, with the link or the text attached to the cell
+type cellRegion struct {
+	cell   Cell
+	x      float64
+	y      float64
+	width  float64
+	height float64
+	text   string
+}
+
+// SetLink sets the hyperlink of a cell, which becomes a clickable link annotation in SVG and PDF output. An empty
+// URL clears it
+func (g *Gridder) SetLink(row int, column int, url string) error {
+	if g.parent != nil {
+		return g.parent.SetLink(row, column, url)
+	}
+
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	cell := Cell{Row: row, Column: column}
+	if url == "" {
+		delete(g.links, cell)
+		return nil
+	}
+
+	if g.links == nil {
+		g.links = make(map[Cell]string)
+	}
+	g.links[cell] = url
+	return nil
+}
+
+// GetLink gets the hyperlink of a cell
+func (g *Gridder) GetLink(row int, column int) (string, error) {
+	if g.parent != nil {
+		return g.parent.GetLink(row, column)
+	}
+
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return "", err
+	}
+	return g.links[Cell{Row: row, Column: column}], nil
+}
+
+// ClearLinks clears the hyperlinks of all cells
+func (g *Gridder) ClearLinks() {
+	if g.parent != nil {
+		g.parent.ClearLinks()
+		return
+	}
+	g.links = nil
+}
+
+// linkRegions gets the areas of the linked cells in the rendered image in row and column order
+func (g *Gridder) linkRegions() []cellRegion {
+	return g.cellRegions(g.links)
+}
+
+// cellRegions gets the areas of cells with a text in the rendered image in row and column order, taking the margin
+// and flips into account
+func (g *Gridder) cellRegions(texts map[Cell]string) []cellRegion {
+	cells := make([]Cell, 0, len(texts))
+	for cell := range texts {
+		cells = append(cells, cell)
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Row != cells[j].Row {
+			return cells[i].Row < cells[j].Row
+		}
+		return cells[i].Column < cells[j].Column
+	})
+
+	margin := float64(g.gridConfig.GetMarginWidth())
+	imageWidth, imageHeight := float64(g.imageConfig.GetWidth()), float64(g.imageConfig.GetHeight())
+	regions := make([]cellRegion, len(cells))
+	for i, cell := range cells {
+		center := g.getCellCenter(cell.Row, cell.Column)
+		width, height := g.getCellDimensions(cell.Row, cell.Column)
+		x, y := margin+center.X-width/2, margin+center.Y-height/2
+		if g.flipHorizontal {
+			x = imageWidth - x - width
+		}
+		if g.flipVertical {
+			y = imageHeight - y - height
+		}
+		regions[i] = cellRegion{cell: cell, x: x, y: y, width: width, height: height, text: texts[cell]}
+	}
+	return regions
+}
@@ -0,0 +1,76 @@
+package gridder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLink(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.SetLink(1, 2, "https://example.com"))
+	link, err := gridder.GetLink(1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, link, "https://example.com")
+
+	link, err = gridder.Overlay().GetLink(1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, link, "https://example.com")
+
+	assert.Nil(t, gridder.SetLink(1, 2, ""))
+	link, _ = gridder.GetLink(1, 2)
+	assert.Equal(t, link, "")
+
+	err = gridder.SetLink(2, 0, "https://example.com")
+	assert.True(t, errors.Is(err, errOutOfBounds))
+	_, err = gridder.GetLink(0, 4)
+	assert.True(t, errors.Is(err, errOutOfBounds))
+
+	assert.Nil(t, gridder.SetLink(0, 0, "a"))
+	gridder.ClearLinks()
+	link, _ = gridder.GetLink(0, 0)
+	assert.Equal(t, link, "")
+}
+
+func TestLinkRestructure(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetLink(0, 1, "a"))
+
+	assert.Nil(t, gridder.InsertColumn(0))
+	link, _ := gridder.GetLink(0, 2)
+	assert.Equal(t, link, "a")
+
+	assert.Nil(t, gridder.MoveCell(0, 2, 1, 3))
+	link, _ = gridder.GetLink(1, 3)
+	assert.Equal(t, link, "a")
+
+	assert.Nil(t, gridder.CopyCell(1, 3, 0, 0))
+	link, _ = gridder.GetLink(0, 0)
+	assert.Equal(t, link, "a")
+
+	assert.Nil(t, gridder.DeleteRow(1))
+	assert.Equal(t, gridder.links, map[Cell]string{{Row: 0, Column: 0}: "a"})
+}
+
+func TestLinkRegions(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 220, Height: 120}, GridConfig{Rows: 2, Columns: 4, MarginWidth: 10})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetLink(1, 0, "b"))
+	assert.Nil(t, gridder.SetLink(0, 3, "a"))
+
+	assert.Equal(t, gridder.linkRegions(), []cellRegion{
+		{cell: Cell{Row: 0, Column: 3}, x: 160, y: 10, width: 50, height: 50, text: "a"},
+		{cell: Cell{Row: 1, Column: 0}, x: 10, y: 60, width: 50, height: 50, text: "b"},
+	})
+
+	gridder.FlipHorizontal()
+	gridder.FlipVertical()
+	assert.Equal(t, gridder.linkRegions(), []cellRegion{
+		{cell: Cell{Row: 0, Column: 3}, x: 10, y: 60, width: 50, height: 50, text: "a"},
+		{cell: Cell{Row: 1, Column: 0}, x: 160, y: 10, width: 50, height: 50, text: "b"},
+	})
+}
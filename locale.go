@@ -0,0 +1,193 @@
+package gridder
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"golang.org/x/image/font"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// calendarNames holds localized month and weekday names, starting with January and Sunday. Months are in the form
+// used next to a day of the month, and standalone months, when a language has them, in the form used alone, such as
+// in calendar headers
+type calendarNames struct {
+	months      [12]string
+	shortMonths [12]string
+	days        [7]string
+	shortDays   [7]string
+
+	standaloneMonths      [12]string
+	standaloneShortMonths [12]string
+}
+
+var localizedCalendarNames = map[string]calendarNames{
+	"en": {
+		months:      [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		shortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		days:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		shortDays:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	},
+	"de": {
+		months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortMonths: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		days:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		shortDays:   [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	},
+	"fr": {
+		months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		days:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		shortDays:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	},
+	"es": {
+		months:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		shortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		days:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		shortDays:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	},
+	"it": {
+		months:      [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		shortMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		days:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		shortDays:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+	},
+	"pt": {
+		months:      [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		shortMonths: [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		days:        [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		shortDays:   [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+	},
+	"nl": {
+		months:      [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		shortMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		days:        [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		shortDays:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+	},
+	"ru": {
+		months:      [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		shortMonths: [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		days:        [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		shortDays:   [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+
+		standaloneMonths:      [12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+		standaloneShortMonths: [12]string{"янв.", "февр.", "март", "апр.", "май", "июнь", "июль", "авг.", "сент.", "окт.", "нояб.", "дек."},
+	},
+}
+
+// SetLocale sets the locale used to format numeric and date labels
+func (g *Gridder) SetLocale(locale language.Tag) {
+	g.locale = locale
+}
+
+// DrawDate formats a time with a Go reference layout, localizing month and weekday names, and draws it in a cell
+func (g *Gridder) DrawDate(row int, column int, t time.Time, layout string, fontFace font.Face, stringConfigs ...StringConfig) error {
+	return g.DrawString(row, column, FormatDate(g.locale, t, layout), fontFace, stringConfigs...)
+}
+
+// FormatDate formats a time with a Go reference layout, using the month and weekday names of a locale.
+// Months take their standalone form, such as "май" instead of "мая" in Russian, in layouts without a day of the
+// month. Locales without localized names fall back to English
+func FormatDate(locale language.Tag, t time.Time, layout string) string {
+	base, _ := locale.Base()
+	names, ok := localizedCalendarNames[base.String()]
+	if !ok {
+		names = localizedCalendarNames["en"]
+	}
+	if names.standaloneMonths[0] != "" && !hasDayOfMonth(layout) {
+		names.months, names.shortMonths = names.standaloneMonths, names.standaloneShortMonths
+	}
+
+	var builder strings.Builder
+	for layout != "" {
+		index, token := nextCalendarToken(layout)
+		if index < 0 {
+			builder.WriteString(t.Format(layout))
+			break
+		}
+
+		builder.WriteString(t.Format(layout[:index]))
+		switch token {
+		case "January":
+			builder.WriteString(names.months[t.Month()-1])
+		case "Jan":
+			builder.WriteString(names.shortMonths[t.Month()-1])
+		case "Monday":
+			builder.WriteString(names.days[t.Weekday()])
+		case "Mon":
+			builder.WriteString(names.shortDays[t.Weekday()])
+		}
+		layout = layout[index+len(token):]
+	}
+	return builder.String()
+}
+
+// FormatLocale formats a value using the separators of a locale.
+// Digits are grouped only when a thousands separator is configured
+func (f *NumberFormat) FormatLocale(locale language.Tag, value float64) string {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return f.Format(value)
+	}
+
+	var options []number.Option
+	if precision := f.GetPrecision(); precision >= 0 {
+		options = append(options, number.MinFractionDigits(precision), number.MaxFractionDigits(precision))
+	}
+	if f.GetThousandsSeparator() == "" {
+		options = append(options, number.NoSeparator())
+	}
+
+	printer := message.NewPrinter(locale)
+	switch {
+	case f.IsScientific():
+		return printer.Sprint(number.Scientific(value, options...))
+	case f.IsPercent():
+		return printer.Sprint(number.Percent(value, options...))
+	default:
+		return printer.Sprint(number.Decimal(value, options...))
+	}
+}
+
+func (g *Gridder) formatNumber(format NumberFormat, value float64) string {
+	if g.locale == language.Und {
+		return format.Format(value)
+	}
+	return format.FormatLocale(g.locale, value)
+}
+
+// hasDayOfMonth reports whether a layout formats the day of the month, by formatting two days of a month with the
+// weekday names left out
+func hasDayOfMonth(layout string) bool {
+	var builder strings.Builder
+	for layout != "" {
+		index, token := nextCalendarToken(layout)
+		if index < 0 {
+			builder.WriteString(layout)
+			break
+		}
+
+		builder.WriteString(layout[:index])
+		if token == "January" || token == "Jan" {
+			builder.WriteString(token)
+		}
+		layout = layout[index+len(token):]
+	}
+
+	dateLayout := builder.String()
+	first := time.Date(2001, time.March, 1, 0, 0, 0, 0, time.UTC)
+	return first.Format(dateLayout) != first.AddDate(0, 0, 1).Format(dateLayout)
+}
+
+func nextCalendarToken(layout string) (int, string) {
+	for i := range layout {
+		for _, token := range []string{"January", "Jan", "Monday", "Mon"} {
+			if strings.HasPrefix(layout[i:], token) {
+				return i, token
+			}
+		}
+	}
+	return -1, ""
+}
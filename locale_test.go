@@ -0,0 +1,58 @@
+package gridder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/text/language"
+)
+
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2021, time.March, 7, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, FormatDate(language.English, date, "Monday, 2 January 2006"), "Sunday, 7 March 2021")
+	assert.Equal(t, FormatDate(language.German, date, "Monday, 2. January 2006"), "Sonntag, 7. März 2021")
+	assert.Equal(t, FormatDate(language.French, date, "Mon 2 Jan"), "dim. 7 mars")
+	assert.Equal(t, FormatDate(language.Japanese, date, "Jan 02"), "Mar 07")
+	assert.Equal(t, FormatDate(language.Und, date, "2006-01-02"), "2021-03-07")
+}
+
+func TestFormatDateStandaloneMonths(t *testing.T) {
+	date := time.Date(2021, time.May, 7, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, FormatDate(language.Russian, date, "January 2006"), "май 2021")
+	assert.Equal(t, FormatDate(language.Russian, date, "Jan"), "май")
+	assert.Equal(t, FormatDate(language.Russian, date, "Monday, January"), "пятница, май")
+	assert.Equal(t, FormatDate(language.Russian, date, "2 January 2006"), "7 мая 2021")
+	assert.Equal(t, FormatDate(language.Russian, date, "Mon 02 Jan"), "пт 07 мая")
+}
+
+func TestFormatLocale(t *testing.T) {
+	format1 := &NumberFormat{Precision: 2, ThousandsSeparator: ","}
+	assert.Equal(t, format1.FormatLocale(language.English, 1234567.891), "1,234,567.89")
+	assert.Equal(t, format1.FormatLocale(language.German, 1234567.891), "1.234.567,89")
+
+	format2 := &NumberFormat{Precision: 1}
+	assert.Equal(t, format2.FormatLocale(language.German, 1234.56), "1234,6")
+
+	format3 := &NumberFormat{Percent: true}
+	assert.Equal(t, format3.FormatLocale(language.English, 0.25), "25%")
+}
+
+func TestDrawDate(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 24})
+
+	gridder.SetLocale(language.Spanish)
+	assert.Equal(t, gridder.formatNumber(NumberFormat{Precision: 1}, 1.5), "1,5")
+
+	err = gridder.DrawDate(1, 1, time.Now(), "Jan", fontFace)
+	assert.NotNil(t, err)
+
+	err = gridder.DrawDate(0, 0, time.Now(), "Jan", fontFace)
+	assert.Nil(t, err)
+}
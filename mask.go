@@ -0,0 +1,120 @@
+package gridder
+
+// keepCell is a cell mapping that keeps every cell in place
+func keepCell(cell Cell) (Cell, bool) {
+	return cell, true
+}
+
+// remapCellMask moves the cell mask of a grid configuration with a cell mapping, cells without a mapped cell are
+// valid
+func remapCellMask(gridConfig *GridConfig, mapping cellMapping) {
+	if gridConfig.CellMask == nil {
+		return
+	}
+
+	rows, columns := gridConfig.GetRows(), gridConfig.GetColumns()
+	mask := make([][]bool, rows)
+	for row := range mask {
+		mask[row] = make([]bool, columns)
+		for column := range mask[row] {
+			mask[row][column] = true
+		}
+	}
+
+	for row := range gridConfig.CellMask {
+		for column, valid := range gridConfig.CellMask[row] {
+			cell, ok := mapping(Cell{Row: row, Column: column})
+			if ok && cell.Row >= 0 && cell.Row < rows && cell.Column >= 0 && cell.Column < columns {
+				mask[cell.Row][cell.Column] = valid
+			}
+		}
+	}
+	gridConfig.CellMask = mask
+}
+
+// hidesMaskedCells determines if the background, the lines and the border are left out of masked cells
+func (g *Gridder) hidesMaskedCells() bool {
+	return g.gridConfig.HideMaskedCells && g.gridConfig.CellMask != nil
+}
+
+// isValidCell determines if a cell is in the grid and not masked
+func (g *Gridder) isValidCell(row int, column int) bool {
+	return row >= 0 && row < g.gridConfig.GetRows() && column >= 0 && column < g.gridConfig.GetColumns() && !g.gridConfig.IsMasked(row, column)
+}
+
+// paintMaskedBackground paints the background of the valid cells only, leaving the rest transparent
+func (g *Gridder) paintMaskedBackground() {
+	layout := g.getLayout()
+
+	g.ctx.Push()
+	g.ctx.SetColor(g.getBackgroundColor())
+	for row := range layout.rowEdges {
+		for column := range layout.columnEdges {
+			if !g.isValidCell(row, column) {
+				continue
+			}
+			x, y := edgeStart(layout.columnEdges, column), edgeStart(layout.rowEdges, row)
+			g.ctx.DrawRectangle(x, y, layout.columnEdges[column]-x, layout.rowEdges[row]-y)
+		}
+	}
+	g.ctx.Fill()
+	g.ctx.Pop()
+}
+
+// traceMaskedLines traces the lines of the grid along the sides of valid cells
+func (g *Gridder) traceMaskedLines() {
+	layout := g.getLayout()
+	columnEdges, rowEdges := g.getLineEdges()
+	for column, x := range columnEdges {
+		for row, y := range layout.rowEdges {
+			if g.isValidCell(row, column) || g.isValidCell(row, column+1) {
+				g.traceLine(x, edgeStart(layout.rowEdges, row), x, y)
+			}
+		}
+	}
+
+	for row, y := range rowEdges {
+		for column, x := range layout.columnEdges {
+			if g.isValidCell(row, column) || g.isValidCell(row+1, column) {
+				g.traceLine(edgeStart(layout.columnEdges, column), y, x, y)
+			}
+		}
+	}
+}
+
+// traceMaskedBorder traces the border of the grid along the sides of valid cells
+func (g *Gridder) traceMaskedBorder() {
+	layout := g.getLayout()
+	rows, columns := len(layout.rowEdges), len(layout.columnEdges)
+	right, bottom := layout.columnEdges[columns-1], layout.rowEdges[rows-1]
+	if g.gridConfig.OuterLines == OuterLinesFlush {
+		right, bottom = g.getGridDimensions()
+	}
+	for row, y := range layout.rowEdges {
+		top := edgeStart(layout.rowEdges, row)
+		if g.isValidCell(row, 0) {
+			g.traceLine(0, top, 0, y)
+		}
+		if g.isValidCell(row, columns-1) {
+			g.traceLine(right, top, right, y)
+		}
+	}
+
+	for column, x := range layout.columnEdges {
+		left := edgeStart(layout.columnEdges, column)
+		if g.isValidCell(0, column) {
+			g.traceLine(left, 0, x, 0)
+		}
+		if g.isValidCell(rows-1, column) {
+			g.traceLine(left, bottom, x, bottom)
+		}
+	}
+}
+
+// edgeStart gets the near edge of a cell along one axis
+func edgeStart(edges []float64, index int) float64 {
+	if index == 0 {
+		return 0
+	}
+	return edges[index-1]
+}
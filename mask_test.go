@@ -0,0 +1,95 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testMaskGridConfig(hide bool) GridConfig {
+	return GridConfig{
+		Rows:              3,
+		Columns:           3,
+		CellMask:          [][]bool{{true, false, true}, {true, true, true}, {true, true, true}},
+		HideMaskedCells:   hide,
+		LineColor:         color.Black,
+		BorderColor:       color.Black,
+		LineStrokeWidth:   2,
+		BorderStrokeWidth: 4,
+		BackgroundColor:   color.White,
+	}
+}
+
+func TestCellMask(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 300, Height: 300}, testMaskGridConfig(false))
+	assert.Nil(t, err)
+
+	err = gridder.PaintCell(0, 1, color.Black)
+	assert.True(t, errors.Is(err, errMaskedCell))
+	err = gridder.DrawPath(0, 0, 0, 1)
+	assert.True(t, errors.Is(err, errMaskedCell))
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(150, 50)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(150, 1)), color.NRGBAModel.Convert(color.Black))
+
+	_, ok := gridder.CellAt(150, 50)
+	assert.False(t, ok)
+	cell, ok := gridder.CellAt(250, 50)
+	assert.True(t, ok)
+	assert.Equal(t, cell, Cell{Row: 0, Column: 2})
+}
+
+func TestHideMaskedCells(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 300, Height: 300}, testMaskGridConfig(true))
+	assert.Nil(t, err)
+
+	img := gridder.image()
+	black := color.NRGBAModel.Convert(color.Black)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 50)), color.NRGBAModel.Convert(color.Transparent))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 1)), color.NRGBAModel.Convert(color.Transparent))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 50)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 1)), black)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(100, 50)), black)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 100)), black)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 200)), black)
+}
+
+func TestCellMaskRestructure(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 300, Height: 300}, testMaskGridConfig(false))
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.InsertRow(0))
+	assert.Equal(t, gridder.GridConfig().CellMask, [][]bool{{true, true, true}, {true, false, true}, {true, true, true}, {true, true, true}})
+
+	assert.Nil(t, gridder.AddColumn())
+	assert.Equal(t, gridder.GridConfig().CellMask[1], []bool{true, false, true, true})
+
+	assert.Nil(t, gridder.DeleteColumn(1))
+	assert.Equal(t, gridder.GridConfig().CellMask[1], []bool{true, true, true})
+
+	assert.Nil(t, gridder.AddRow())
+	assert.Equal(t, len(gridder.GridConfig().CellMask), 5)
+
+	gridConfig := gridder.GridConfig()
+	gridConfig.CellMask[0][0] = false
+	assert.False(t, gridder.GridConfig().IsMasked(0, 0))
+	assert.Nil(t, gridder.SetGridConfig(gridConfig))
+	assert.True(t, gridder.GridConfig().IsMasked(0, 0))
+
+	gridConfig.CellMask = gridConfig.CellMask[1:]
+	err = gridder.SetGridConfig(gridConfig)
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+}
+
+func TestGridConfigIsMasked(t *testing.T) {
+	gridConfig := GridConfig{Rows: 1, Columns: 2}
+	assert.False(t, gridConfig.IsMasked(0, 1))
+
+	gridConfig.CellMask = [][]bool{{true, false}}
+	assert.False(t, gridConfig.IsMasked(0, 0))
+	assert.True(t, gridConfig.IsMasked(0, 1))
+	assert.False(t, gridConfig.IsMasked(1, 1))
+}
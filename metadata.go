@@ -0,0 +1,368 @@
+package gridder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// pngHeaderLength is the length of the PNG signature and the IHDR chunk that text chunks are written after
+const pngHeaderLength = 8 + 25
+
+// jpegHeaderLength is the length of the JPEG start of image marker that the Exif segment is written after
+const jpegHeaderLength = 2
+
+// maxExifLength is the largest Exif data an APP1 segment holds, as its length includes the 2 bytes of the length
+const maxExifLength = 65535 - 2
+
+// maxExifText bounds the values of the Exif text tags, leaving the rest of the segment to the user comment
+const maxExifText = 4096
+
+// Exif field types and tags
+const (
+	exifASCII     = 2
+	exifLong      = 4
+	exifUndefined = 7
+
+	exifTagImageDescription = 0x010e
+	exifTagSoftware         = 0x0131
+	exifTagArtist           = 0x013b
+	exifTagCopyright        = 0x8298
+	exifTagExifIFD          = 0x8769
+	exifTagUserComment      = 0x9286
+)
+
+// exifTextTags maps the keys of the PNG keyword vocabulary to the Exif text tags they are also written to
+var exifTextTags = map[string]uint16{
+	"Description": exifTagImageDescription,
+	"Software":    exifTagSoftware,
+	"Author":      exifTagArtist,
+	"Copyright":   exifTagCopyright,
+}
+
+// SetMetadata attaches a key and value, such as the generator version, a scene hash or the author, which are
+// embedded in PNG output as text chunks and in JPEG output as an Exif segment. Keys are 1 to 79 printable Latin-1
+// characters, an empty value removes the key
+func (g *Gridder) SetMetadata(key string, value string) error {
+	if g.parent != nil {
+		return g.parent.SetMetadata(key, value)
+	}
+
+	err := validateMetadataKey(key)
+	if err != nil {
+		return err
+	}
+	if !utf8.ValidString(value) || bytes.IndexByte([]byte(value), 0) >= 0 {
+		return fmt.Errorf("%w: metadata value of %q", errInvalidValue, key)
+	}
+
+	if value == "" {
+		delete(g.metadata, key)
+		return nil
+	}
+
+	if g.metadata == nil {
+		g.metadata = make(map[string]string)
+	}
+	g.metadata[key] = value
+	return nil
+}
+
+// Metadata returns a copy of the attached metadata
+func (g *Gridder) Metadata() map[string]string {
+	if g.parent != nil {
+		return g.parent.Metadata()
+	}
+
+	metadata := make(map[string]string, len(g.metadata))
+	for key, value := range g.metadata {
+		metadata[key] = value
+	}
+	return metadata
+}
+
+func validateMetadataKey(key string) error {
+	if key == "" || len([]rune(key)) > 79 {
+		return fmt.Errorf("%w: metadata key %q", errInvalidValue, key)
+	}
+	for _, r := range key {
+		if r < 32 || (r > 126 && r < 161) || r > 255 {
+			return fmt.Errorf("%w: metadata key %q", errInvalidValue, key)
+		}
+	}
+	return nil
+}
+
+// metadataWriter returns a writer that embeds the metadata in the PNG written to w, after its header chunk
+func (g *Gridder) metadataWriter(w io.Writer) *segmentWriter {
+	if g.parent != nil {
+		return g.parent.metadataWriter(w)
+	}
+	return newPNGTextWriter(w, g.metadata)
+}
+
+// jpegMetadataWriter returns a writer that embeds the metadata in the JPEG written to w, after its start of image
+// marker
+func (g *Gridder) jpegMetadataWriter(w io.Writer) *segmentWriter {
+	if g.parent != nil {
+		return g.parent.jpegMetadataWriter(w)
+	}
+	return newJPEGExifWriter(w, g.metadata)
+}
+
+// segmentWriter inserts segments into an encoded image stream after a header of a fixed length
+type segmentWriter struct {
+	w            io.Writer
+	headerLength int
+	segments     []byte
+	header       []byte
+	done         bool
+}
+
+// newPNGTextWriter creates a writer inserting text chunks into a PNG stream. Values of Latin-1 characters are
+// written as tEXt chunks and other values as UTF-8 iTXt chunks, in key order
+func newPNGTextWriter(w io.Writer, metadata map[string]string) *segmentWriter {
+	return &segmentWriter{w: w, headerLength: pngHeaderLength, segments: pngTextChunks(metadata)}
+}
+
+// newJPEGExifWriter creates a writer inserting an APP1 Exif segment into a JPEG stream. Every key is written to the
+// user comment as a "key=value" line, in key order, and the Description, Author, Software and Copyright keys also to
+// the ImageDescription, Artist, Software and Copyright tags. Values longer than the segment are truncated
+func newJPEGExifWriter(w io.Writer, metadata map[string]string) *segmentWriter {
+	return &segmentWriter{w: w, headerLength: jpegHeaderLength, segments: jpegExifSegment(metadata)}
+}
+
+func (p *segmentWriter) Write(data []byte) (int, error) {
+	if p.done || len(p.segments) == 0 {
+		return p.w.Write(data)
+	}
+
+	n := minInt(len(data), p.headerLength-len(p.header))
+	p.header = append(p.header, data[:n]...)
+	if len(p.header) < p.headerLength {
+		return len(data), nil
+	}
+
+	p.done = true
+	_, err := p.w.Write(p.header)
+	if err != nil {
+		return 0, err
+	}
+	_, err = p.w.Write(p.segments)
+	if err != nil {
+		return 0, err
+	}
+	_, err = p.w.Write(data[n:])
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// Close writes a header that was too short to be followed by the segments
+func (p *segmentWriter) Close() error {
+	if p.done || len(p.header) == 0 {
+		return nil
+	}
+	p.done = true
+	_, err := p.w.Write(p.header)
+	return err
+}
+
+func sortedMetadataKeys(metadata map[string]string) []string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func pngTextChunks(metadata map[string]string) []byte {
+	var chunks bytes.Buffer
+	for _, key := range sortedMetadataKeys(metadata) {
+		latin1Key := latin1(key)
+		if value, ok := toLatin1(metadata[key]); ok {
+			writePNGChunk(&chunks, "tEXt", append(append(latin1Key, 0), value...))
+			continue
+		}
+
+		// keyword, null separator, no compression, no language tag and no translated keyword
+		data := append(latin1Key, 0, 0, 0, 0, 0)
+		writePNGChunk(&chunks, "iTXt", append(data, metadata[key]...))
+	}
+	return chunks.Bytes()
+}
+
+// exifEntry is a field of an Exif image file directory
+type exifEntry struct {
+	tag      uint16
+	dataType uint16
+	count    uint32
+	data     []byte
+}
+
+func jpegExifSegment(metadata map[string]string) []byte {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	var entries []exifEntry
+	lines := make([]string, 0, len(metadata))
+	for _, key := range sortedMetadataKeys(metadata) {
+		if tag, ok := exifTextTags[key]; ok {
+			text := append([]byte(truncateText(metadata[key], maxExifText)), 0)
+			entries = append(entries, exifEntry{tag: tag, dataType: exifASCII, count: uint32(len(text)), data: text})
+		}
+		lines = append(lines, key+"="+metadata[key])
+	}
+	sort.Slice(entries, func(i int, j int) bool {
+		return entries[i].tag < entries[j].tag
+	})
+	entries = append(entries, exifEntry{tag: exifTagExifIFD, dataType: exifLong, count: 1, data: make([]byte, 4)})
+
+	// the TIFF header, the first directory with its data, and the Exif directory holding only the user comment
+	exifOffset := 8 + exifIFDLength(entries)
+	binary.BigEndian.PutUint32(entries[len(entries)-1].data, uint32(exifOffset))
+	commentLength := (maxExifLength - 6 - exifOffset - exifIFDLength(make([]exifEntry, 1))) &^ 1
+	comment := exifUserComment(strings.Join(lines, "\n"), commentLength)
+
+	var segment bytes.Buffer
+	segment.Write([]byte{0xff, 0xe1, 0, 0})
+	segment.WriteString("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08")
+	writeExifIFD(&segment, 8, entries)
+	writeExifIFD(&segment, exifOffset, []exifEntry{
+		{tag: exifTagUserComment, dataType: exifUndefined, count: uint32(len(comment)), data: comment},
+	})
+
+	data := segment.Bytes()
+	binary.BigEndian.PutUint16(data[2:], uint16(len(data)-2))
+	return data
+}
+
+// exifIFDLength gets the length of a directory and the data of its fields that do not fit in them
+func exifIFDLength(entries []exifEntry) int {
+	length := 2 + 12*len(entries) + 4
+	for _, entry := range entries {
+		if len(entry.data) > 4 {
+			length += len(entry.data) + len(entry.data)%2
+		}
+	}
+	return length
+}
+
+// writeExifIFD writes a directory at an offset from the TIFF header, followed by the data of its fields that do not
+// fit in them
+func writeExifIFD(w *bytes.Buffer, offset int, entries []exifEntry) {
+	var buffer [4]byte
+	binary.BigEndian.PutUint16(buffer[:2], uint16(len(entries)))
+	w.Write(buffer[:2])
+
+	dataOffset := offset + 2 + 12*len(entries) + 4
+	for _, entry := range entries {
+		binary.BigEndian.PutUint16(buffer[:2], entry.tag)
+		binary.BigEndian.PutUint16(buffer[2:], entry.dataType)
+		w.Write(buffer[:])
+		binary.BigEndian.PutUint32(buffer[:], entry.count)
+		w.Write(buffer[:])
+
+		if len(entry.data) <= 4 {
+			value := [4]byte{}
+			copy(value[:], entry.data)
+			w.Write(value[:])
+			continue
+		}
+		binary.BigEndian.PutUint32(buffer[:], uint32(dataOffset))
+		w.Write(buffer[:])
+		dataOffset += len(entry.data) + len(entry.data)%2
+	}
+	w.Write([]byte{0, 0, 0, 0})
+
+	for _, entry := range entries {
+		if len(entry.data) > 4 {
+			w.Write(entry.data)
+			if len(entry.data)%2 == 1 {
+				w.WriteByte(0)
+			}
+		}
+	}
+}
+
+// exifUserComment encodes a comment of at most a length as ASCII, or as big endian UTF-16 when it has other
+// characters, after the 8 bytes of its character code
+func exifUserComment(comment string, length int) []byte {
+	ascii := true
+	for i := 0; i < len(comment); i++ {
+		if comment[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return append([]byte("ASCII\x00\x00\x00"), truncateText(comment, length-8)...)
+	}
+
+	units := utf16.Encode([]rune(comment))
+	if len(units) > (length-8)/2 {
+		units = units[:(length-8)/2]
+		if utf16.IsSurrogate(rune(units[len(units)-1])) && units[len(units)-1] < 0xdc00 {
+			units = units[:len(units)-1]
+		}
+	}
+
+	data := append(make([]byte, 0, 8+2*len(units)), "UNICODE\x00"...)
+	for _, unit := range units {
+		data = append(data, byte(unit>>8), byte(unit))
+	}
+	return data
+}
+
+// truncateText truncates a UTF-8 text to at most a length in bytes, without splitting characters
+func truncateText(text string, length int) string {
+	if len(text) <= length {
+		return text
+	}
+	for length > 0 && !utf8.RuneStart(text[length]) {
+		length--
+	}
+	return text[:length]
+}
+
+func writePNGChunk(w *bytes.Buffer, chunkType string, data []byte) {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+	w.Write(length[:])
+
+	crc := crc32.NewIEEE()
+	crc.Write([]byte(chunkType))
+	crc.Write(data)
+	w.WriteString(chunkType)
+	w.Write(data)
+
+	var checksum [4]byte
+	binary.BigEndian.PutUint32(checksum[:], crc.Sum32())
+	w.Write(checksum[:])
+}
+
+// toLatin1 converts a text to Latin-1, reporting false when it has other characters
+func toLatin1(text string) ([]byte, bool) {
+	converted := make([]byte, 0, len(text))
+	for _, r := range text {
+		if r > 255 {
+			return nil, false
+		}
+		converted = append(converted, byte(r))
+	}
+	return converted, true
+}
+
+func latin1(text string) []byte {
+	converted, _ := toLatin1(text)
+	return converted
+}
@@ -0,0 +1,207 @@
+package gridder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// readPNGChunks gets the type and data of the chunks of a PNG, verifying their checksums
+func readPNGChunks(t *testing.T, data []byte) ([]string, [][]byte) {
+	var types []string
+	var chunks [][]byte
+	data = data[8:]
+	for len(data) > 0 {
+		length := binary.BigEndian.Uint32(data)
+		chunkType, chunk := string(data[4:8]), data[8:8+length]
+		assert.Equal(t, binary.BigEndian.Uint32(data[8+length:]), crc32.ChecksumIEEE(data[4:8+length]))
+		types = append(types, chunkType)
+		chunks = append(chunks, chunk)
+		data = data[12+length:]
+	}
+	return types, chunks
+}
+
+func TestSetMetadata(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.SetMetadata("Author", "gridder"))
+	assert.Nil(t, gridder.Overlay().SetMetadata("Version", "1.0"))
+	assert.Equal(t, gridder.Metadata(), map[string]string{"Author": "gridder", "Version": "1.0"})
+
+	assert.Nil(t, gridder.SetMetadata("Version", ""))
+	metadata := gridder.Metadata()
+	metadata["Scene"] = "abc"
+	assert.Equal(t, gridder.Metadata(), map[string]string{"Author": "gridder"})
+
+	assert.True(t, errors.Is(gridder.SetMetadata("", "a"), errInvalidValue))
+	assert.True(t, errors.Is(gridder.SetMetadata(strings.Repeat("a", 80), "a"), errInvalidValue))
+	assert.True(t, errors.Is(gridder.SetMetadata("a\nb", "a"), errInvalidValue))
+	assert.True(t, errors.Is(gridder.SetMetadata("ключ", "a"), errInvalidValue))
+	assert.True(t, errors.Is(gridder.SetMetadata("a", "a\x00b"), errInvalidValue))
+}
+
+func TestEncodePNGMetadata(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	var plain bytes.Buffer
+	assert.Nil(t, gridder.EncodePNG(&plain))
+	var expected bytes.Buffer
+	assert.Nil(t, encodePNG(&expected, gridder.image()))
+	assert.Equal(t, plain.Bytes(), expected.Bytes())
+
+	assert.Nil(t, gridder.SetMetadata("Software", "gridder"))
+	assert.Nil(t, gridder.SetMetadata("Title", "café ☕"))
+	assert.Nil(t, gridder.SetMetadata("Author", "Zoë"))
+
+	for _, encode := range []func(buffer *bytes.Buffer) error{
+		func(buffer *bytes.Buffer) error { return gridder.EncodePNG(buffer) },
+		func(buffer *bytes.Buffer) error { return gridder.Encode(buffer, ".PNG") },
+	} {
+		var buffer bytes.Buffer
+		assert.Nil(t, encode(&buffer))
+
+		types, chunks := readPNGChunks(t, buffer.Bytes())
+		assert.Equal(t, types[:4], []string{"IHDR", "tEXt", "tEXt", "iTXt"})
+		assert.Equal(t, chunks[1], []byte("Author\x00Zo\xeb"))
+		assert.Equal(t, chunks[2], []byte("Software\x00gridder"))
+		assert.Equal(t, chunks[3], []byte("Title\x00\x00\x00\x00\x00café ☕"))
+
+		_, err = png.Decode(&buffer)
+		assert.Nil(t, err)
+	}
+}
+
+// readJPEGExif gets the fields of the Exif segment of a JPEG, following the Exif directory pointer, verifying the
+// segment lies before the first frame
+func readJPEGExif(t *testing.T, data []byte) map[uint16][]byte {
+	assert.Equal(t, data[:2], []byte{0xff, 0xd8})
+	fields := make(map[uint16][]byte)
+	data = data[2:]
+	for len(data) > 4 && data[0] == 0xff && data[1] != 0xc0 {
+		length := int(binary.BigEndian.Uint16(data[2:]))
+		if data[1] == 0xe1 {
+			assert.True(t, length <= 65535)
+			assert.Equal(t, string(data[4:10]), "Exif\x00\x00")
+			readExifIFD(t, data[10:2+length], 8, fields)
+		}
+		data = data[2+length:]
+	}
+	return fields
+}
+
+func readExifIFD(t *testing.T, tiff []byte, offset int, fields map[uint16][]byte) {
+	assert.Equal(t, tiff[:8], []byte("MM\x00\x2a\x00\x00\x00\x08"))
+	count := int(binary.BigEndian.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := tiff[offset+2+12*i:]
+		tag, length := binary.BigEndian.Uint16(entry), int(binary.BigEndian.Uint32(entry[4:]))
+		value := entry[8:12]
+		if length > 4 {
+			value = tiff[binary.BigEndian.Uint32(entry[8:]):][:length]
+		}
+		if tag == exifTagExifIFD {
+			readExifIFD(t, tiff, int(binary.BigEndian.Uint32(value)), fields)
+			continue
+		}
+		fields[tag] = value[:length]
+	}
+}
+
+func TestEncodeJPEGMetadata(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	var plain bytes.Buffer
+	assert.Nil(t, gridder.EncodeJPEG(&plain, 90))
+	assert.Empty(t, readJPEGExif(t, plain.Bytes()))
+
+	assert.Nil(t, gridder.SetMetadata("Software", "gridder"))
+	assert.Nil(t, gridder.SetMetadata("Title", "café ☕"))
+
+	for _, encode := range []func(buffer *bytes.Buffer) error{
+		func(buffer *bytes.Buffer) error { return gridder.EncodeJPEG(buffer, 90) },
+		func(buffer *bytes.Buffer) error { return gridder.Encode(buffer, ".JPG") },
+		func(buffer *bytes.Buffer) error { return gridder.Encode(buffer, "jpeg") },
+	} {
+		var buffer bytes.Buffer
+		assert.Nil(t, encode(&buffer))
+
+		fields := readJPEGExif(t, buffer.Bytes())
+		assert.Equal(t, fields[exifTagSoftware], []byte("gridder\x00"))
+		comment := utf16.Encode([]rune("Software=gridder\nTitle=café ☕"))
+		expected := []byte("UNICODE\x00")
+		for _, unit := range comment {
+			expected = append(expected, byte(unit>>8), byte(unit))
+		}
+		assert.Equal(t, fields[exifTagUserComment], expected)
+
+		_, err = jpeg.Decode(&buffer)
+		assert.Nil(t, err)
+	}
+}
+
+func TestJPEGExifSegment(t *testing.T) {
+	jpegExif := func(metadata map[string]string) map[uint16][]byte {
+		return readJPEGExif(t, append([]byte{0xff, 0xd8}, jpegExifSegment(metadata)...))
+	}
+
+	fields := jpegExif(map[string]string{"Author": "Zoe", "Copyright": "CC0", "Description": "A grid", "Scene": "abc"})
+	assert.Equal(t, fields[exifTagArtist], []byte("Zoe\x00"))
+	assert.Equal(t, fields[exifTagCopyright], []byte("CC0\x00"))
+	assert.Equal(t, fields[exifTagImageDescription], []byte("A grid\x00"))
+	assert.Equal(t, string(fields[exifTagUserComment]),
+		"ASCII\x00\x00\x00Author=Zoe\nCopyright=CC0\nDescription=A grid\nScene=abc")
+
+	fields = jpegExif(map[string]string{"a": strings.Repeat("a", maxExifLength)})
+	assert.True(t, strings.HasPrefix("ASCII\x00\x00\x00a="+strings.Repeat("a", maxExifLength), string(fields[exifTagUserComment])))
+
+	fields = jpegExif(map[string]string{"Author": strings.Repeat("é", maxExifLength)})
+	assert.True(t, len(fields[exifTagArtist]) <= maxExifText+1)
+	assert.True(t, utf8.Valid(fields[exifTagArtist][:len(fields[exifTagArtist])-1]))
+
+	fields = jpegExif(map[string]string{"b": strings.Repeat("😀", maxExifLength)})
+	comment := fields[exifTagUserComment][8:]
+	units := make([]uint16, len(comment)/2)
+	for i := range units {
+		units[i] = binary.BigEndian.Uint16(comment[2*i:])
+	}
+	assert.True(t, strings.HasSuffix(string(utf16.Decode(units)), "😀"))
+}
+
+func TestPNGTextWriter(t *testing.T) {
+	var expected bytes.Buffer
+	assert.Nil(t, encodePNG(&expected, image.NewNRGBA(image.Rect(0, 0, 4, 4))))
+
+	var buffer bytes.Buffer
+	writer := newPNGTextWriter(&buffer, map[string]string{"a": "b"})
+	for _, b := range expected.Bytes() {
+		n, err := writer.Write([]byte{b})
+		assert.Nil(t, err)
+		assert.Equal(t, n, 1)
+	}
+	assert.Nil(t, writer.Close())
+
+	types, _ := readPNGChunks(t, buffer.Bytes())
+	assert.Equal(t, types[:2], []string{"IHDR", "tEXt"})
+	assert.Equal(t, buffer.Len(), expected.Len()+12+3)
+
+	buffer.Reset()
+	writer = newPNGTextWriter(&buffer, map[string]string{"a": "b"})
+	_, err := writer.Write([]byte("short"))
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Close())
+	assert.Equal(t, buffer.String(), "short")
+}
@@ -0,0 +1,57 @@
+package gridder
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// replayMigrations upgrades stored replays, migration i turns a version i+1 document into a version i+2 one.
+// Changes to the replay format must append a migration, which also bumps the version written by ExportReplay
+var replayMigrations []func(document map[string]interface{}) error
+
+// replayVersion is the version of the replay format written by ExportReplay
+func replayVersion() int {
+	return len(replayMigrations) + 1
+}
+
+// migrateReplay upgrades a decoded replay document to the current version in place
+func migrateReplay(document map[string]interface{}) error {
+	number, ok := document["version"].(float64)
+	version := int(number)
+	if !ok || float64(version) != number || version < 1 {
+		return fmt.Errorf("%w: version %v", errInvalidReplay, document["version"])
+	}
+
+	if version > replayVersion() {
+		return fmt.Errorf("%w: version %d is newer than the supported version %d", errInvalidReplay, version, replayVersion())
+	}
+
+	for ; version < replayVersion(); version++ {
+		err := replayMigrations[version-1](document)
+		if err != nil {
+			return fmt.Errorf("%w: migrating version %d: %v", errInvalidReplay, version, err)
+		}
+	}
+	document["version"] = version
+	return nil
+}
+
+// decodeReplayDocument migrates a decoded replay document and converts it to a replay
+func decodeReplayDocument(document map[string]interface{}) (*Replay, error) {
+	err := migrateReplay(document)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	var replay Replay
+	err = json.Unmarshal(data, &replay)
+	if err != nil {
+		return nil, err
+	}
+	return &replay, nil
+}
@@ -0,0 +1,59 @@
+package gridder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gzipReplay(t *testing.T, document string) *bytes.Buffer {
+	buffer := new(bytes.Buffer)
+	writer := gzip.NewWriter(buffer)
+	_, err := writer.Write([]byte(document))
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Close())
+	return buffer
+}
+
+func TestMigrateReplay(t *testing.T) {
+	migrations := replayMigrations
+	defer func() { replayMigrations = migrations }()
+
+	replayMigrations = append(migrations[:len(migrations):len(migrations)], func(document map[string]interface{}) error {
+		size, ok := document["size"].([]interface{})
+		if !ok || len(size) != 2 {
+			return errors.New("no size")
+		}
+		document["width"], document["height"] = size[0], size[1]
+		delete(document, "size")
+		return nil
+	})
+	assert.Equal(t, replayVersion(), len(migrations)+2)
+
+	old := `{"version":` + strconv.Itoa(len(migrations)+1) + `,"size":[30,20],"grid":{"rows":1,"columns":1}}`
+	replay, err := LoadReplay(gzipReplay(t, old))
+	assert.Nil(t, err)
+	assert.Equal(t, replay.Version, replayVersion())
+	assert.Equal(t, replay.Width, 30)
+	assert.Equal(t, replay.Height, 20)
+
+	broken := `{"version":` + strconv.Itoa(len(migrations)+1) + `}`
+	_, err = LoadReplay(gzipReplay(t, broken))
+	assert.True(t, errors.Is(err, errInvalidReplay))
+}
+
+func TestReplayVersions(t *testing.T) {
+	for _, document := range []string{`{}`, `{"version":0}`, `{"version":1.5}`, `{"version":"1"}`, `{"version":99}`} {
+		_, err := LoadReplay(gzipReplay(t, document))
+		assert.True(t, errors.Is(err, errInvalidReplay), document)
+	}
+
+	replay, err := LoadReplay(gzipReplay(t, `{"version":1,"width":5,"height":5}`))
+	assert.Nil(t, err)
+	assert.Equal(t, replay.Version, replayVersion())
+	assert.Equal(t, replay.Width, 5)
+}
@@ -0,0 +1,96 @@
+package gridder
+
+var (
+	offsets4 = []Cell{{Row: -1, Column: 0}, {Row: 0, Column: 1}, {Row: 1, Column: 0}, {Row: 0, Column: -1}}
+	offsets8 = []Cell{
+		{Row: -1, Column: 0}, {Row: -1, Column: 1}, {Row: 0, Column: 1}, {Row: 1, Column: 1},
+		{Row: 1, Column: 0}, {Row: 1, Column: -1}, {Row: 0, Column: -1}, {Row: -1, Column: -1},
+	}
+	// hexOffsetsEven and hexOffsetsOdd are the hex neighbors of even and odd rows, with odd rows shifted right by
+	// half a cell
+	hexOffsetsEven = []Cell{
+		{Row: -1, Column: 0}, {Row: 0, Column: 1}, {Row: 1, Column: 0},
+		{Row: 1, Column: -1}, {Row: 0, Column: -1}, {Row: -1, Column: -1},
+	}
+	hexOffsetsOdd = []Cell{
+		{Row: -1, Column: 1}, {Row: 0, Column: 1}, {Row: 1, Column: 1},
+		{Row: 1, Column: 0}, {Row: 0, Column: -1}, {Row: -1, Column: 0},
+	}
+)
+
+// CellNeighborhood describes a cell together with its neighbors
+type CellNeighborhood struct {
+	Cell       Cell
+	Neighbors4 CellSet
+	Neighbors8 CellSet
+	// NeighborsHex are the neighbors of the cell in a hex layout, see NeighborsHex
+	NeighborsHex CellSet
+}
+
+// Neighbors4 gets the cells sharing an edge with a cell, clockwise starting from the top
+func (g *Gridder) Neighbors4(row int, column int) (CellSet, error) {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return nil, err
+	}
+	return g.neighbors(row, column, offsets4), nil
+}
+
+// Neighbors8 gets the cells sharing an edge or a corner with a cell, clockwise starting from the top
+func (g *Gridder) Neighbors8(row int, column int) (CellSet, error) {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return nil, err
+	}
+	return g.neighbors(row, column, offsets8), nil
+}
+
+// NeighborsHex gets the 6 cells next to a cell when the grid is read as pointy-top hexagons with odd rows shifted
+// right by half a cell, clockwise starting from the top right
+func (g *Gridder) NeighborsHex(row int, column int) (CellSet, error) {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return nil, err
+	}
+	return g.neighbors(row, column, hexOffsets(row)), nil
+}
+
+// EachCell calls visit for every cell in row-major order, stopping at the first error. Masked cells are not visited
+// and are not neighbors
+func (g *Gridder) EachCell(visit func(neighborhood CellNeighborhood) error) error {
+	for _, cell := range g.Cells() {
+		neighborhood := CellNeighborhood{
+			Cell:         cell,
+			Neighbors4:   g.neighbors(cell.Row, cell.Column, offsets4),
+			Neighbors8:   g.neighbors(cell.Row, cell.Column, offsets8),
+			NeighborsHex: g.neighbors(cell.Row, cell.Column, hexOffsets(cell.Row)),
+		}
+
+		err := visit(neighborhood)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hexOffsets(row int) []Cell {
+	if row%2 == 0 {
+		return hexOffsetsEven
+	}
+	return hexOffsetsOdd
+}
+
+func (g *Gridder) neighbors(row int, column int, offsets []Cell) CellSet {
+	rows, columns := g.gridConfig.GetRows(), g.gridConfig.GetColumns()
+	cells := make(CellSet, 0, len(offsets))
+	for _, offset := range offsets {
+		neighborRow, neighborColumn := row+offset.Row, column+offset.Column
+		if neighborRow < 0 || neighborRow >= rows || neighborColumn < 0 || neighborColumn >= columns ||
+			g.gridConfig.IsMasked(neighborRow, neighborColumn) {
+			continue
+		}
+		cells = append(cells, Cell{Row: neighborRow, Column: neighborColumn})
+	}
+	return cells
+}
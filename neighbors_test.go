@@ -0,0 +1,112 @@
+package gridder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNeighbors4(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 3, Columns: 3})
+	assert.Nil(t, err)
+
+	_, err = gridder.Neighbors4(3, 0)
+	assert.NotNil(t, err)
+
+	cells, err := gridder.Neighbors4(1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, cells, CellSet{{Row: 0, Column: 1}, {Row: 1, Column: 2}, {Row: 2, Column: 1}, {Row: 1, Column: 0}})
+
+	cells, err = gridder.Neighbors4(0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, cells, CellSet{{Row: 0, Column: 1}, {Row: 1, Column: 0}})
+}
+
+func TestNeighbors8(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 3, Columns: 3})
+	assert.Nil(t, err)
+
+	_, err = gridder.Neighbors8(0, -1)
+	assert.NotNil(t, err)
+
+	cells, err := gridder.Neighbors8(1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, len(cells), 8)
+
+	cells, err = gridder.Neighbors8(2, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, cells, CellSet{{Row: 1, Column: 2}, {Row: 2, Column: 1}, {Row: 1, Column: 1}})
+}
+
+func TestNeighborsHex(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+
+	_, err = gridder.NeighborsHex(4, 0)
+	assert.NotNil(t, err)
+
+	cells, err := gridder.NeighborsHex(2, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, cells, CellSet{
+		{Row: 1, Column: 1}, {Row: 2, Column: 2}, {Row: 3, Column: 1},
+		{Row: 3, Column: 0}, {Row: 2, Column: 0}, {Row: 1, Column: 0},
+	})
+
+	cells, err = gridder.NeighborsHex(1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, cells, CellSet{
+		{Row: 0, Column: 2}, {Row: 1, Column: 2}, {Row: 2, Column: 2},
+		{Row: 2, Column: 1}, {Row: 1, Column: 0}, {Row: 0, Column: 1},
+	})
+
+	cells, err = gridder.NeighborsHex(1, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, cells, CellSet{{Row: 2, Column: 3}, {Row: 1, Column: 2}, {Row: 0, Column: 3}})
+
+	cells, err = gridder.NeighborsHex(0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, cells, CellSet{{Row: 0, Column: 1}, {Row: 1, Column: 0}})
+}
+
+func TestEachCell(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	var visited int
+	err = gridder.EachCell(func(neighborhood CellNeighborhood) error {
+		visited++
+		assert.Equal(t, len(neighborhood.Neighbors4), 2)
+		assert.Equal(t, len(neighborhood.Neighbors8), 3)
+		expected, err := gridder.NeighborsHex(neighborhood.Cell.Row, neighborhood.Cell.Column)
+		assert.Nil(t, err)
+		assert.Equal(t, neighborhood.NeighborsHex, expected)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, visited, 4)
+
+	errStop := errors.New("stop")
+	err = gridder.EachCell(func(neighborhood CellNeighborhood) error {
+		return errStop
+	})
+	assert.Equal(t, err, errStop)
+}
+
+func TestNeighborsMasked(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 2, CellMask: [][]bool{{true, false}, {true, true}}})
+	assert.Nil(t, err)
+
+	cells, err := gridder.Neighbors4(0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, cells, CellSet{{Row: 1, Column: 0}})
+
+	var visited CellSet
+	err = gridder.EachCell(func(neighborhood CellNeighborhood) error {
+		visited = append(visited, neighborhood.Cell)
+		assert.NotContains(t, neighborhood.Neighbors8, Cell{Row: 0, Column: 1})
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, visited, CellSet{{Row: 0, Column: 0}, {Row: 1, Column: 0}, {Row: 1, Column: 1}})
+}
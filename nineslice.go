@@ -0,0 +1,93 @@
+package gridder
+
+import (
+	"image"
+	"image/draw"
+	"math"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+// DrawNineSlice draws an image over the region spanning two cells as a nine-slice, so that frames and panels
+// stretch to any size without distorting their corners
+func (g *Gridder) DrawNineSlice(row1 int, column1 int, row2 int, column2 int, img image.Image, nineSliceConfigs ...NineSliceConfig) error {
+	cells := []Cell{{Row: row1, Column: column1}, {Row: row2, Column: column2}}
+	return g.retainCells(cells, func(cells []Cell) error {
+		return g.drawNineSlice(cells[0].Row, cells[0].Column, cells[1].Row, cells[1].Column, img, nineSliceConfigs...)
+	})
+}
+
+func (g *Gridder) drawNineSlice(row1 int, column1 int, row2 int, column2 int, img image.Image, nineSliceConfigs ...NineSliceConfig) error {
+	err := g.verifyInBounds(row1, column1)
+	if err != nil {
+		return err
+	}
+
+	err = g.verifyInBounds(row2, column2)
+	if err != nil {
+		return err
+	}
+
+	nineSliceConfig := getFirstNineSliceConfig(nineSliceConfigs...)
+	err = nineSliceConfig.validate(img.Bounds())
+	if err != nil {
+		return err
+	}
+
+	minRow, maxRow := minInt(row1, row2), maxInt(row1, row2)
+	minColumn, maxColumn := minInt(column1, column2), maxInt(column1, column2)
+
+	topLeft := g.getCellCenter(minRow, minColumn)
+	width, height := g.getCellDimensions(minRow, minColumn)
+	x0, y0 := g.ctx.TransformPoint(topLeft.X-width/2, topLeft.Y-height/2)
+
+	bottomRight := g.getCellCenter(maxRow, maxColumn)
+	width, height = g.getCellDimensions(maxRow, maxColumn)
+	x1, y1 := g.ctx.TransformPoint(bottomRight.X+width/2, bottomRight.Y+height/2)
+
+	region := image.Rect(int(math.Round(x0)), int(math.Round(y0)), int(math.Round(x1)), int(math.Round(y1)))
+	paintNineSlice(g.ctx.Image().(draw.Image), region, img, nineSliceConfig)
+	return nil
+}
+
+// paintNineSlice scales the nine patches of an image into a region. Corners shrink evenly when the region is
+// smaller than them
+func paintNineSlice(dst draw.Image, region image.Rectangle, img image.Image, nineSliceConfig NineSliceConfig) {
+	bounds := img.Bounds()
+	scale := nineSliceConfig.GetScale()
+	left, right := nineSliceInsets(nineSliceConfig.GetLeft(), nineSliceConfig.GetRight(), scale, region.Dx())
+	top, bottom := nineSliceInsets(nineSliceConfig.GetTop(), nineSliceConfig.GetBottom(), scale, region.Dy())
+
+	xs := [4]int{region.Min.X, region.Min.X + left, region.Max.X - right, region.Max.X}
+	ys := [4]int{region.Min.Y, region.Min.Y + top, region.Max.Y - bottom, region.Max.Y}
+	srcXs := [4]int{bounds.Min.X, bounds.Min.X + nineSliceConfig.GetLeft(), bounds.Max.X - nineSliceConfig.GetRight(), bounds.Max.X}
+	srcYs := [4]int{bounds.Min.Y, bounds.Min.Y + nineSliceConfig.GetTop(), bounds.Max.Y - nineSliceConfig.GetBottom(), bounds.Max.Y}
+
+	var interpolator xdraw.Interpolator = xdraw.BiLinear
+	if nineSliceConfig.GetFilter() == ScaleNearest {
+		interpolator = xdraw.NearestNeighbor
+	}
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			dr := image.Rect(xs[i], ys[j], xs[i+1], ys[j+1])
+			sr := image.Rect(srcXs[i], srcYs[j], srcXs[i+1], srcYs[j+1])
+			if dr.Empty() || sr.Empty() {
+				continue
+			}
+			interpolator.Scale(dst, dr, img, sr, xdraw.Over, nil)
+		}
+	}
+}
+
+// nineSliceInsets scales a pair of insets, shrinking them to fit a length
+func nineSliceInsets(first int, second int, scale float64, length int) (int, int) {
+	scaledFirst, scaledSecond := float64(first)*scale, float64(second)*scale
+	if total := scaledFirst + scaledSecond; total > float64(length) {
+		scaledFirst *= float64(length) / total
+		scaledSecond *= float64(length) / total
+	}
+
+	firstPixels := int(math.Round(scaledFirst))
+	return firstPixels, minInt(int(math.Round(scaledSecond)), length-firstPixels)
+}
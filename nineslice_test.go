@@ -0,0 +1,64 @@
+package gridder
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testNineSlice is a 6x6 frame with a red 2 pixel border and a white center
+func testNineSlice() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 6, 6))
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 6; x++ {
+			if x < 2 || x > 3 || y < 2 || y > 3 {
+				img.Set(x, y, color.NRGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.White)
+			}
+		}
+	}
+	return img
+}
+
+func TestDrawNineSlice(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, MarginWidth: 10, LineStrokeWidth: 0.01, BorderStrokeWidth: 0.01})
+	assert.Nil(t, err)
+
+	frame := testNineSlice()
+	assert.Nil(t, gridder.DrawNineSlice(2, 3, 0, 1, frame, NineSliceConfig{Left: 2, Top: 2, Right: 2, Bottom: 2, Filter: ScaleNearest}))
+
+	img := gridder.image()
+	red, white := color.NRGBA{R: 255, A: 255}, color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+	// the region spans x 30 to 90 and y 10 to 70, corners keep 2 pixels
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 10)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(31, 11)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(32, 12)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(60, 11)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(60, 40)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(87, 67)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(88, 68)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(29, 40)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(60, 70)), white)
+
+	err = gridder.DrawNineSlice(0, 0, 4, 0, frame)
+	assert.True(t, errors.Is(err, errOutOfBounds))
+	err = gridder.DrawNineSlice(0, 0, 0, 0, frame, NineSliceConfig{Left: 4, Right: 3})
+	assert.True(t, errors.Is(err, errInvalidValue))
+	err = gridder.DrawNineSlice(0, 0, 0, 0, frame, NineSliceConfig{Top: -1})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestNineSliceInsets(t *testing.T) {
+	first, second := nineSliceInsets(2, 2, 2, 20)
+	assert.Equal(t, [2]int{first, second}, [2]int{4, 4})
+
+	first, second = nineSliceInsets(3, 1, 2, 4)
+	assert.Equal(t, [2]int{first, second}, [2]int{3, 1})
+
+	first, second = nineSliceInsets(1, 1, 1, 1)
+	assert.Equal(t, first+second, 1)
+}
@@ -0,0 +1,198 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"strconv"
+
+	"golang.org/x/image/font"
+)
+
+// NonogramClues gets the clues of a nonogram solution, the lengths of the runs of filled cells of every row from
+// the left and of every column from the top. Rows and columns without filled cells get a single 0 clue
+func NonogramClues(solution [][]bool) ([][]int, [][]int) {
+	var columns int
+	if len(solution) > 0 {
+		columns = len(solution[0])
+	}
+
+	rowClues := make([][]int, len(solution))
+	for row := range solution {
+		rowClues[row] = nonogramRuns(len(solution[row]), func(i int) bool { return solution[row][i] })
+	}
+
+	columnClues := make([][]int, columns)
+	for column := range columnClues {
+		columnClues[column] = nonogramRuns(len(solution), func(i int) bool { return solution[i][column] })
+	}
+	return rowClues, columnClues
+}
+
+// nonogramRuns gets the lengths of the runs of filled cells of a line
+func nonogramRuns(length int, filled func(i int) bool) []int {
+	var runs []int
+	var run int
+	for i := 0; i < length; i++ {
+		if filled(i) {
+			run++
+			continue
+		}
+		if run > 0 {
+			runs = append(runs, run)
+			run = 0
+		}
+	}
+	if run > 0 || len(runs) == 0 {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// RenderNonogram creates a nonogram puzzle of a solution, with the row clues in a margin at the left of the puzzle
+// and the column clues in a margin above it, one clue per cell. Every fifth line of the puzzle is thick, and the
+// solution is filled in when configured. Clues are drawn when a font is configured, and the image is sized for
+// square cells unless its dimensions are set
+func RenderNonogram(solution [][]bool, nonogramConfigs ...NonogramConfig) (*Gridder, error) {
+	nonogramConfig := getFirstNonogramConfig(nonogramConfigs...)
+
+	rows := len(solution)
+	if rows == 0 {
+		return nil, errNoRows
+	}
+	columns := len(solution[0])
+	if columns == 0 {
+		return nil, errNoColumns
+	}
+	for row := range solution {
+		if len(solution[row]) != columns {
+			return nil, errMatrixDimensions
+		}
+	}
+
+	rowClues, columnClues := NonogramClues(solution)
+	var clueColumns, clueRows int
+	for _, clues := range rowClues {
+		clueColumns = maxInt(clueColumns, len(clues))
+	}
+	for _, clues := range columnClues {
+		clueRows = maxInt(clueRows, len(clues))
+	}
+
+	imageConfig := nonogramConfig.ImageConfig
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = (clueColumns + columns) * defaultNonogramCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = (clueRows + rows) * defaultNonogramCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            clueRows + rows,
+		Columns:         clueColumns + columns,
+		LineStrokeWidth: nonogramConfig.GetLineStrokeWidth(),
+		LineColor:       color.Transparent,
+		BorderColor:     color.Transparent,
+		BackgroundColor: nonogramConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if nonogramConfig.Font != nil {
+		fontFace = g.FontFace(nonogramConfig.Font, nonogramConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		if nonogramConfig.ShowSolution {
+			for row := range solution {
+				for column, filled := range solution[row] {
+					if !filled {
+						continue
+					}
+					err := g.paintCell(clueRows+row, clueColumns+column, nonogramConfig.GetFillColor())
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if fontFace != nil {
+			stringConfig := StringConfig{Color: nonogramConfig.GetTextColor()}
+			for row, clues := range rowClues {
+				for i, clue := range clues {
+					err := g.drawString(clueRows+row, clueColumns-len(clues)+i, strconv.Itoa(clue), fontFace, stringConfig)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			for column, clues := range columnClues {
+				for i, clue := range clues {
+					err := g.drawString(clueRows-len(clues)+i, clueColumns+column, strconv.Itoa(clue), fontFace, stringConfig)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		g.drawMajorLines(image.Rect(clueColumns, clueRows, clueColumns+columns, clueRows+rows), majorLines{
+			interval:         nonogramConfig.GetMajorLineInterval(),
+			color:            nonogramConfig.GetLineColor(),
+			strokeWidth:      nonogramConfig.GetLineStrokeWidth(),
+			majorColor:       nonogramConfig.GetMajorLineColor(),
+			majorStrokeWidth: nonogramConfig.GetMajorLineStrokeWidth(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// majorLines is the style of lines with a major line at every interval
+type majorLines struct {
+	interval         int
+	color            color.Color
+	strokeWidth      float64
+	majorColor       color.Color
+	majorStrokeWidth float64
+}
+
+// drawMajorLines draws the lines of an area of cells, with columns along X and rows along Y, with a major line at
+// every interval from its top left and around it
+func (g *Gridder) drawMajorLines(area image.Rectangle, lines majorLines) {
+	layout := g.getLayout()
+	columnEdges := append([]float64{0}, layout.columnEdges...)[area.Min.X : area.Max.X+1]
+	rowEdges := append([]float64{0}, layout.rowEdges...)[area.Min.Y : area.Max.Y+1]
+	left, right := columnEdges[0], columnEdges[len(columnEdges)-1]
+	top, bottom := rowEdges[0], rowEdges[len(rowEdges)-1]
+
+	g.ctx.Push()
+	defer g.ctx.Pop()
+	g.ctx.SetDash()
+	for _, major := range []bool{false, true} {
+		strokeWidth := lines.strokeWidth
+		g.ctx.SetColor(lines.color)
+		if major {
+			strokeWidth = lines.majorStrokeWidth
+			g.ctx.SetColor(lines.majorColor)
+		}
+
+		g.stroke(strokeWidth, func() {
+			for i, x := range columnEdges {
+				if (i%lines.interval == 0 || i == len(columnEdges)-1) == major {
+					g.ctx.DrawLine(x, top, x, bottom)
+				}
+			}
+			for i, y := range rowEdges {
+				if (i%lines.interval == 0 || i == len(rowEdges)-1) == major {
+					g.ctx.DrawLine(left, y, right, y)
+				}
+			}
+		})
+	}
+}
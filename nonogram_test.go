@@ -0,0 +1,77 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+var testNonogram = [][]bool{
+	{true, true, false, true, false, false},
+	{false, false, false, false, false, false},
+	{true, true, true, true, true, true},
+	{false, false, false, false, false, false},
+	{false, false, false, false, false, false},
+	{false, false, false, false, false, false},
+}
+
+func TestNonogramClues(t *testing.T) {
+	rowClues, columnClues := NonogramClues(testNonogram)
+	assert.Equal(t, rowClues, [][]int{{2, 1}, {0}, {6}, {0}, {0}, {0}})
+	assert.Equal(t, columnClues, [][]int{{1, 1}, {1, 1}, {1}, {1, 1}, {1}, {1}})
+
+	rowClues, columnClues = NonogramClues(nil)
+	assert.Equal(t, len(rowClues), 0)
+	assert.Equal(t, len(columnClues), 0)
+}
+
+func TestRenderNonogram(t *testing.T) {
+	gridder, err := RenderNonogram(testNonogram)
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 240)
+	assert.Equal(t, gridder.ctx.Height(), 240)
+
+	img := gridder.image()
+	white := color.NRGBAModel.Convert(color.White)
+	black := color.NRGBAModel.Convert(color.Black)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 75)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(60, 30)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 100)), white)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(210, 100)), black)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(100, 60)), black)
+	assert.NotEqual(t, color.NRGBAModel.Convert(img.At(90, 100)), white)
+	assert.NotEqual(t, color.NRGBAModel.Convert(img.At(90, 100)), black)
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestRenderNonogramSolution(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	gridder, err := RenderNonogram(testNonogram, NonogramConfig{ShowSolution: true, MajorLineInterval: 2, Font: ttf})
+	assert.Nil(t, err)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 75)), color.NRGBAModel.Convert(color.Black))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(120, 100)), color.NRGBAModel.Convert(color.Black))
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 0}], []string{"2"})
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 1}], []string{"1"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 1}], []string{"0"})
+	assert.Equal(t, len(gridder.labels[Cell{Row: 3, Column: 0}]), 0)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 2}], []string{"1"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 4}], []string{"1"})
+	assert.Equal(t, len(gridder.labels[Cell{Row: 0, Column: 4}]), 0)
+}
+
+func TestRenderNonogramErrors(t *testing.T) {
+	_, err := RenderNonogram(nil)
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = RenderNonogram([][]bool{{}})
+	assert.True(t, errors.Is(err, errNoColumns))
+
+	_, err = RenderNonogram([][]bool{{true}, {true, false}})
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+}
@@ -0,0 +1,138 @@
+package gridder
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/font"
+)
+
+const defaultDecimalSeparator = "."
+
+// NumberFormat Number Formatting Configuration
+type NumberFormat struct {
+	Precision          int
+	ThousandsSeparator string
+	DecimalSeparator   string
+	Percent            bool
+	Scientific         bool
+	AutoContrast       bool
+}
+
+// GetPrecision gets the number of digits after the decimal separator. Negative values use as many digits as needed
+func (f *NumberFormat) GetPrecision() int {
+	return f.Precision
+}
+
+// GetThousandsSeparator gets thousands separator
+func (f *NumberFormat) GetThousandsSeparator() string {
+	return f.ThousandsSeparator
+}
+
+// GetDecimalSeparator gets decimal separator
+func (f *NumberFormat) GetDecimalSeparator() string {
+	if f.DecimalSeparator == "" {
+		return defaultDecimalSeparator
+	}
+	return f.DecimalSeparator
+}
+
+// IsPercent determines if values are fractions rendered as percentages
+func (f *NumberFormat) IsPercent() bool {
+	return f.Percent
+}
+
+// IsScientific determines if values are rendered in scientific notation
+func (f *NumberFormat) IsScientific() bool {
+	return f.Scientific
+}
+
+// IsAutoContrast determines if the text color is picked to contrast with the cell fill
+func (f *NumberFormat) IsAutoContrast() bool {
+	return f.AutoContrast
+}
+
+// Format formats a value
+func (f *NumberFormat) Format(value float64) string {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	if f.IsPercent() {
+		value *= 100
+	}
+
+	var text string
+	if f.IsScientific() {
+		text = strconv.FormatFloat(value, 'e', f.GetPrecision(), 64)
+		text = strings.Replace(text, ".", f.GetDecimalSeparator(), 1)
+	} else {
+		text = strconv.FormatFloat(value, 'f', f.GetPrecision(), 64)
+		integer, fraction := text, ""
+		if index := strings.IndexByte(text, '.'); index >= 0 {
+			integer, fraction = text[:index], f.GetDecimalSeparator()+text[index+1:]
+		}
+		text = groupThousands(integer, f.GetThousandsSeparator()) + fraction
+	}
+
+	if f.IsPercent() {
+		text += "%"
+	}
+	return text
+}
+
+// DrawNumbers formats and draws every value of a matrix in its cell, using the gridder locale when set
+func (g *Gridder) DrawNumbers(values [][]float64, fontFace font.Face, format NumberFormat, stringConfigs ...StringConfig) error {
+	texts := make([][]string, len(values))
+	for row := range values {
+		texts[row] = make([]string, len(values[row]))
+		for column, value := range values[row] {
+			texts[row][column] = g.formatNumber(format, value)
+		}
+	}
+
+	var colorAt func(row int, column int) color.Color
+	if format.IsAutoContrast() {
+		colorAt = g.contrastColorAt
+	}
+
+	stringConfig := getFirstStringConfig(stringConfigs...)
+	return g.retainStrings(texts, fontFace, stringConfig, colorAt)
+}
+
+func (g *Gridder) contrastColorAt(row int, column int) color.Color {
+	center := g.getCellCenter(row, column)
+	x, y := g.ctx.TransformPoint(center.X, center.Y)
+	return contrastColor(g.ctx.Image().At(int(x), int(y)))
+}
+
+func contrastColor(background color.Color) color.Color {
+	r, g, b, _ := background.RGBA()
+	luminance := (0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)) / 0xffff
+	if luminance > 0.5 {
+		return color.Black
+	}
+	return color.White
+}
+
+func groupThousands(integer string, separator string) string {
+	if separator == "" {
+		return integer
+	}
+
+	sign := ""
+	if strings.HasPrefix(integer, "-") {
+		sign, integer = "-", integer[1:]
+	}
+
+	var builder strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteRune(digit)
+	}
+	return sign + builder.String()
+}
@@ -0,0 +1,57 @@
+package gridder
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestNumberFormat(t *testing.T) {
+	format1 := &NumberFormat{}
+	assert.Equal(t, format1.GetPrecision(), 0)
+	assert.Equal(t, format1.GetThousandsSeparator(), "")
+	assert.Equal(t, format1.GetDecimalSeparator(), defaultDecimalSeparator)
+	assert.Equal(t, format1.IsPercent(), false)
+	assert.Equal(t, format1.IsScientific(), false)
+	assert.Equal(t, format1.IsAutoContrast(), false)
+	assert.Equal(t, format1.Format(1234.56), "1235")
+	assert.Equal(t, format1.Format(math.NaN()), "NaN")
+
+	format2 := &NumberFormat{Precision: 2, ThousandsSeparator: ".", DecimalSeparator: ","}
+	assert.Equal(t, format2.Format(1234567.891), "1.234.567,89")
+	assert.Equal(t, format2.Format(-123456), "-123.456,00")
+	assert.Equal(t, format2.Format(12), "12,00")
+
+	format3 := &NumberFormat{Precision: 1, Percent: true}
+	assert.Equal(t, format3.Format(0.256), "25.6%")
+
+	format4 := &NumberFormat{Precision: 2, Scientific: true}
+	assert.Equal(t, format4.Format(12345), "1.23e+04")
+
+	format5 := &NumberFormat{Precision: -1}
+	assert.Equal(t, format5.Format(0.125), "0.125")
+}
+
+func TestDrawNumbers(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 1, Columns: 2})
+	assert.Nil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 24})
+
+	err = gridder.DrawNumbers([][]float64{{1, 2, 3}}, fontFace, NumberFormat{})
+	assert.NotNil(t, err)
+
+	err = gridder.PaintCell(0, 0, color.Black)
+	assert.Nil(t, err)
+
+	assert.Equal(t, gridder.contrastColorAt(0, 0), color.White)
+	assert.Equal(t, gridder.contrastColorAt(0, 1), color.Black)
+
+	err = gridder.DrawNumbers([][]float64{{1, 2}}, fontFace, NumberFormat{AutoContrast: true})
+	assert.Nil(t, err)
+}
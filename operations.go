@@ -0,0 +1,164 @@
+package gridder
+
+import (
+	"image/color"
+)
+
+// operationStore holds the retained operations of a grid in typed slices, so that scenes of millions of operations,
+// such as cellular automata, cost a few large allocations instead of several small ones per operation.
+//
+// Painted cells, the bulk of large scenes, are stored by value in paints with their cell and fill inline and no
+// pointers, 32 bytes each, which the garbage collector does not scan. Other operations keep their drawing closure in
+// draws, and their cells share the cells slice, addressed by index ranges. The drawing order is a slice of 8 byte
+// references into either slice. The slices only grow as operations are retained, removing operations when the grid
+// is restructured or reset compacts them into new slices and releases the old ones
+type operationStore struct {
+	order  []operationRef
+	paints []paintOperation
+	draws  []drawOperation
+	cells  []Cell
+}
+
+// operationKind selects the slice of an operation reference
+type operationKind uint8
+
+const (
+	paintOperationKind operationKind = iota
+	drawOperationKind
+)
+
+// operationRef is a reference to an operation in the slice of its kind
+type operationRef struct {
+	kind  operationKind
+	index uint32
+}
+
+// paintOperation paints a cell with a fill, or with the fill of its cell style when styled
+type paintOperation struct {
+	cell   Cell
+	fill   color.RGBA64
+	styled bool
+}
+
+// drawOperation is an operation other than painting a cell, its cells are the cells of the store from start to end.
+// Its key describes the drawing call for EncodeCached, empty when the call cannot be described
+type drawOperation struct {
+	start   int
+	end     int
+	partial bool
+	draw    func(cells []Cell) error
+	key     string
+}
+
+// newPaintOperation creates the operation painting a cell, nil fills paint with the fill of the cell style
+func newPaintOperation(cell Cell, fill color.Color) paintOperation {
+	if fill == nil {
+		return paintOperation{cell: cell, styled: true}
+	}
+	r, g, b, a := fill.RGBA()
+	return paintOperation{cell: cell, fill: color.RGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: uint16(a)}}
+}
+
+// color gets the fill of the painted cell, nil for the fill of the cell style
+func (p paintOperation) color() color.Color {
+	if p.styled {
+		return nil
+	}
+	return p.fill
+}
+
+// len gets the number of operations
+func (s *operationStore) len() int {
+	return len(s.order)
+}
+
+// addPaint appends a painted cell
+func (s *operationStore) addPaint(paint paintOperation) {
+	s.order = append(s.order, operationRef{kind: paintOperationKind, index: uint32(len(s.paints))})
+	s.paints = append(s.paints, paint)
+}
+
+// add appends an operation, copying its cells into the store
+func (s *operationStore) add(op operation) {
+	start := len(s.cells)
+	s.cells = append(s.cells, op.cells...)
+	s.order = append(s.order, operationRef{kind: drawOperationKind, index: uint32(len(s.draws))})
+	s.draws = append(s.draws, drawOperation{start: start, end: len(s.cells), partial: op.partial, draw: op.draw, key: op.key})
+}
+
+// operation gets a drawing operation by reference, its cells share the memory of the store. Operations without cells
+// get nil cells, as they were retained
+func (s *operationStore) operation(ref operationRef) operation {
+	draw := s.draws[ref.index]
+	var cells []Cell
+	if draw.end > draw.start {
+		cells = s.cells[draw.start:draw.end:draw.end]
+	}
+	return operation{cells: cells, partial: draw.partial, draw: draw.draw, key: draw.key}
+}
+
+// cellsAt gets the cells of the operation at a position in drawing order, for tests and debugging
+func (s *operationStore) cellsAt(i int) []Cell {
+	ref := s.order[i]
+	if ref.kind == paintOperationKind {
+		return []Cell{s.paints[ref.index].cell}
+	}
+	return s.operation(ref).cells
+}
+
+// remap moves the cells of every operation with a cell mapping into a new store, dropping operations that lose
+// their cells
+func (s *operationStore) remap(mapping cellMapping) operationStore {
+	var remapped operationStore
+	for _, ref := range s.order {
+		if ref.kind == paintOperationKind {
+			paint := s.paints[ref.index]
+			if cell, ok := mapping(paint.cell); ok {
+				paint.cell = cell
+				remapped.addPaint(paint)
+			}
+			continue
+		}
+
+		if op, ok := s.operation(ref).remap(mapping); ok {
+			remapped.add(op)
+		}
+	}
+	return remapped
+}
+
+// countCopies counts the operations copyCell copies from a source cell
+func (s *operationStore) countCopies(src Cell) int {
+	count := 0
+	for _, ref := range s.order {
+		if ref.kind == paintOperationKind {
+			if s.paints[ref.index].cell == src {
+				count++
+			}
+			continue
+		}
+
+		if _, ok := s.operation(ref).copyCell(src, src); ok {
+			count++
+		}
+	}
+	return count
+}
+
+// copyCell appends copies of the operations of a source cell drawing in a destination cell
+func (s *operationStore) copyCell(src Cell, dst Cell) {
+	order := s.order
+	for _, ref := range order {
+		if ref.kind == paintOperationKind {
+			if paint := s.paints[ref.index]; paint.cell == src {
+				paint.cell = dst
+				s.addPaint(paint)
+			}
+			continue
+		}
+
+		if copied, ok := s.operation(ref).copyCell(src, dst); ok {
+			s.add(copied)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationStore(t *testing.T) {
+	var store operationStore
+	store.addPaint(newPaintOperation(Cell{Row: 0, Column: 0}, color.NRGBA{R: 255, A: 255}))
+	store.add(operation{cells: []Cell{{Row: 0, Column: 1}, {Row: 1, Column: 1}}, draw: func([]Cell) error { return nil }})
+	store.add(operation{draw: func([]Cell) error { return nil }})
+	store.addPaint(newPaintOperation(Cell{Row: 1, Column: 0}, nil))
+
+	assert.Equal(t, store.len(), 4)
+	assert.Equal(t, store.cellsAt(0), []Cell{{Row: 0, Column: 0}})
+	assert.Equal(t, store.cellsAt(1), []Cell{{Row: 0, Column: 1}, {Row: 1, Column: 1}})
+	assert.Nil(t, store.cellsAt(2))
+	assert.Equal(t, store.paints[0].color(), color.RGBA64{R: 0xffff, A: 0xffff})
+	assert.Nil(t, store.paints[1].color())
+
+	// deleting column 0 drops the paints and moves the path left
+	remapped := store.remap(func(cell Cell) (Cell, bool) {
+		if cell.Column == 0 {
+			return cell, false
+		}
+		cell.Column--
+		return cell, true
+	})
+	assert.Equal(t, remapped.len(), 2)
+	assert.Equal(t, remapped.cellsAt(0), []Cell{{Row: 0, Column: 0}, {Row: 1, Column: 0}})
+	assert.Nil(t, remapped.cellsAt(1))
+
+	store.copyCell(Cell{Row: 1, Column: 0}, Cell{Row: 2, Column: 2})
+	assert.Equal(t, store.len(), 5)
+	assert.Equal(t, store.cellsAt(4), []Cell{{Row: 2, Column: 2}})
+	assert.Nil(t, store.paints[2].color())
+}
+
+func TestPaintCellRetainsPaint(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 1, color.NRGBA{G: 255, A: 255}))
+	assert.Equal(t, len(gridder.operations.paints), 1)
+	assert.Equal(t, len(gridder.operations.draws), 0)
+
+	assert.Nil(t, gridder.InsertRow(0))
+	assert.Equal(t, gridder.operations.cellsAt(0), []Cell{{Row: 1, Column: 1}})
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(75, 50)), color.NRGBA{G: 255, A: 255})
+}
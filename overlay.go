@@ -0,0 +1,60 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Overlay returns a gridder that draws into a transparent layer on top of the grid.
+// The overlay shares the grid geometry and can be cleared without touching the base drawing
+func (g *Gridder) Overlay() *Gridder {
+	if g.parent != nil {
+		return g
+	}
+
+	if g.overlay == nil {
+		overlay := Gridder{
+			imageConfig: g.imageConfig,
+			gridConfig:  g.gridConfig,
+			ctx:         newContext(g.imageConfig, g.gridConfig),
+			parent:      g,
+		}
+		overlay.paintBackground()
+		g.overlay = &overlay
+	}
+	return g.overlay
+}
+
+// ClearOverlay removes everything drawn on the overlay
+func (g *Gridder) ClearOverlay() {
+	if g.parent != nil {
+		g.parent.ClearOverlay()
+		return
+	}
+
+	if g.overlay != nil {
+		g.overlay.paintBackground()
+		g.overlay.labels = nil
+		g.overlay.labelBoxes = nil
+		g.overlay.routedPaths = nil
+		g.overlay.operations = operationStore{}
+	}
+}
+
+func (g *Gridder) getBackgroundColor() color.Color {
+	if g.parent != nil {
+		return color.Transparent
+	}
+	return g.gridConfig.GetBackgroundColor()
+}
+
+func composeLayers(base image.Image, layers ...image.Image) image.Image {
+	bounds := base.Bounds()
+	rgba := image.NewRGBA(bounds)
+	draw.Draw(rgba, bounds, base, bounds.Min, draw.Src)
+	for _, layer := range layers {
+		draw.Draw(rgba, bounds, layer, bounds.Min, draw.Over)
+	}
+	return rgba
+}
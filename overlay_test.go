@@ -0,0 +1,36 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlay(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.PaintCell(0, 0, color.Black)
+	assert.Nil(t, err)
+
+	overlay := gridder.Overlay()
+	assert.Equal(t, overlay, gridder.Overlay())
+	assert.Equal(t, overlay, overlay.Overlay())
+
+	err = overlay.PaintCell(-1, -1, color.Black)
+	assert.NotNil(t, err)
+
+	err = overlay.PaintCell(1, 1, color.Black)
+	assert.Nil(t, err)
+
+	image := gridder.image()
+	assert.Equal(t, color.RGBAModel.Convert(image.At(25, 25)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(image.At(75, 75)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, overlay.image(), image)
+
+	overlay.ClearOverlay()
+	image = gridder.image()
+	assert.Equal(t, color.RGBAModel.Convert(image.At(25, 25)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(image.At(75, 75)), color.RGBAModel.Convert(color.White))
+}
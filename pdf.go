@@ -0,0 +1,164 @@
+package gridder
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"io"
+	"strings"
+)
+
+// EncodePDF renders the grid and writes it to w as a single page PDF embedding the rendered image, with a link
+// annotation over every linked cell. The page is sized from the configured DPI
+func (g *Gridder) EncodePDF(w io.Writer) error {
+	return writePDF(w, []pdfPage{g.pdfPage()})
+}
+
+// pdfPage is a page image with the link regions over it, and the trim and bleed boxes of print pages in pixels
+type pdfPage struct {
+	img      image.Image
+	dpi      float64
+	regions  []cellRegion
+	trimBox  image.Rectangle
+	bleedBox image.Rectangle
+}
+
+func (g *Gridder) pdfPage() pdfPage {
+	return pdfPage{img: g.image(), dpi: g.imageConfig.GetDPI(), regions: g.linkRegions()}
+}
+
+// writePDF writes a PDF of the pages
+func writePDF(w io.Writer, pages []pdfPage) error {
+	pdf := &pdfWriter{}
+	kids := make([]string, len(pages))
+	number := 3
+	for i, page := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", number)
+		number += 4 + len(page.regions)
+	}
+
+	pdf.object("<< /Type /Catalog /Pages 2 0 R >>")
+	pdf.object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	for _, page := range pages {
+		err := page.write(pdf)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write(pdf.bytes())
+	return err
+}
+
+// write writes the page, its contents, its image with the alpha mask and its link annotations
+func (p pdfPage) write(pdf *pdfWriter) error {
+	nrgba := toNRGBA(p.img)
+	bounds := nrgba.Bounds()
+
+	rgb := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	alpha := make([]byte, 0, bounds.Dx()*bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			offset := nrgba.PixOffset(x, y)
+			rgb = append(rgb, nrgba.Pix[offset:offset+3]...)
+			alpha = append(alpha, nrgba.Pix[offset+3])
+		}
+	}
+
+	rgb, err := deflatePDFStream(rgb)
+	if err != nil {
+		return err
+	}
+	alpha, err = deflatePDFStream(alpha)
+	if err != nil {
+		return err
+	}
+
+	scale := 72 / p.dpi
+	pageWidth, pageHeight := float64(bounds.Dx())*scale, float64(bounds.Dy())*scale
+
+	page := len(pdf.offsets) + 1
+	annotations := make([]string, len(p.regions))
+	for i := range p.regions {
+		annotations[i] = fmt.Sprintf("%d 0 R", page+4+i)
+	}
+
+	var boxes string
+	if !p.trimBox.Empty() {
+		boxes = fmt.Sprintf(" /TrimBox %s /BleedBox %s", pdfBox(p.trimBox, scale, pageHeight), pdfBox(p.bleedBox, scale, pageHeight))
+	}
+
+	pdf.object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %g %g]%s /Resources << /XObject << /Im0 %d 0 R >> >> /Contents %d 0 R /Annots [%s] >>", pageWidth, pageHeight, boxes, page+2, page+1, strings.Join(annotations, " ")))
+	pdf.stream("", []byte(fmt.Sprintf("q %g 0 0 %g 0 0 cm /Im0 Do Q", pageWidth, pageHeight)))
+	pdf.stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode /SMask %d 0 R", bounds.Dx(), bounds.Dy(), page+3), rgb)
+	pdf.stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode", bounds.Dx(), bounds.Dy()), alpha)
+	for _, region := range p.regions {
+		x1, y1 := region.x*scale, pageHeight-(region.y+region.height)*scale
+		x2, y2 := (region.x+region.width)*scale, pageHeight-region.y*scale
+		pdf.object(fmt.Sprintf("<< /Type /Annot /Subtype /Link /Rect [%g %g %g %g] /Border [0 0 0] /A << /Type /Action /S /URI /URI %s >> >>", x1, y1, x2, y2, pdfString(region.text)))
+	}
+	return nil
+}
+
+// pdfWriter writes the objects of a PDF document, numbered from 1 in the order they are written
+type pdfWriter struct {
+	buffer  bytes.Buffer
+	offsets []int
+}
+
+func (p *pdfWriter) object(body string) {
+	p.begin()
+	fmt.Fprintf(&p.buffer, "%s\nendobj\n", body)
+}
+
+func (p *pdfWriter) stream(dictionary string, data []byte) {
+	p.begin()
+	fmt.Fprintf(&p.buffer, "<< %s /Length %d >>\nstream\n", dictionary, len(data))
+	p.buffer.Write(data)
+	p.buffer.WriteString("\nendstream\nendobj\n")
+}
+
+func (p *pdfWriter) begin() {
+	if p.buffer.Len() == 0 {
+		p.buffer.WriteString("%PDF-1.4\n")
+	}
+	p.offsets = append(p.offsets, p.buffer.Len())
+	fmt.Fprintf(&p.buffer, "%d 0 obj\n", len(p.offsets))
+}
+
+// bytes ends the document with its cross-reference table and trailer
+func (p *pdfWriter) bytes() []byte {
+	xref := p.buffer.Len()
+	fmt.Fprintf(&p.buffer, "xref\n0 %d\n0000000000 65535 f \n", len(p.offsets)+1)
+	for _, offset := range p.offsets {
+		fmt.Fprintf(&p.buffer, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&p.buffer, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(p.offsets)+1, xref)
+	return p.buffer.Bytes()
+}
+
+func deflatePDFStream(data []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	zw := zlib.NewWriter(&buffer)
+	_, err := zw.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	err = zw.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// pdfBox converts a rectangle of the page image to a PDF rectangle, with the origin at the bottom left
+func pdfBox(rectangle image.Rectangle, scale float64, pageHeight float64) string {
+	return fmt.Sprintf("[%g %g %g %g]", float64(rectangle.Min.X)*scale, pageHeight-float64(rectangle.Max.Y)*scale, float64(rectangle.Max.X)*scale, pageHeight-float64(rectangle.Min.Y)*scale)
+}
+
+// pdfString quotes a text as a PDF literal string
+func pdfString(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`, "\r", `\r`, "\n", `\n`)
+	return "(" + replacer.Replace(text) + ")"
+}
@@ -0,0 +1,55 @@
+package gridder
+
+import (
+	"bytes"
+	"compress/zlib"
+	"image"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodePDF(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100, DPI: 144}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetLink(0, 1, "https://example.com/(a)"))
+
+	var buffer bytes.Buffer
+	assert.Nil(t, gridder.Encode(&buffer, "PDF"))
+	pdf := buffer.Bytes()
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+	assert.True(t, bytes.Contains(pdf, []byte("/MediaBox [0 0 100 50]")))
+	assert.True(t, bytes.Contains(pdf, []byte(`/Rect [25 25 50 50] /Border [0 0 0] /A << /Type /Action /S /URI /URI (https://example.com/\(a\)) >>`)))
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	assert.NotNil(t, startxref)
+	offset, _ := strconv.Atoi(string(startxref[1]))
+	assert.True(t, bytes.HasPrefix(pdf[offset:], []byte("xref\n0 8\n")))
+
+	objects := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(pdf, -1)
+	assert.Equal(t, len(objects), 7)
+	for i, object := range objects {
+		offset, _ := strconv.Atoi(string(object[1]))
+		assert.True(t, bytes.HasPrefix(pdf[offset:], []byte(strconv.Itoa(i+1)+" 0 obj\n")))
+	}
+
+	stream := regexp.MustCompile(`(?s)/SMask 6 0 R /Length \d+ >>\nstream\n(.*?)\nendstream`).FindSubmatch(pdf)
+	assert.NotNil(t, stream)
+	reader, err := zlib.NewReader(bytes.NewReader(stream[1]))
+	assert.Nil(t, err)
+	pixels, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, len(pixels), 200*100*3)
+}
+
+func TestPDFString(t *testing.T) {
+	assert.Equal(t, pdfString(`a(b)\c`), `(a\(b\)\\c)`)
+}
+
+func TestPDFBox(t *testing.T) {
+	assert.Equal(t, pdfBox(image.Rect(10, 20, 30, 50), 0.5, 100), "[5 75 15 90]")
+}
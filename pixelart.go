@@ -0,0 +1,142 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// FromImage pixelates an image onto a grid of rows and columns, painting every cell with a color sampled from the
+// pixels it covers, restricted to the configured palette when one is set. The image is sized for square cells unless
+// its dimensions are set
+func FromImage(img image.Image, rows int, columns int, fromImageConfigs ...FromImageConfig) (*Gridder, error) {
+	fromImageConfig := getFirstFromImageConfig(fromImageConfigs...)
+	if rows <= 0 {
+		return nil, errNoRows
+	}
+	if columns <= 0 {
+		return nil, errNoColumns
+	}
+	if img.Bounds().Empty() {
+		return nil, fmt.Errorf("%w: empty image", errInvalidValue)
+	}
+
+	colors := sampleImage(img, rows, columns, fromImageConfig.GetSampling())
+	if palette := fromImageConfig.GetPalette(); palette != nil {
+		for row := range colors {
+			for column, c := range colors[row] {
+				colors[row][column] = palette.Convert(c)
+			}
+		}
+	}
+
+	imageConfig := fromImageConfig.ImageConfig
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultFromImageCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = rows * defaultFromImageCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:              rows,
+		Columns:           columns,
+		LineStrokeWidth:   fromImageConfig.GetLineStrokeWidth(),
+		BorderStrokeWidth: fromImageConfig.GetLineStrokeWidth(),
+		LineColor:         fromImageConfig.GetLineColor(),
+		BorderColor:       fromImageConfig.GetLineColor(),
+		BackgroundColor:   color.Transparent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = g.retain(func() error {
+		for row := range colors {
+			for column, c := range colors[row] {
+				err := g.paintCell(row, column, c)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// sampleImage gets a color per cell of a grid laid over an image. Cells smaller than a pixel take the pixel they
+// fall on
+func sampleImage(img image.Image, rows int, columns int, sampling CellSampling) [][]color.Color {
+	bounds := img.Bounds()
+	span := func(i int, count int, min int, length int) (int, int) {
+		start := min + i*length/count
+		end := min + (i+1)*length/count
+		if end <= start {
+			end = start + 1
+		}
+		return start, end
+	}
+
+	colors := make([][]color.Color, rows)
+	for row := range colors {
+		colors[row] = make([]color.Color, columns)
+		y0, y1 := span(row, rows, bounds.Min.Y, bounds.Dy())
+		for column := range colors[row] {
+			x0, x1 := span(column, columns, bounds.Min.X, bounds.Dx())
+			area := image.Rect(x0, y0, x1, y1)
+			if sampling == SampleDominant {
+				colors[row][column] = dominantColor(img, area)
+			} else {
+				colors[row][column] = averageColor(img, area)
+			}
+		}
+	}
+	return colors
+}
+
+// averageColor gets the mean color of the pixels of an area, weighting colors by their opacity
+func averageColor(img image.Image, area image.Rectangle) color.Color {
+	var r, g, b, a uint64
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			pr, pg, pb, pa := img.At(x, y).RGBA()
+			r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+		}
+	}
+	count := uint64(area.Dx() * area.Dy())
+	return color.RGBA64{R: uint16(r / count), G: uint16(g / count), B: uint16(b / count), A: uint16(a / count)}
+}
+
+// dominantColor gets the most frequent color of the pixels of an area. Colors are counted with 5 bits per channel so
+// that the noise of photos does not split them, and the pixels of the most frequent one are averaged, the one counted
+// first on ties
+func dominantColor(img image.Image, area image.Rectangle) color.Color {
+	type bucket struct {
+		count      int
+		r, g, b, a uint64
+	}
+	buckets := make(map[uint32]*bucket)
+	var best *bucket
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			pr, pg, pb, pa := img.At(x, y).RGBA()
+			key := pr>>11<<15 | pg>>11<<10 | pb>>11<<5 | pa>>11
+			current, ok := buckets[key]
+			if !ok {
+				current = &bucket{}
+				buckets[key] = current
+			}
+			current.count++
+			current.r, current.g, current.b, current.a = current.r+uint64(pr), current.g+uint64(pg), current.b+uint64(pb), current.a+uint64(pa)
+			if best == nil || current.count > best.count {
+				best = current
+			}
+		}
+	}
+	count := uint64(best.count)
+	return color.RGBA64{R: uint16(best.r / count), G: uint16(best.g / count), B: uint16(best.b / count), A: uint16(best.a / count)}
+}
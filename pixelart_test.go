@@ -0,0 +1,65 @@
+package gridder
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testPixelArt is red on the left and blue on the right, with a green top left pixel
+func testPixelArt() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			img.Set(x, y, color.NRGBA{R: 255, A: 255})
+			if x >= 2 {
+				img.Set(x, y, color.NRGBA{B: 255, A: 255})
+			}
+		}
+	}
+	img.Set(0, 0, color.NRGBA{G: 255, A: 255})
+	return img
+}
+
+func TestFromImage(t *testing.T) {
+	gridder, err := FromImage(testPixelArt(), 2, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 32)
+	assert.Equal(t, gridder.ctx.Height(), 32)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(8, 8)), color.NRGBA{R: 191, G: 63, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(8, 24)), color.NRGBA{R: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(24, 8)), color.NRGBA{B: 255, A: 255})
+
+	gridder, _ = FromImage(testPixelArt(), 2, 2, FromImageConfig{Sampling: SampleDominant})
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(8, 8)), color.NRGBA{R: 255, A: 255})
+
+	gridder, _ = FromImage(testPixelArt(), 2, 2, FromImageConfig{Palette: []color.Color{color.Black, color.White}})
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(24, 8)), color.NRGBA{A: 255})
+}
+
+func TestFromImageErrors(t *testing.T) {
+	_, err := FromImage(testPixelArt(), 0, 2)
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = FromImage(testPixelArt(), 2, 0)
+	assert.True(t, errors.Is(err, errNoColumns))
+
+	_, err = FromImage(image.NewNRGBA(image.Rect(0, 0, 0, 0)), 2, 2)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestSampleImage(t *testing.T) {
+	colors := sampleImage(testPixelArt(), 8, 8, SampleAverage)
+	assert.Equal(t, len(colors), 8)
+	assert.Equal(t, color.NRGBAModel.Convert(colors[0][0]), color.NRGBA{G: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(colors[1][1]), color.NRGBA{G: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(colors[7][7]), color.NRGBA{B: 255, A: 255})
+
+	colors = sampleImage(testPixelArt(), 1, 1, SampleDominant)
+	assert.Equal(t, color.NRGBAModel.Convert(colors[0][0]), color.NRGBA{B: 255, A: 255})
+}
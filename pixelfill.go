@@ -0,0 +1,118 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"reflect"
+
+	"github.com/fogleman/gg"
+)
+
+// fillRectangle fills an axis aligned rectangle by writing straight into the pixels of the context, bypassing the
+// path rasterizer. It reproduces the antialiased edges of the rasterizer exactly: coordinates are truncated to
+// 1/64 of a pixel like gg does, and edge pixels are covered by the product of their overlaps. It reports false and
+// draws nothing when the context is not translated only, has a clip set or the rectangle reaches left of or above the
+// image, so that gg draws it. Pixels are clipped to the image bounds
+func fillRectangle(ctx *gg.Context, x float64, y float64, width float64, height float64, fill color.Color) bool {
+	img, ok := ctx.Image().(*image.RGBA)
+	if !ok || !isTranslation(ctx) || isClipped(ctx) {
+		return false
+	}
+
+	left, top := ctx.TransformPoint(x, y)
+	right, bottom := ctx.TransformPoint(x+width, y+height)
+	// the rasterizer folds coverage left of and above the image into its first column and row, which is left to gg
+	for _, value := range []float64{left, top, right, bottom} {
+		if math.IsNaN(value) || value < 0 || value > math.MaxInt32/64 {
+			return false
+		}
+	}
+
+	x0, x1 := int(int32(left*64)), int(int32(right*64))
+	y0, y1 := int(int32(top*64)), int(int32(bottom*64))
+	if x1 < x0 {
+		x0, x1 = x1, x0
+	}
+	if y1 < y0 {
+		y0, y1 = y1, y0
+	}
+
+	bounds := img.Bounds()
+	minX, maxX := maxInt(x0/64, bounds.Min.X), minInt((x1+63)/64, bounds.Max.X)
+	minY, maxY := maxInt(y0/64, bounds.Min.Y), minInt((y1+63)/64, bounds.Max.Y)
+	if minX >= maxX || minY >= maxY {
+		return true
+	}
+
+	cr, cg, cb, ca := fill.RGBA()
+	solid := [4]uint8{uint8(cr >> 8), uint8(cg >> 8), uint8(cb >> 8), uint8(ca >> 8)}
+	for py := minY; py < maxY; py++ {
+		overlapY := minInt(y1, (py+1)*64) - maxInt(y0, py*64)
+		row := img.Pix[img.PixOffset(minX, py):img.PixOffset(maxX, py)]
+
+		// whole pixels of an opaque color are copied, doubling the filled run, which compiles to wide moves
+		if overlapY == 64 && ca == 0xffff {
+			start, end := 0, len(row)
+			if overlapX := minInt(x1, (minX+1)*64) - maxInt(x0, minX*64); overlapX < 64 {
+				blendPixel(row[0:4], coverageAlpha(overlapX*overlapY), cr, cg, cb, ca)
+				start = 4
+			}
+			if overlapX := minInt(x1, maxX*64) - maxInt(x0, (maxX-1)*64); overlapX < 64 && end-4 >= start {
+				blendPixel(row[end-4:end], coverageAlpha(overlapX*overlapY), cr, cg, cb, ca)
+				end -= 4
+			}
+			if start < end {
+				run := row[start:end]
+				copy(run, solid[:])
+				for filled := 4; filled < len(run); filled *= 2 {
+					copy(run[filled:], run[:filled])
+				}
+			}
+			continue
+		}
+
+		for px := minX; px < maxX; px++ {
+			overlapX := minInt(x1, (px+1)*64) - maxInt(x0, px*64)
+			i := (px - minX) * 4
+			blendPixel(row[i:i+4], coverageAlpha(overlapX*overlapY), cr, cg, cb, ca)
+		}
+	}
+	return true
+}
+
+// isTranslation determines if the transformation of a context only translates
+func isTranslation(ctx *gg.Context) bool {
+	originX, originY := ctx.TransformPoint(0, 0)
+	unitX, unitXY := ctx.TransformPoint(1, 0)
+	unitYX, unitY := ctx.TransformPoint(0, 1)
+	return unitX-originX == 1 && unitXY == originY && unitYX == originX && unitY-originY == 1
+}
+
+// isClipped determines if a clip is set on a context. gg does not expose its clip mask, which is read by reflection,
+// and a context without the mask is taken as clipped
+func isClipped(ctx *gg.Context) bool {
+	mask := reflect.ValueOf(ctx).Elem().FieldByName("mask")
+	return !mask.IsValid() || mask.Kind() != reflect.Ptr || !mask.IsNil()
+}
+
+// coverageAlpha converts the covered area of a pixel in 1/4096 to the 16 bit alpha of the rasterizer, which caps
+// coverage at 12 bits
+func coverageAlpha(area int) uint32 {
+	alpha := uint32(minInt(area, 0x0fff))
+	return alpha<<4 | alpha>>8
+}
+
+// blendPixel composites a premultiplied color with a coverage alpha over a pixel, with the integer arithmetic of the
+// rasterizer's RGBA painter
+func blendPixel(pixel []uint8, coverage uint32, cr uint32, cg uint32, cb uint32, ca uint32) {
+	if coverage == 0 {
+		return
+	}
+	const m = 1<<16 - 1
+	a := (m - (ca * coverage / m)) * 0x101
+	pixel[0] = uint8((uint32(pixel[0])*a + cr*coverage) / m >> 8)
+	pixel[1] = uint8((uint32(pixel[1])*a + cg*coverage) / m >> 8)
+	pixel[2] = uint8((uint32(pixel[2])*a + cb*coverage) / m >> 8)
+	pixel[3] = uint8((uint32(pixel[3])*a + ca*coverage) / m >> 8)
+}
@@ -0,0 +1,82 @@
+package gridder
+
+import (
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/fogleman/gg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillRectangle(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	filled := 0
+	for i := 0; i < 2000; i++ {
+		fast, slow := gg.NewContext(40, 30), gg.NewContext(40, 30)
+		background := color.NRGBA{R: uint8(random.Intn(256)), G: uint8(random.Intn(256)), B: uint8(random.Intn(256)), A: uint8(random.Intn(256))}
+		for _, ctx := range []*gg.Context{fast, slow} {
+			ctx.SetColor(background)
+			ctx.Clear()
+			ctx.Translate(random.Float64()*4-2, 0)
+		}
+		slow.Identity()
+		slow.Translate(fast.TransformPoint(0, 0))
+
+		x, y := random.Float64()*50-5, random.Float64()*40-5
+		width, height := random.Float64()*30-2, random.Float64()*20-2
+		fill := color.NRGBA{R: uint8(random.Intn(256)), G: uint8(random.Intn(256)), B: uint8(random.Intn(256)), A: uint8(random.Intn(256))}
+		if i%2 == 0 {
+			fill.A = 255
+		}
+
+		// rectangles reaching left of or above the image are left to gg
+		if !fillRectangle(fast, x, y, width, height, fill) {
+			left, top := fast.TransformPoint(x, y)
+			right, bottom := fast.TransformPoint(x+width, y+height)
+			assert.True(t, left < 0 || top < 0 || right < 0 || bottom < 0)
+			continue
+		}
+		filled++
+
+		slow.DrawRectangle(x, y, width, height)
+		slow.SetColor(fill)
+		slow.Fill()
+		assert.Equal(t, fast.Image(), slow.Image(), "rectangle %v,%v %vx%v", x, y, width, height)
+	}
+	assert.True(t, filled > 1000)
+}
+
+func TestFillRectangleTransformed(t *testing.T) {
+	ctx := gg.NewContext(10, 10)
+	ctx.Scale(2, 2)
+	assert.False(t, fillRectangle(ctx, 0, 0, 2, 2, color.Black))
+
+	ctx.Identity()
+	ctx.RotateAbout(1, 5, 5)
+	assert.False(t, fillRectangle(ctx, 0, 0, 2, 2, color.Black))
+}
+
+func TestFillRectangleClipped(t *testing.T) {
+	ctx := gg.NewContext(10, 10)
+	ctx.DrawRectangle(0, 0, 5, 10)
+	ctx.Clip()
+	assert.False(t, fillRectangle(ctx, 0, 0, 10, 10, color.Black))
+
+	ctx.ResetClip()
+	assert.True(t, fillRectangle(ctx, 0, 0, 10, 10, color.Black))
+}
+
+func TestDrawRectangleClipped(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	red := color.RGBA{R: 255, A: 255}
+	gridder.Context().DrawRectangle(0, 0, 50, 100)
+	gridder.Context().Clip()
+	assert.Nil(t, gridder.DrawRectangle(0, 0, RectangleConfig{Width: 80, Height: 80, Color: red}))
+
+	img := gridder.Context().Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(25, 50)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(75, 50)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+}
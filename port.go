@@ -0,0 +1,56 @@
+package gridder
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/fogleman/gg"
+)
+
+// Port is a point on a side of a cell where a path attaches instead of the center of the cell, so that connectors
+// enter boxes at sensible points and do not cross the shapes in them
+type Port struct {
+	Side Side
+	// Offset moves the port along its side as a fraction of the side, from -0.5 at its top or left end to 0.5 at its
+	// bottom or right end
+	Offset float64
+	// Inset moves the port towards the center of the cell in pixels, onto a shape smaller than the cell
+	Inset float64
+}
+
+// point gets the point of the port on the side of an area
+func (p *Port) point(area cellArea) *gg.Point {
+	x, y := area.center.X+p.Offset*area.width, area.center.Y+p.Offset*area.height
+	switch p.Side {
+	case SideTop:
+		y = area.center.Y - area.height/2 + p.Inset
+	case SideRight:
+		x = area.center.X + area.width/2 - p.Inset
+	case SideBottom:
+		y = area.center.Y + area.height/2 - p.Inset
+	case SideLeft:
+		x = area.center.X - area.width/2 + p.Inset
+	}
+	return &gg.Point{X: x, Y: y}
+}
+
+// portPoint gets the point of an optional port on an area, the center of the area without a port
+func portPoint(port *Port, area cellArea) *gg.Point {
+	if port == nil {
+		return area.center
+	}
+	return port.point(area)
+}
+
+func (p *Port) validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.Side < SideTop || p.Side > SideLeft {
+		return fmt.Errorf("%w: port side %d", errInvalidValue, p.Side)
+	}
+	if math.IsNaN(p.Offset) || math.Abs(p.Offset) > 0.5 {
+		return fmt.Errorf("%w: port offset %v", errInvalidValue, p.Offset)
+	}
+	return nonNegative("port inset", p.Inset)
+}
@@ -0,0 +1,46 @@
+package gridder
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/fogleman/gg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortPoint(t *testing.T) {
+	area := cellArea{center: &gg.Point{X: 50, Y: 50}, width: 20, height: 10}
+	assert.Equal(t, portPoint(nil, area), area.center)
+	assert.Equal(t, portPoint(&Port{Side: SideTop, Offset: 0.25}, area), &gg.Point{X: 55, Y: 45})
+	assert.Equal(t, portPoint(&Port{Side: SideRight, Inset: 2}, area), &gg.Point{X: 58, Y: 50})
+	assert.Equal(t, portPoint(&Port{Side: SideBottom}, area), &gg.Point{X: 50, Y: 55})
+	assert.Equal(t, portPoint(&Port{Side: SideLeft, Offset: -0.5}, area), &gg.Point{X: 40, Y: 45})
+}
+
+func TestPortValidate(t *testing.T) {
+	var port *Port
+	assert.Nil(t, port.validate())
+	assert.Nil(t, (&Port{Side: SideLeft, Offset: 0.5, Inset: 1}).validate())
+	assert.ErrorIs(t, (&Port{Side: Side(4)}).validate(), errInvalidValue)
+	assert.ErrorIs(t, (&Port{Offset: -0.6}).validate(), errInvalidValue)
+	assert.ErrorIs(t, (&Port{Offset: math.NaN()}).validate(), errInvalidValue)
+	assert.ErrorIs(t, (&Port{Inset: -1}).validate(), errInvalidValue)
+}
+
+func TestDrawPathPorts(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+
+	red := color.RGBA{R: 255, A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	pathConfig := PathConfig{StrokeWidth: 4, Color: red, StartPort: &Port{Side: SideRight}, EndPort: &Port{Side: SideLeft}}
+	assert.Nil(t, gridder.DrawPath(1, 0, 1, 2, pathConfig))
+
+	img := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(37, 37)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(12, 37)), white)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(62, 37)), white)
+
+	assert.ErrorIs(t, gridder.DrawPath(0, 0, 1, 1, PathConfig{EndPort: &Port{Offset: 1}}), errInvalidValue)
+}
@@ -0,0 +1,130 @@
+package gridder
+
+import (
+	"fmt"
+	"math"
+
+	"golang.org/x/image/font"
+)
+
+// position is a fractional position in the grid, where whole numbers are the centers of cells and 2.5 is halfway
+// between rows or columns 2 and 3, on the line between them
+type position struct {
+	row    float64
+	column float64
+}
+
+// DrawRectangleAt draws a rectangle at a fractional position, styled as the cell nearest to it
+func (g *Gridder) DrawRectangleAt(row float64, column float64, rectangleConfigs ...RectangleConfig) error {
+	return g.retainPositions([]position{{row: row, column: column}}, func(cells []Cell, areas []cellArea) error {
+		return g.drawRectangleAt(cells[0].Row, cells[0].Column, areas[0], rectangleConfigs...)
+	})
+}
+
+// DrawCircleAt draws a circle at a fractional position, such as a stone on an intersection of the lines of a Go
+// board, styled as the cell nearest to it
+func (g *Gridder) DrawCircleAt(row float64, column float64, circleConfigs ...CircleConfig) error {
+	return g.retainPositions([]position{{row: row, column: column}}, func(cells []Cell, areas []cellArea) error {
+		return g.drawCircleAt(cells[0].Row, cells[0].Column, areas[0], circleConfigs...)
+	})
+}
+
+// DrawLineAt draws a line at a fractional position, styled as the cell nearest to it
+func (g *Gridder) DrawLineAt(row float64, column float64, lineConfigs ...LineConfig) error {
+	return g.retainPositions([]position{{row: row, column: column}}, func(cells []Cell, areas []cellArea) error {
+		return g.drawLineAt(cells[0].Row, cells[0].Column, areas[0], lineConfigs...)
+	})
+}
+
+// DrawStringAt draws a string at a fractional position. It is styled as and labels the cell nearest to it
+func (g *Gridder) DrawStringAt(row float64, column float64, text string, fontFace font.Face, stringConfigs ...StringConfig) error {
+	return g.retainPositions([]position{{row: row, column: column}}, func(cells []Cell, areas []cellArea) error {
+		return g.drawStringAt(cells[0].Row, cells[0].Column, areas[0], text, fontFace, stringConfigs...)
+	})
+}
+
+// DrawPathAt draws a path between two fractional positions, styled as the cell nearest to the first one
+func (g *Gridder) DrawPathAt(row1 float64, column1 float64, row2 float64, column2 float64, pathConfigs ...PathConfig) error {
+	positions := []position{{row: row1, column: column1}, {row: row2, column: column2}}
+	return g.retainPositions(positions, func(cells []Cell, areas []cellArea) error {
+		return g.drawPathAt(cells[0].Row, cells[0].Column, areas[0], areas[1], pathConfigs...)
+	})
+}
+
+// retainAnchored runs a drawing operation in the areas of cells, or at the intersections of the lines before them
+// in the intersection mode, which reach one past the last row and column
+func (g *Gridder) retainAnchored(cells []Cell, draw func(cells []Cell, areas []cellArea) error) error {
+	if g.gridConfig.Intersections {
+		positions := make([]position, len(cells))
+		for i, cell := range cells {
+			positions[i] = position{row: float64(cell.Row) - 0.5, column: float64(cell.Column) - 0.5}
+		}
+		return g.retainPositions(positions, draw)
+	}
+
+	return g.retainCells(cells, func(cells []Cell) error {
+		areas := make([]cellArea, len(cells))
+		for i, cell := range cells {
+			err := g.verifyInBounds(cell.Row, cell.Column)
+			if err != nil {
+				return err
+			}
+			areas[i] = g.getCellArea(cell.Row, cell.Column)
+		}
+		return draw(cells, areas)
+	})
+}
+
+// retainPositions runs a drawing operation in areas of the size of a cell centered on fractional positions, and records it with the cells nearest to them,
+// so that the positions keep their offsets from their cells when the layout changes
+func (g *Gridder) retainPositions(positions []position, draw func(cells []Cell, areas []cellArea) error) error {
+	cells := make([]Cell, len(positions))
+	offsets := make([]position, len(positions))
+	for i, p := range positions {
+		err := g.verifyPosition(p)
+		if err != nil {
+			return err
+		}
+		cells[i] = g.nearestCell(p)
+		offsets[i] = position{row: p.row - float64(cells[i].Row), column: p.column - float64(cells[i].Column)}
+	}
+
+	return g.retainCells(cells, func(cells []Cell) error {
+		areas := make([]cellArea, len(cells))
+		for i, cell := range cells {
+			err := g.verifyInBounds(cell.Row, cell.Column)
+			if err != nil {
+				return err
+			}
+			areas[i] = g.getCellArea(cell.Row, cell.Column)
+			areas[i].center = g.getPositionCenter(float64(cell.Row)+offsets[i].row, float64(cell.Column)+offsets[i].column)
+		}
+		return draw(cells, areas)
+	})
+}
+
+// verifyPosition verifies that a fractional position is finite and in the grid, which reaches half a cell beyond the
+// centers of the outer cells
+func (g *Gridder) verifyPosition(p position) error {
+	if math.IsNaN(p.row) || math.IsInf(p.row, 0) || math.IsNaN(p.column) || math.IsInf(p.column, 0) {
+		return fmt.Errorf("%w: position %v, %v", errInvalidValue, p.row, p.column)
+	}
+	if g.skipBoundsCheck {
+		return nil
+	}
+	rows, columns := float64(g.gridConfig.GetRows()), float64(g.gridConfig.GetColumns())
+	if p.row < -0.5 || p.row > rows-0.5 || p.column < -0.5 || p.column > columns-0.5 {
+		return errOutOfBounds
+	}
+	return nil
+}
+
+// nearestCell gets the cell of the grid nearest to a fractional position, rounding halves down
+func (g *Gridder) nearestCell(p position) Cell {
+	row := int(math.Ceil(p.row - 0.5))
+	column := int(math.Ceil(p.column - 0.5))
+	return Cell{
+		Row:    maxInt(0, minInt(row, g.gridConfig.GetRows()-1)),
+		Column: maxInt(0, minInt(column, g.gridConfig.GetColumns()-1)),
+	}
+}
@@ -0,0 +1,93 @@
+package gridder
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestDrawCircleAt(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+
+	red := color.RGBA{R: 255, A: 255}
+	assert.Nil(t, gridder.DrawCircleAt(1.5, 1.5, CircleConfig{Radius: 5, Color: red}))
+	assert.Equal(t, gridder.operations.cellsAt(0), []Cell{{Row: 1, Column: 1}})
+	assert.Equal(t, color.RGBAModel.Convert(gridder.Image().At(50, 50)), red)
+
+	assert.ErrorIs(t, gridder.DrawCircleAt(-0.6, 0), errOutOfBounds)
+	assert.ErrorIs(t, gridder.DrawCircleAt(0, 3.6), errOutOfBounds)
+	assert.ErrorIs(t, gridder.DrawCircleAt(math.NaN(), 0), errInvalidValue)
+	assert.Nil(t, gridder.DrawCircleAt(-0.5, 3.5))
+
+	assert.Nil(t, gridder.AddRow())
+	img := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 40)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 50)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+}
+
+func TestDrawShapesAt(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+
+	blue := color.RGBA{B: 255, A: 255}
+	assert.Nil(t, gridder.DrawRectangleAt(0, 0.5, RectangleConfig{Width: 4, Height: 4, Color: blue}))
+	assert.Nil(t, gridder.DrawLineAt(3, 2.5, LineConfig{Length: 10, StrokeWidth: 2, Color: blue}))
+	assert.Nil(t, gridder.DrawPathAt(-0.5, -0.5, 3.5, 3.5, PathConfig{StrokeWidth: 2, Color: blue}))
+	assert.Equal(t, gridder.operations.cellsAt(2), []Cell{{Row: 0, Column: 0}, {Row: 3, Column: 3}})
+
+	img := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(25, 12)), blue)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(72, 87)), blue)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(75, 75)), blue)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(75, 25)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+}
+
+func TestDrawStringAt(t *testing.T) {
+	font, err := truetype.Parse(goregular.TTF)
+	assert.Nil(t, err)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 12})
+
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.DrawStringAt(2.4, 0.6, "A", fontFace))
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 1}], []string{"A"})
+}
+
+func TestNearestCell(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+
+	assert.Equal(t, gridder.nearestCell(position{row: 1.5, column: 1.51}), Cell{Row: 1, Column: 2})
+	assert.Equal(t, gridder.nearestCell(position{row: -0.5, column: 3.5}), Cell{Row: 0, Column: 3})
+	assert.Equal(t, gridder.nearestCell(position{row: -7, column: 9}), Cell{Row: 0, Column: 3})
+}
+
+func TestIntersections(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, LineStrokeWidth: 0, BorderStrokeWidth: 0, Intersections: true})
+	assert.Nil(t, err)
+
+	black := color.RGBA{A: 255}
+	for _, cell := range []Cell{{Row: 0, Column: 0}, {Row: 1, Column: 1}, {Row: 2, Column: 2}} {
+		assert.Nil(t, gridder.DrawCircle(cell.Row, cell.Column, CircleConfig{Radius: 4, Color: black}))
+	}
+	assert.ErrorIs(t, gridder.DrawCircle(3, 0), errOutOfBounds)
+	assert.ErrorIs(t, gridder.DrawRectangle(0, -1), errOutOfBounds)
+	assert.Equal(t, gridder.operations.cellsAt(2), []Cell{{Row: 1, Column: 1}})
+
+	img := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(1, 1)), black)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 50)), black)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(98, 98)), black)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(25, 25)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+	assert.Nil(t, gridder.DrawPath(0, 2, 2, 0, PathConfig{StrokeWidth: 2, Color: black}))
+	assert.Nil(t, gridder.AddRow())
+	img = gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(98, 65)), black)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(75, 50)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+}
@@ -0,0 +1,138 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+
+	"github.com/fogleman/gg"
+)
+
+// millimetresPerInch converts print lengths to pixels at the image DPI
+const millimetresPerInch = 25.4
+
+// SplitPages renders the grid and slices it into pages of the configured paper size at the image DPI, so that a
+// large board can be printed on several sheets and assembled. Pages run left to right, then top to bottom, and the
+// content of neighboring pages overlaps by the configured length. Links are not kept on the pages
+func (g *Gridder) SplitPages(printConfigs ...PrintConfig) (*Document, error) {
+	printConfig := getFirstPrintConfig(printConfigs...)
+	dpi := g.imageConfig.GetDPI()
+	toPixels := func(millimetres float64) int {
+		return int(math.Round(millimetres * dpi / millimetresPerInch))
+	}
+
+	pageWidth, pageHeight := toPixels(printConfig.GetPageWidth()), toPixels(printConfig.GetPageHeight())
+	margin, overlap := toPixels(printConfig.GetMargin()), toPixels(printConfig.GetOverlap())
+	contentWidth, contentHeight := pageWidth-2*margin, pageHeight-2*margin
+	if contentWidth <= overlap || contentHeight <= overlap {
+		return nil, fmt.Errorf("%w: no room for content on a page of %gx%gmm", errInvalidValue, printConfig.GetPageWidth(), printConfig.GetPageHeight())
+	}
+
+	img := g.image()
+	bounds := img.Bounds()
+	columns := printPageCount(bounds.Dx(), contentWidth, overlap)
+	rows := printPageCount(bounds.Dy(), contentHeight, overlap)
+
+	document := NewDocument()
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			x := bounds.Min.X + column*(contentWidth-overlap)
+			y := bounds.Min.Y + row*(contentHeight-overlap)
+			content := image.Rect(x, y, x+contentWidth, y+contentHeight).Intersect(bounds)
+
+			page := image.NewRGBA(image.Rect(0, 0, pageWidth, pageHeight))
+			target := image.Rect(margin, margin, margin+content.Dx(), margin+content.Dy())
+			draw.Draw(page, target, img, content.Min, draw.Over)
+			drawPrintMarks(page, target, 0, float64(margin), dpi, printConfig)
+
+			document.pages = append(document.pages, documentPage{img: page, dpi: dpi, title: fmt.Sprintf("row %d, column %d", row+1, column+1)})
+		}
+	}
+	return document, nil
+}
+
+// printPageCount gets the number of pages covering a length of content, with pages advancing by their content
+// length less the overlap
+func printPageCount(length int, contentLength int, overlap int) int {
+	if length <= contentLength {
+		return 1
+	}
+	step := contentLength - overlap
+	return 1 + (length-contentLength+step-1)/step
+}
+
+// PrintPage renders the grid for professional printing, on a page holding the grid as the trim area, the bleed
+// around it and a margin of the configured size for the crop and registration marks. The bleed repeats the edge
+// pixels of the grid, and PDF output of the page carries its trim and bleed boxes
+func (g *Gridder) PrintPage(printConfigs ...PrintConfig) (*Document, error) {
+	printConfig := getFirstPrintConfig(printConfigs...)
+	dpi := g.imageConfig.GetDPI()
+	bleed := int(math.Round(printConfig.GetBleed() * dpi / millimetresPerInch))
+	margin := int(math.Round(printConfig.GetMargin() * dpi / millimetresPerInch))
+
+	img := g.image()
+	bounds := img.Bounds()
+	trim := image.Rect(margin+bleed, margin+bleed, margin+bleed+bounds.Dx(), margin+bleed+bounds.Dy())
+	page := image.NewRGBA(trim.Inset(-bleed - margin))
+	bleedImage(page, trim.Inset(-bleed), img)
+	drawPrintMarks(page, trim, float64(bleed), float64(margin), dpi, printConfig)
+
+	document := NewDocument()
+	document.pages = append(document.pages, documentPage{img: page, dpi: dpi, trimBox: trim, bleedBox: trim.Inset(-bleed)})
+	return document, nil
+}
+
+// bleedImage draws an image at the middle of an area, filling the rest of the area with the nearest edge pixels
+func bleedImage(dst *image.RGBA, area image.Rectangle, img image.Image) {
+	bounds := img.Bounds()
+	offsetX, offsetY := area.Min.X+(area.Dx()-bounds.Dx())/2, area.Min.Y+(area.Dy()-bounds.Dy())/2
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		sourceY := minInt(maxInt(bounds.Min.Y+y-offsetY, bounds.Min.Y), bounds.Max.Y-1)
+		for x := area.Min.X; x < area.Max.X; x++ {
+			sourceX := minInt(maxInt(bounds.Min.X+x-offsetX, bounds.Min.X), bounds.Max.X-1)
+			dst.Set(x, y, img.At(sourceX, sourceY))
+		}
+	}
+}
+
+// drawPrintMarks draws the configured marks around the trim area of a page. Crop marks continue the trim edges at
+// each corner past the bleed, and registration marks are centered in the margin at the middle of every side
+func drawPrintMarks(page *image.RGBA, trim image.Rectangle, bleed float64, margin float64, dpi float64, printConfig PrintConfig) {
+	ctx := gg.NewContextForRGBA(page)
+	ctx.SetColor(printConfig.GetCropMarkColor())
+	ctx.SetLineWidth(math.Max(1, defaultPrintCropMarkWidth*dpi/millimetresPerInch))
+
+	// marks keep clear of the bleed by a quarter of the margin
+	offset := bleed + margin/4
+	minX, minY, maxX, maxY := float64(trim.Min.X), float64(trim.Min.Y), float64(trim.Max.X), float64(trim.Max.Y)
+	if printConfig.IsCropMarks() {
+		length := math.Min(printConfig.GetCropMarkLength()*dpi/millimetresPerInch, margin*3/4)
+		for _, x := range []float64{minX, maxX} {
+			for _, y := range []float64{minY, maxY} {
+				// marks point away from the trim area
+				dx, dy := -1.0, -1.0
+				if x == maxX {
+					dx = 1
+				}
+				if y == maxY {
+					dy = 1
+				}
+				ctx.DrawLine(x+dx*offset, y, x+dx*(offset+length), y)
+				ctx.DrawLine(x, y+dy*offset, x, y+dy*(offset+length))
+			}
+		}
+	}
+
+	if printConfig.IsRegistrationMarks() {
+		radius := margin / 4
+		distance := offset + margin*3/8
+		centerX, centerY := (minX+maxX)/2, (minY+maxY)/2
+		for _, center := range []gg.Point{{X: centerX, Y: minY - distance}, {X: centerX, Y: maxY + distance}, {X: minX - distance, Y: centerY}, {X: maxX + distance, Y: centerY}} {
+			ctx.DrawCircle(center.X, center.Y, radius)
+			ctx.DrawLine(center.X-radius*1.5, center.Y, center.X+radius*1.5, center.Y)
+			ctx.DrawLine(center.X, center.Y-radius*1.5, center.X, center.Y+radius*1.5)
+		}
+	}
+	ctx.Stroke()
+}
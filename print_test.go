@@ -0,0 +1,79 @@
+package gridder
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitPages(t *testing.T) {
+	// at 25.4 DPI a millimetre is a pixel
+	gridder, err := New(ImageConfig{Width: 250, Height: 100, DPI: 25.4}, GridConfig{Rows: 2, Columns: 5, BackgroundColor: color.White})
+	assert.Nil(t, err)
+
+	document, err := gridder.SplitPages(PrintConfig{PageWidth: 120, PageHeight: 120, Margin: 10, Overlap: 20, CropMarks: true, CropMarkColor: color.Black})
+	assert.Nil(t, err)
+	assert.Equal(t, document.GetPageCount(), 3)
+	assert.Equal(t, document.pages[2].title, "row 1, column 3")
+
+	page := document.pages[1].img
+	assert.Equal(t, page.Bounds(), image.Rect(0, 0, 120, 120))
+	assert.Equal(t, document.pages[1].dpi, 25.4)
+	assert.Equal(t, color.RGBAModel.Convert(page.At(0, 0)), color.RGBA{})
+	assert.Equal(t, color.RGBAModel.Convert(page.At(60, 60)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(page.At(60, 115)), color.RGBA{})
+
+	// the last page holds the remaining 10 pixels wide strip after advancing twice by 80 pixels
+	last := document.pages[2].img
+	assert.Equal(t, color.RGBAModel.Convert(last.At(95, 60)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(last.At(105, 60)), color.RGBA{})
+
+	// crop marks continue the top edge of the content to its left
+	_, _, _, alpha := page.At(5, 10).RGBA()
+	assert.True(t, alpha > 0)
+
+	_, err = gridder.SplitPages(PrintConfig{PageWidth: 30, PageHeight: 30, Margin: 10, Overlap: 10})
+	assert.NotNil(t, err)
+}
+
+func TestPrintPageCount(t *testing.T) {
+	assert.Equal(t, printPageCount(50, 100, 10), 1)
+	assert.Equal(t, printPageCount(100, 100, 10), 1)
+	assert.Equal(t, printPageCount(101, 100, 10), 2)
+	assert.Equal(t, printPageCount(190, 100, 10), 2)
+	assert.Equal(t, printPageCount(191, 100, 10), 3)
+}
+
+func TestPrintPage(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 50, DPI: 25.4}, GridConfig{Rows: 1, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.NRGBA{R: 255, A: 255}))
+
+	document, err := gridder.PrintPage(PrintConfig{Margin: 8, Bleed: 3, CropMarks: true, RegistrationMarks: true})
+	assert.Nil(t, err)
+	assert.Equal(t, document.GetPageCount(), 1)
+
+	page := document.pages[0]
+	assert.Equal(t, page.img.Bounds(), image.Rect(0, 0, 122, 72))
+	assert.Equal(t, page.trimBox, image.Rect(11, 11, 111, 61))
+	assert.Equal(t, page.bleedBox, image.Rect(8, 8, 114, 64))
+
+	// the bleed repeats the edge pixels of the grid
+	assert.Equal(t, color.RGBAModel.Convert(page.img.At(8, 36)), color.RGBA{R: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(page.img.At(113, 36)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(page.img.At(7, 36)), color.RGBA{})
+
+	// a crop mark continues the left trim edge above the bleed, and a registration mark crosses the top margin
+	_, _, _, alpha := page.img.At(11, 3).RGBA()
+	assert.True(t, alpha > 0)
+	_, _, _, alpha = page.img.At(61, 3).RGBA()
+	assert.True(t, alpha > 0)
+
+	var buffer bytes.Buffer
+	assert.Nil(t, document.EncodePDF(&buffer))
+	assert.True(t, bytes.Contains(buffer.Bytes(), []byte("/TrimBox [")))
+	assert.True(t, bytes.Contains(buffer.Bytes(), []byte("/BleedBox [")))
+}
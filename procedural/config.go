@@ -0,0 +1,92 @@
+package procedural
+
+import (
+	"image/color"
+
+	"github.com/rageofgods/gridder"
+)
+
+const (
+	defaultMazeCellSize = 10
+
+	defaultNoiseCellSize = 10
+	defaultNoiseScale    = 8.0
+	defaultNoiseOctaves  = 4
+)
+
+var (
+	defaultMazeWallColor    = color.Black
+	defaultMazePassageColor = color.White
+
+	defaultNoiseColormap = gridder.LinearColormap(color.NRGBA{R: 20, G: 30, B: 90, A: 255}, color.NRGBA{R: 250, G: 240, B: 200, A: 255})
+)
+
+// MazeConfig Maze Configuration
+type MazeConfig struct {
+	WallColor    color.Color
+	PassageColor color.Color
+}
+
+// GetWallColor gets the color of the walls
+func (g *MazeConfig) GetWallColor() color.Color {
+	if g.WallColor == nil {
+		return defaultMazeWallColor
+	}
+	return g.WallColor
+}
+
+// GetPassageColor gets the color of the passages
+func (g *MazeConfig) GetPassageColor() color.Color {
+	if g.PassageColor == nil {
+		return defaultMazePassageColor
+	}
+	return g.PassageColor
+}
+
+// NoiseConfig Noise Heatmap Configuration
+type NoiseConfig struct {
+	// Scale is the number of cells per unit of noise, larger scales give smoother heatmaps
+	Scale float64
+	// Octaves is the number of layers of noise of doubling frequency and halving amplitude
+	Octaves int
+	// Colormap maps noise values between 0 and 1 to colors
+	Colormap func(fraction float64) color.Color
+}
+
+// GetScale gets the number of cells per unit of noise
+func (g *NoiseConfig) GetScale() float64 {
+	if g.Scale <= 0 {
+		return defaultNoiseScale
+	}
+	return g.Scale
+}
+
+// GetOctaves gets the number of layers of noise
+func (g *NoiseConfig) GetOctaves() int {
+	if g.Octaves <= 0 {
+		return defaultNoiseOctaves
+	}
+	return g.Octaves
+}
+
+// GetColor gets the color of a noise value between 0 and 1
+func (g *NoiseConfig) GetColor(fraction float64) color.Color {
+	if g.Colormap == nil {
+		return defaultNoiseColormap(fraction)
+	}
+	return g.Colormap(fraction)
+}
+
+func getFirstMazeConfig(configs ...MazeConfig) MazeConfig {
+	if len(configs) == 0 {
+		return MazeConfig{}
+	}
+	return configs[0]
+}
+
+func getFirstNoiseConfig(configs ...NoiseConfig) NoiseConfig {
+	if len(configs) == 0 {
+		return NoiseConfig{}
+	}
+	return configs[0]
+}
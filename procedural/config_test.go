@@ -0,0 +1,46 @@
+package procedural
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMazeConfig(t *testing.T) {
+	config1 := &MazeConfig{}
+	assert.Equal(t, config1.GetWallColor(), defaultMazeWallColor)
+	assert.Equal(t, config1.GetPassageColor(), defaultMazePassageColor)
+
+	config2 := &MazeConfig{WallColor: color.White, PassageColor: color.Black}
+	assert.Equal(t, config2.GetWallColor(), color.White)
+	assert.Equal(t, config2.GetPassageColor(), color.Black)
+}
+
+func TestNoiseConfig(t *testing.T) {
+	config1 := &NoiseConfig{}
+	assert.Equal(t, config1.GetScale(), defaultNoiseScale)
+	assert.Equal(t, config1.GetOctaves(), defaultNoiseOctaves)
+	assert.Equal(t, config1.GetColor(0), color.NRGBA{R: 20, G: 30, B: 90, A: 255})
+
+	config2 := &NoiseConfig{Scale: 2, Octaves: 1, Colormap: func(float64) color.Color { return color.White }}
+	assert.Equal(t, config2.GetScale(), 2.0)
+	assert.Equal(t, config2.GetOctaves(), 1)
+	assert.Equal(t, config2.GetColor(0), color.White)
+}
+
+func TestFirstMazeConfig(t *testing.T) {
+	config1 := getFirstMazeConfig()
+	assert.Equal(t, config1, MazeConfig{})
+
+	config2 := getFirstMazeConfig(config1)
+	assert.Equal(t, config2, config1)
+}
+
+func TestFirstNoiseConfig(t *testing.T) {
+	config1 := getFirstNoiseConfig()
+	assert.Equal(t, config1, NoiseConfig{})
+
+	config2 := getFirstNoiseConfig(config1)
+	assert.Equal(t, config2, config1)
+}
@@ -0,0 +1,200 @@
+package procedural
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/rageofgods/gridder"
+)
+
+// MazeAlgorithm is an algorithm generating perfect mazes, with exactly one path between any two rooms
+type MazeAlgorithm int
+
+const (
+	// RecursiveBacktracker carves a random walk, backing up at dead ends, giving long winding corridors
+	RecursiveBacktracker MazeAlgorithm = iota
+	// Prim grows the maze from a room by opening random walls along its frontier, giving many short dead ends
+	Prim
+)
+
+// Maze is a maze of rooms in rows and columns, with walls between neighboring rooms
+type Maze struct {
+	Rows    int
+	Columns int
+
+	// east and south are open when the wall to the room right of or below a room is carved away
+	east  [][]bool
+	south [][]bool
+}
+
+// mazeRoom is a room of a maze
+type mazeRoom struct {
+	row    int
+	column int
+}
+
+// NewMaze generates a maze of rows and columns of rooms with an algorithm and a seed
+func NewMaze(rows int, columns int, algorithm MazeAlgorithm, seed int64) (*Maze, error) {
+	if rows <= 0 || columns <= 0 {
+		return nil, fmt.Errorf("%w: maze of %d by %d rooms", errInvalidValue, rows, columns)
+	}
+
+	m := &Maze{Rows: rows, Columns: columns, east: make([][]bool, rows), south: make([][]bool, rows)}
+	for row := range m.east {
+		m.east[row] = make([]bool, columns)
+		m.south[row] = make([]bool, columns)
+	}
+
+	random := rand.New(rand.NewSource(seed))
+	switch algorithm {
+	case RecursiveBacktracker:
+		m.carveBacktracking(random)
+	case Prim:
+		m.carvePrim(random)
+	default:
+		return nil, fmt.Errorf("%w: maze algorithm %d", errInvalidValue, algorithm)
+	}
+	return m, nil
+}
+
+// Passages gets the maze as a matrix of 2*Rows+1 by 2*Columns+1 cells, with walls and rooms alternating, where
+// passages are true and walls false
+func (m *Maze) Passages() [][]bool {
+	passages := make([][]bool, 2*m.Rows+1)
+	for row := range passages {
+		passages[row] = make([]bool, 2*m.Columns+1)
+	}
+	for row := 0; row < m.Rows; row++ {
+		for column := 0; column < m.Columns; column++ {
+			passages[2*row+1][2*column+1] = true
+			passages[2*row+1][2*column+2] = m.east[row][column]
+			passages[2*row+2][2*column+1] = m.south[row][column]
+		}
+	}
+	return passages
+}
+
+// Render creates a grid of the passages of the maze, sized for square cells unless the image dimensions are set
+func (m *Maze) Render(imageConfig gridder.ImageConfig, mazeConfigs ...MazeConfig) (*gridder.Gridder, error) {
+	mazeConfig := getFirstMazeConfig(mazeConfigs...)
+	passages := m.Passages()
+	rows, columns := len(passages), len(passages[0])
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultMazeCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = rows * defaultMazeCellSize
+	}
+
+	g, err := gridder.New(imageConfig, gridder.GridConfig{
+		Rows:            rows,
+		Columns:         columns,
+		BackgroundColor: mazeConfig.GetPassageColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for row := range passages {
+		for column, passage := range passages[row] {
+			if passage {
+				continue
+			}
+			err = g.PaintCell(row, column, mazeConfig.GetWallColor())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return g, nil
+}
+
+// carveBacktracking carves the maze with a random walk from the top left room, backing up to the last room with an
+// unvisited neighbor at every dead end
+func (m *Maze) carveBacktracking(random *rand.Rand) {
+	visited := m.newVisited()
+	stack := []mazeRoom{{}}
+	visited[0][0] = true
+	for len(stack) > 0 {
+		room := stack[len(stack)-1]
+		neighbors := m.neighbors(room, visited, false)
+		if len(neighbors) == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		next := neighbors[random.Intn(len(neighbors))]
+		m.open(room, next)
+		visited[next.row][next.column] = true
+		stack = append(stack, next)
+	}
+}
+
+// carvePrim carves the maze from a random room, repeatedly connecting a random frontier room to a random visited
+// neighbor
+func (m *Maze) carvePrim(random *rand.Rand) {
+	visited := m.newVisited()
+	inFrontier := m.newVisited()
+	var frontier []mazeRoom
+	visit := func(room mazeRoom) {
+		visited[room.row][room.column] = true
+		for _, neighbor := range m.neighbors(room, visited, false) {
+			if !inFrontier[neighbor.row][neighbor.column] {
+				inFrontier[neighbor.row][neighbor.column] = true
+				frontier = append(frontier, neighbor)
+			}
+		}
+	}
+
+	visit(mazeRoom{row: random.Intn(m.Rows), column: random.Intn(m.Columns)})
+	for len(frontier) > 0 {
+		index := random.Intn(len(frontier))
+		room := frontier[index]
+		frontier[index] = frontier[len(frontier)-1]
+		frontier = frontier[:len(frontier)-1]
+
+		neighbors := m.neighbors(room, visited, true)
+		m.open(room, neighbors[random.Intn(len(neighbors))])
+		visit(room)
+	}
+}
+
+// newVisited creates a matrix marking the rooms of the maze
+func (m *Maze) newVisited() [][]bool {
+	visited := make([][]bool, m.Rows)
+	for row := range visited {
+		visited[row] = make([]bool, m.Columns)
+	}
+	return visited
+}
+
+// neighbors gets the rooms next to a room that are visited or not, in a fixed order
+func (m *Maze) neighbors(room mazeRoom, visited [][]bool, wantVisited bool) []mazeRoom {
+	var neighbors []mazeRoom
+	for _, neighbor := range []mazeRoom{
+		{row: room.row - 1, column: room.column},
+		{row: room.row, column: room.column + 1},
+		{row: room.row + 1, column: room.column},
+		{row: room.row, column: room.column - 1},
+	} {
+		if neighbor.row < 0 || neighbor.row >= m.Rows || neighbor.column < 0 || neighbor.column >= m.Columns {
+			continue
+		}
+		if visited[neighbor.row][neighbor.column] == wantVisited {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	return neighbors
+}
+
+// open carves away the wall between two neighboring rooms
+func (m *Maze) open(room1 mazeRoom, room2 mazeRoom) {
+	if room2.row < room1.row || room2.column < room1.column {
+		room1, room2 = room2, room1
+	}
+	if room2.row > room1.row {
+		m.south[room1.row][room1.column] = true
+		return
+	}
+	m.east[room1.row][room1.column] = true
+}
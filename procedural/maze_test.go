@@ -0,0 +1,71 @@
+package procedural
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/rageofgods/gridder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMaze(t *testing.T) {
+	for _, algorithm := range []MazeAlgorithm{RecursiveBacktracker, Prim} {
+		maze, err := NewMaze(8, 12, algorithm, 42)
+		assert.Nil(t, err)
+
+		again, _ := NewMaze(8, 12, algorithm, 42)
+		assert.Equal(t, maze.Passages(), again.Passages())
+
+		// a perfect maze connects every room with one less opening than rooms, so it is a spanning tree
+		passages := maze.Passages()
+		assert.Equal(t, len(passages), 17)
+		assert.Equal(t, len(passages[0]), 25)
+		openings := 0
+		for row := range passages {
+			for column, passage := range passages[row] {
+				if passage && (row%2 == 0 || column%2 == 0) {
+					openings++
+				}
+			}
+		}
+		assert.Equal(t, openings, 8*12-1)
+		assert.Equal(t, countReachable(passages), 8*12)
+	}
+
+	_, err := NewMaze(0, 1, Prim, 1)
+	assert.True(t, errors.Is(err, errInvalidValue))
+	_, err = NewMaze(1, 1, MazeAlgorithm(5), 1)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestMazeRender(t *testing.T) {
+	maze, _ := NewMaze(2, 3, RecursiveBacktracker, 1)
+	g, err := maze.Render(gridder.ImageConfig{}, MazeConfig{WallColor: color.NRGBA{R: 255, A: 255}})
+	assert.Nil(t, err)
+	gridConfig := g.GridConfig()
+	assert.Equal(t, gridConfig.GetRows(), 5)
+	assert.Equal(t, gridConfig.GetColumns(), 7)
+}
+
+// countReachable counts the rooms reachable through the passages from the top left room
+func countReachable(passages [][]bool) int {
+	seen := map[[2]int]bool{{1, 1}: true}
+	queue := [][2]int{{1, 1}}
+	rooms := 0
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+		if cell[0]%2 == 1 && cell[1]%2 == 1 {
+			rooms++
+		}
+		for _, step := range [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+			next := [2]int{cell[0] + step[0], cell[1] + step[1]}
+			if passages[next[0]][next[1]] && !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return rooms
+}
@@ -0,0 +1,165 @@
+package procedural
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/rageofgods/gridder"
+)
+
+// Noise is smooth gradient noise in two dimensions, between -1 and 1
+type Noise interface {
+	At(x float64, y float64) float64
+}
+
+// noiseGradients are the directions of the gradients at the lattice points
+var noiseGradients = [8][2]float64{
+	{1, 0}, {-1, 0}, {0, 1}, {0, -1},
+	{math.Sqrt2 / 2, math.Sqrt2 / 2}, {-math.Sqrt2 / 2, math.Sqrt2 / 2}, {math.Sqrt2 / 2, -math.Sqrt2 / 2}, {-math.Sqrt2 / 2, -math.Sqrt2 / 2},
+}
+
+// permutation is a seeded shuffle of the lattice hashes, repeated so that lookups of sums need no wrapping
+type permutation [512]int
+
+func newPermutation(seed int64) *permutation {
+	var p permutation
+	for i, value := range rand.New(rand.NewSource(seed)).Perm(256) {
+		p[i] = value
+		p[i+256] = value
+	}
+	return &p
+}
+
+// gradient gets the dot product of the gradient at a lattice point with the offset from it
+func (p *permutation) gradient(x int, y int, dx float64, dy float64) float64 {
+	g := noiseGradients[p[p[x&255]+y&255]&7]
+	return g[0]*dx + g[1]*dy
+}
+
+// Perlin is Perlin's improved gradient noise
+type Perlin struct {
+	permutation *permutation
+}
+
+// NewPerlin creates Perlin noise with a seed
+func NewPerlin(seed int64) *Perlin {
+	return &Perlin{permutation: newPermutation(seed)}
+}
+
+// At gets the noise at a point, interpolating the gradients of the four corners of its lattice square
+func (p *Perlin) At(x float64, y float64) float64 {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	dx, dy := x-x0, y-y0
+	ix, iy := int(x0), int(y0)
+	fade := func(t float64) float64 {
+		return t * t * t * (t*(t*6-15) + 10)
+	}
+	lerp := func(a float64, b float64, t float64) float64 {
+		return a + (b-a)*t
+	}
+
+	u, v := fade(dx), fade(dy)
+	top := lerp(p.permutation.gradient(ix, iy, dx, dy), p.permutation.gradient(ix+1, iy, dx-1, dy), u)
+	bottom := lerp(p.permutation.gradient(ix, iy+1, dx, dy-1), p.permutation.gradient(ix+1, iy+1, dx-1, dy-1), u)
+	// the largest value in two dimensions is the square root of a half
+	return math.Max(-1, math.Min(1, lerp(top, bottom, v)*math.Sqrt2))
+}
+
+// Simplex is simplex noise, summing the gradients of the three corners of a skewed triangular lattice. It has fewer
+// directional artifacts than Perlin noise
+type Simplex struct {
+	permutation *permutation
+}
+
+// NewSimplex creates simplex noise with a seed
+func NewSimplex(seed int64) *Simplex {
+	return &Simplex{permutation: newPermutation(seed)}
+}
+
+// At gets the noise at a point
+func (s *Simplex) At(x float64, y float64) float64 {
+	skew := (math.Sqrt(3) - 1) / 2
+	unskew := (3 - math.Sqrt(3)) / 6
+
+	offset := (x + y) * skew
+	i, j := math.Floor(x+offset), math.Floor(y+offset)
+	back := (i + j) * unskew
+	x0, y0 := x-(i-back), y-(j-back)
+
+	// the upper or lower triangle of the skewed square
+	i1, j1 := 0, 1
+	if x0 > y0 {
+		i1, j1 = 1, 0
+	}
+	corners := [3][4]float64{
+		{0, 0, x0, y0},
+		{float64(i1), float64(j1), x0 - float64(i1) + unskew, y0 - float64(j1) + unskew},
+		{1, 1, x0 - 1 + 2*unskew, y0 - 1 + 2*unskew},
+	}
+
+	var sum float64
+	for _, corner := range corners {
+		dx, dy := corner[2], corner[3]
+		t := 0.5 - dx*dx - dy*dy
+		if t <= 0 {
+			continue
+		}
+		t *= t
+		sum += t * t * s.permutation.gradient(int(i)+int(corner[0]), int(j)+int(corner[1]), dx, dy)
+	}
+	// scales the largest sums to about 1
+	return math.Max(-1, math.Min(1, 70*sum))
+}
+
+// NoiseValues samples fractal noise at the center of every cell, summing octaves of doubling frequency and halving
+// amplitude, with a unit of noise every scale cells. Values are between 0 and 1
+func NoiseValues(noise Noise, rows int, columns int, scale float64, octaves int) [][]float64 {
+	values := make([][]float64, rows)
+	for row := range values {
+		values[row] = make([]float64, columns)
+		for column := range values[row] {
+			x, y := (float64(column)+0.5)/scale, (float64(row)+0.5)/scale
+			var sum, total float64
+			amplitude := 1.0
+			for octave := 0; octave < octaves; octave++ {
+				sum += amplitude * noise.At(x, y)
+				total += amplitude
+				x, y, amplitude = x*2, y*2, amplitude/2
+			}
+			values[row][column] = (sum/total + 1) / 2
+		}
+	}
+	return values
+}
+
+// RenderNoise creates a heatmap of fractal noise on a grid of rows and columns, sized for square cells unless the
+// image dimensions are set
+func RenderNoise(noise Noise, rows int, columns int, imageConfig gridder.ImageConfig, noiseConfigs ...NoiseConfig) (*gridder.Gridder, error) {
+	if rows <= 0 || columns <= 0 {
+		return nil, fmt.Errorf("%w: noise of %d by %d cells", errInvalidValue, rows, columns)
+	}
+
+	noiseConfig := getFirstNoiseConfig(noiseConfigs...)
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultNoiseCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = rows * defaultNoiseCellSize
+	}
+
+	g, err := gridder.New(imageConfig, gridder.GridConfig{Rows: rows, Columns: columns})
+	if err != nil {
+		return nil, err
+	}
+
+	for row, values := range NoiseValues(noise, rows, columns, noiseConfig.GetScale(), noiseConfig.GetOctaves()) {
+		for column, value := range values {
+			err = g.PaintCell(row, column, noiseConfig.GetColor(value))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return g, nil
+}
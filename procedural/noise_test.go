@@ -0,0 +1,50 @@
+package procedural
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rageofgods/gridder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoise(t *testing.T) {
+	for _, noise := range []Noise{NewPerlin(7), NewSimplex(7)} {
+		var min, max float64
+		for y := 0.0; y < 8; y += 0.13 {
+			for x := 0.0; x < 8; x += 0.17 {
+				value := noise.At(x, y)
+				min, max = math.Min(min, value), math.Max(max, value)
+			}
+		}
+		assert.True(t, min >= -1 && min < -0.2)
+		assert.True(t, max <= 1 && max > 0.2)
+	}
+
+	// Perlin noise is zero on the lattice
+	assert.InDelta(t, NewPerlin(7).At(3, 5), 0, 1e-9)
+	assert.Equal(t, NewPerlin(1).At(0.3, 0.7), NewPerlin(1).At(0.3, 0.7))
+	assert.NotEqual(t, NewSimplex(1).At(0.3, 0.7), NewSimplex(2).At(0.3, 0.7))
+}
+
+func TestNoiseValues(t *testing.T) {
+	values := NoiseValues(NewPerlin(1), 4, 6, 3, 2)
+	assert.Equal(t, len(values), 4)
+	assert.Equal(t, len(values[0]), 6)
+	for _, row := range values {
+		for _, value := range row {
+			assert.True(t, value >= 0 && value <= 1)
+		}
+	}
+}
+
+func TestRenderNoise(t *testing.T) {
+	g, err := RenderNoise(NewSimplex(1), 4, 6, gridder.ImageConfig{})
+	assert.Nil(t, err)
+	gridConfig := g.GridConfig()
+	assert.Equal(t, gridConfig.GetColumns(), 6)
+
+	_, err = RenderNoise(NewSimplex(1), 0, 6, gridder.ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
@@ -0,0 +1,60 @@
+// Package procedural generates seeded random boards, mazes and noise heatmaps for demos, tests and placeholder art.
+// The same seed always generates the same board
+package procedural
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/rageofgods/gridder"
+)
+
+var (
+	errInvalidValue = errors.New("invalid value")
+)
+
+// FillStates sets the state of every unmasked cell of a grid to a random state, picked with a probability in
+// proportion to its weight. An empty state leaves cells without a state
+func FillStates(g *gridder.Gridder, seed int64, weights map[string]float64) error {
+	states := make([]string, 0, len(weights))
+	var total float64
+	for state, weight := range weights {
+		if weight < 0 {
+			return fmt.Errorf("%w: weight %v of state %q", errInvalidValue, weight, state)
+		}
+		states = append(states, state)
+		total += weight
+	}
+	if total <= 0 {
+		return fmt.Errorf("%w: no state weight", errInvalidValue)
+	}
+	// map order is random, sorting keeps the states of a seed
+	sort.Strings(states)
+
+	random := rand.New(rand.NewSource(seed))
+	gridConfig := g.GridConfig()
+	for row := 0; row < gridConfig.GetRows(); row++ {
+		for column := 0; column < gridConfig.GetColumns(); column++ {
+			pick := random.Float64() * total
+			state := states[len(states)-1]
+			for _, candidate := range states {
+				if pick < weights[candidate] {
+					state = candidate
+					break
+				}
+				pick -= weights[candidate]
+			}
+
+			if gridConfig.IsMasked(row, column) {
+				continue
+			}
+			err := g.SetState(row, column, state)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package procedural
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rageofgods/gridder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillStates(t *testing.T) {
+	states := func(seed int64) [][]string {
+		g, err := gridder.New(gridder.ImageConfig{Width: 100, Height: 100}, gridder.GridConfig{Rows: 10, Columns: 10})
+		assert.Nil(t, err)
+		assert.Nil(t, FillStates(g, seed, map[string]float64{"wall": 1, "floor": 3, "": 0}))
+
+		matrix := make([][]string, 10)
+		for row := range matrix {
+			matrix[row] = make([]string, 10)
+			for column := range matrix[row] {
+				matrix[row][column], _ = g.GetState(row, column)
+			}
+		}
+		return matrix
+	}
+
+	assert.Equal(t, states(1), states(1))
+	assert.NotEqual(t, states(1), states(2))
+
+	counts := make(map[string]int)
+	for _, row := range states(1) {
+		for _, state := range row {
+			counts[state]++
+		}
+	}
+	assert.Equal(t, counts[""], 0)
+	assert.Equal(t, counts["wall"]+counts["floor"], 100)
+	assert.True(t, counts["floor"] > counts["wall"])
+
+	g, _ := gridder.New(gridder.ImageConfig{}, gridder.GridConfig{Rows: 2, Columns: 2})
+	err := FillStates(g, 1, map[string]float64{"wall": 0})
+	assert.True(t, errors.Is(err, errInvalidValue))
+	err = FillStates(g, 1, map[string]float64{"wall": -1, "floor": 2})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
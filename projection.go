@@ -0,0 +1,97 @@
+package gridder
+
+import (
+	"math"
+)
+
+// webMercatorMaxLatitude is the latitude where Web Mercator maps become square, tiles stop there
+const webMercatorMaxLatitude = 85.0511287798066
+
+// CoordinateTransform maps geographic coordinates to fractional cell positions and back. A position of row and
+// column 0 is the top left corner of the top left cell, and 0.5 its center
+type CoordinateTransform interface {
+	ToCell(latitude float64, longitude float64) (row float64, column float64)
+	FromCell(row float64, column float64) (latitude float64, longitude float64)
+}
+
+// WebMercatorTiles maps the slippy map tiles of a zoom level to cells, as used by OpenStreetMap and most web maps.
+// The top left cell is the tile at X and Y, counted from the west and the north
+type WebMercatorTiles struct {
+	Zoom int
+	X    int
+	Y    int
+}
+
+// ToCell gets the position of a latitude and longitude in tiles from the top left tile, clamping latitudes to the
+// range of Web Mercator
+func (w WebMercatorTiles) ToCell(latitude float64, longitude float64) (float64, float64) {
+	tiles := math.Exp2(float64(w.Zoom))
+	latitude = math.Max(-webMercatorMaxLatitude, math.Min(webMercatorMaxLatitude, latitude))
+	sin := math.Sin(latitude * math.Pi / 180)
+	y := (0.5 - math.Log((1+sin)/(1-sin))/(4*math.Pi)) * tiles
+	x := (longitude + 180) / 360 * tiles
+	return y - float64(w.Y), x - float64(w.X)
+}
+
+// FromCell gets the latitude and longitude of a position in tiles from the top left tile
+func (w WebMercatorTiles) FromCell(row float64, column float64) (float64, float64) {
+	tiles := math.Exp2(float64(w.Zoom))
+	y, x := row+float64(w.Y), column+float64(w.X)
+	latitude := math.Atan(math.Sinh(math.Pi*(1-2*y/tiles))) * 180 / math.Pi
+	longitude := x/tiles*360 - 180
+	return latitude, longitude
+}
+
+// ToCell gets the position of a latitude and longitude in cells of the geographic grid
+func (b GeoGrid) ToCell(latitude float64, longitude float64) (float64, float64) {
+	return (b.North - latitude) / b.Resolution, (longitude - b.West) / b.Resolution
+}
+
+// FromCell gets the latitude and longitude of a position in cells of the geographic grid
+func (b GeoGrid) FromCell(row float64, column float64) (float64, float64) {
+	return b.North - row*b.Resolution, b.West + column*b.Resolution
+}
+
+// SetCoordinateTransform registers the transform between geographic coordinates and cells, nil removes it
+func (g *Gridder) SetCoordinateTransform(transform CoordinateTransform) {
+	if g.parent != nil {
+		g.parent.SetCoordinateTransform(transform)
+		return
+	}
+	g.coordinateTransform = transform
+}
+
+// CellForLatLon gets the cell containing a latitude and longitude with the coordinate transform. It returns false
+// without a transform, for invalid coordinates and outside of the grid
+func (g *Gridder) CellForLatLon(latitude float64, longitude float64) (Cell, bool) {
+	if g.parent != nil {
+		return g.parent.CellForLatLon(latitude, longitude)
+	}
+	if g.coordinateTransform == nil || validateValues(finite("latitude", latitude), finite("longitude", longitude)) != nil {
+		return Cell{}, false
+	}
+
+	row, column := g.coordinateTransform.ToCell(latitude, longitude)
+	if math.IsNaN(row) || math.IsNaN(column) {
+		return Cell{}, false
+	}
+	cell := Cell{Row: int(math.Floor(row)), Column: int(math.Floor(column))}
+	if row < 0 || column < 0 || cell.Row >= g.gridConfig.GetRows() || cell.Column >= g.gridConfig.GetColumns() {
+		return Cell{}, false
+	}
+	return cell, true
+}
+
+// LatLonForCell gets the latitude and longitude of the center of a cell with the coordinate transform. It returns
+// false without a transform
+func (g *Gridder) LatLonForCell(row int, column int) (float64, float64, bool) {
+	if g.parent != nil {
+		return g.parent.LatLonForCell(row, column)
+	}
+	if g.coordinateTransform == nil {
+		return 0, 0, false
+	}
+
+	latitude, longitude := g.coordinateTransform.FromCell(float64(row)+0.5, float64(column)+0.5)
+	return latitude, longitude, true
+}
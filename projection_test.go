@@ -0,0 +1,61 @@
+package gridder
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebMercatorTiles(t *testing.T) {
+	tiles := WebMercatorTiles{Zoom: 10, X: 548, Y: 334}
+	row, column := tiles.ToCell(52.52, 13.405)
+	assert.Equal(t, int(row), 1)
+	assert.Equal(t, int(column), 2)
+
+	latitude, longitude := tiles.FromCell(row, column)
+	assert.InDelta(t, latitude, 52.52, 1e-9)
+	assert.InDelta(t, longitude, 13.405, 1e-9)
+
+	// the whole world is a single tile at zoom 0
+	world := WebMercatorTiles{}
+	row, column = world.ToCell(90, -180)
+	assert.InDelta(t, row, 0, 1e-9)
+	assert.Equal(t, column, 0.0)
+	latitude, longitude = world.FromCell(0.5, 0.5)
+	assert.InDelta(t, latitude, 0, 1e-9)
+	assert.InDelta(t, longitude, 0, 1e-9)
+}
+
+func TestCellForLatLon(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 400, Height: 400}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+
+	_, ok := gridder.CellForLatLon(52.52, 13.405)
+	assert.False(t, ok)
+	_, _, ok = gridder.LatLonForCell(0, 0)
+	assert.False(t, ok)
+
+	gridder.SetCoordinateTransform(WebMercatorTiles{Zoom: 10, X: 548, Y: 334})
+	cell, ok := gridder.CellForLatLon(52.52, 13.405)
+	assert.True(t, ok)
+	assert.Equal(t, cell, Cell{Row: 1, Column: 2})
+
+	_, ok = gridder.CellForLatLon(48.85, 2.35)
+	assert.False(t, ok)
+	_, ok = gridder.CellForLatLon(math.NaN(), 0)
+	assert.False(t, ok)
+
+	latitude, longitude, ok := gridder.LatLonForCell(1, 2)
+	assert.True(t, ok)
+	cell, _ = gridder.CellForLatLon(latitude, longitude)
+	assert.Equal(t, cell, Cell{Row: 1, Column: 2})
+
+	gridder.SetCoordinateTransform(GeoGrid{West: 0, South: 0, East: 4, North: 4, Resolution: 1})
+	cell, ok = gridder.CellForLatLon(3.5, 0.5)
+	assert.True(t, ok)
+	assert.Equal(t, cell, Cell{Row: 0, Column: 0})
+	latitude, longitude, _ = gridder.LatLonForCell(3, 3)
+	assert.Equal(t, latitude, 0.5)
+	assert.Equal(t, longitude, 3.5)
+}
@@ -0,0 +1,756 @@
+// Protocol buffer definition of the gridder replay format, the binary counterpart of the JSON written by
+// ExportReplay. Backends in other languages can generate types from it to emit render jobs for LoadReplayProto.
+// Fields follow the JSON format and are only ever added, field numbers are never reused.
+// Regenerate the Go code in replaypb with protoc-gen-go from the module root, using module=github.com/rageofgods/gridder
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/replay.proto
+
+package replaypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Replay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version of the replay format, older versions are migrated on load
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// width and height of the recorded image in pixels, lengths are scaled from them
+	Width         int32      `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32      `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Grid          *Grid      `protobuf:"bytes,4,opt,name=grid,proto3" json:"grid,omitempty"`
+	Commands      []*Command `protobuf:"bytes,5,rep,name=commands,proto3" json:"commands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Replay) Reset() {
+	*x = Replay{}
+	mi := &file_proto_replay_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Replay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Replay) ProtoMessage() {}
+
+func (x *Replay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_replay_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Replay.ProtoReflect.Descriptor instead.
+func (*Replay) Descriptor() ([]byte, []int) {
+	return file_proto_replay_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Replay) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Replay) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Replay) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Replay) GetGrid() *Grid {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Replay) GetCommands() []*Command {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+type Grid struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Rows              int32                  `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns           int32                  `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`
+	Margin            int32                  `protobuf:"varint,3,opt,name=margin,proto3" json:"margin,omitempty"`
+	RowOffsets        []*Offset              `protobuf:"bytes,4,rep,name=row_offsets,json=rowOffsets,proto3" json:"row_offsets,omitempty"`
+	ColumnOffsets     []*Offset              `protobuf:"bytes,5,rep,name=column_offsets,json=columnOffsets,proto3" json:"column_offsets,omitempty"`
+	LineDashes        float64                `protobuf:"fixed64,6,opt,name=line_dashes,json=lineDashes,proto3" json:"line_dashes,omitempty"`
+	LineStrokeWidth   float64                `protobuf:"fixed64,7,opt,name=line_stroke_width,json=lineStrokeWidth,proto3" json:"line_stroke_width,omitempty"`
+	BorderDashes      float64                `protobuf:"fixed64,8,opt,name=border_dashes,json=borderDashes,proto3" json:"border_dashes,omitempty"`
+	BorderStrokeWidth float64                `protobuf:"fixed64,9,opt,name=border_stroke_width,json=borderStrokeWidth,proto3" json:"border_stroke_width,omitempty"`
+	// colors are hex strings such as "#ff0000ff"
+	LineColor       string `protobuf:"bytes,10,opt,name=line_color,json=lineColor,proto3" json:"line_color,omitempty"`
+	BorderColor     string `protobuf:"bytes,11,opt,name=border_color,json=borderColor,proto3" json:"border_color,omitempty"`
+	BackgroundColor string `protobuf:"bytes,12,opt,name=background_color,json=backgroundColor,proto3" json:"background_color,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Grid) Reset() {
+	*x = Grid{}
+	mi := &file_proto_replay_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Grid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grid) ProtoMessage() {}
+
+func (x *Grid) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_replay_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grid.ProtoReflect.Descriptor instead.
+func (*Grid) Descriptor() ([]byte, []int) {
+	return file_proto_replay_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Grid) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *Grid) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *Grid) GetMargin() int32 {
+	if x != nil {
+		return x.Margin
+	}
+	return 0
+}
+
+func (x *Grid) GetRowOffsets() []*Offset {
+	if x != nil {
+		return x.RowOffsets
+	}
+	return nil
+}
+
+func (x *Grid) GetColumnOffsets() []*Offset {
+	if x != nil {
+		return x.ColumnOffsets
+	}
+	return nil
+}
+
+func (x *Grid) GetLineDashes() float64 {
+	if x != nil {
+		return x.LineDashes
+	}
+	return 0
+}
+
+func (x *Grid) GetLineStrokeWidth() float64 {
+	if x != nil {
+		return x.LineStrokeWidth
+	}
+	return 0
+}
+
+func (x *Grid) GetBorderDashes() float64 {
+	if x != nil {
+		return x.BorderDashes
+	}
+	return 0
+}
+
+func (x *Grid) GetBorderStrokeWidth() float64 {
+	if x != nil {
+		return x.BorderStrokeWidth
+	}
+	return 0
+}
+
+func (x *Grid) GetLineColor() string {
+	if x != nil {
+		return x.LineColor
+	}
+	return ""
+}
+
+func (x *Grid) GetBorderColor() string {
+	if x != nil {
+		return x.BorderColor
+	}
+	return ""
+}
+
+func (x *Grid) GetBackgroundColor() string {
+	if x != nil {
+		return x.BackgroundColor
+	}
+	return ""
+}
+
+type Offset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Offset        float64                `protobuf:"fixed64,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Offset) Reset() {
+	*x = Offset{}
+	mi := &file_proto_replay_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Offset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offset) ProtoMessage() {}
+
+func (x *Offset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_replay_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offset.ProtoReflect.Descriptor instead.
+func (*Offset) Descriptor() ([]byte, []int) {
+	return file_proto_replay_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Offset) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Offset) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type Command struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// op is one of paint, place, shape, entity, remove or frame
+	Op            string   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Id            string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Cells         []*Cell  `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	Color         string   `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	Shapes        []*Shape `protobuf:"bytes,5,rep,name=shapes,proto3" json:"shapes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Command) Reset() {
+	*x = Command{}
+	mi := &file_proto_replay_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Command) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Command) ProtoMessage() {}
+
+func (x *Command) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_replay_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Command.ProtoReflect.Descriptor instead.
+func (*Command) Descriptor() ([]byte, []int) {
+	return file_proto_replay_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Command) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *Command) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Command) GetCells() []*Cell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *Command) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Command) GetShapes() []*Shape {
+	if x != nil {
+		return x.Shapes
+	}
+	return nil
+}
+
+type Cell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column        int32                  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cell) Reset() {
+	*x = Cell{}
+	mi := &file_proto_replay_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cell) ProtoMessage() {}
+
+func (x *Cell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_replay_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cell.ProtoReflect.Descriptor instead.
+func (*Cell) Descriptor() ([]byte, []int) {
+	return file_proto_replay_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Cell) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Cell) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type Shape struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is one of rectangle, circle, line or path
+	Kind        string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Width       float64 `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height      float64 `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
+	Radius      float64 `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Length      float64 `protobuf:"fixed64,5,opt,name=length,proto3" json:"length,omitempty"`
+	Rotate      float64 `protobuf:"fixed64,6,opt,name=rotate,proto3" json:"rotate,omitempty"`
+	Dashes      float64 `protobuf:"fixed64,7,opt,name=dashes,proto3" json:"dashes,omitempty"`
+	StrokeWidth float64 `protobuf:"fixed64,8,opt,name=stroke_width,json=strokeWidth,proto3" json:"stroke_width,omitempty"`
+	Stroke      bool    `protobuf:"varint,9,opt,name=stroke,proto3" json:"stroke,omitempty"`
+	Color       string  `protobuf:"bytes,10,opt,name=color,proto3" json:"color,omitempty"`
+	// row_span and column_span merge the cells a rectangle covers, unset spans cover one cell
+	RowSpan    int32 `protobuf:"varint,11,opt,name=row_span,json=rowSpan,proto3" json:"row_span,omitempty"`
+	ColumnSpan int32 `protobuf:"varint,12,opt,name=column_span,json=columnSpan,proto3" json:"column_span,omitempty"`
+	// start_port and end_port attach a path to sides of its cells, unset ports attach it to the centers
+	StartPort     *Port `protobuf:"bytes,13,opt,name=start_port,json=startPort,proto3" json:"start_port,omitempty"`
+	EndPort       *Port `protobuf:"bytes,14,opt,name=end_port,json=endPort,proto3" json:"end_port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shape) Reset() {
+	*x = Shape{}
+	mi := &file_proto_replay_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shape) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shape) ProtoMessage() {}
+
+func (x *Shape) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_replay_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shape.ProtoReflect.Descriptor instead.
+func (*Shape) Descriptor() ([]byte, []int) {
+	return file_proto_replay_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Shape) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Shape) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Shape) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Shape) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *Shape) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Shape) GetRotate() float64 {
+	if x != nil {
+		return x.Rotate
+	}
+	return 0
+}
+
+func (x *Shape) GetDashes() float64 {
+	if x != nil {
+		return x.Dashes
+	}
+	return 0
+}
+
+func (x *Shape) GetStrokeWidth() float64 {
+	if x != nil {
+		return x.StrokeWidth
+	}
+	return 0
+}
+
+func (x *Shape) GetStroke() bool {
+	if x != nil {
+		return x.Stroke
+	}
+	return false
+}
+
+func (x *Shape) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Shape) GetRowSpan() int32 {
+	if x != nil {
+		return x.RowSpan
+	}
+	return 0
+}
+
+func (x *Shape) GetColumnSpan() int32 {
+	if x != nil {
+		return x.ColumnSpan
+	}
+	return 0
+}
+
+func (x *Shape) GetStartPort() *Port {
+	if x != nil {
+		return x.StartPort
+	}
+	return nil
+}
+
+func (x *Shape) GetEndPort() *Port {
+	if x != nil {
+		return x.EndPort
+	}
+	return nil
+}
+
+type Port struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// side is 0 for the top, 1 for the right, 2 for the bottom and 3 for the left side
+	Side          int32   `protobuf:"varint,1,opt,name=side,proto3" json:"side,omitempty"`
+	Offset        float64 `protobuf:"fixed64,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Inset         float64 `protobuf:"fixed64,3,opt,name=inset,proto3" json:"inset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Port) Reset() {
+	*x = Port{}
+	mi := &file_proto_replay_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Port) ProtoMessage() {}
+
+func (x *Port) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_replay_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Port.ProtoReflect.Descriptor instead.
+func (*Port) Descriptor() ([]byte, []int) {
+	return file_proto_replay_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Port) GetSide() int32 {
+	if x != nil {
+		return x.Side
+	}
+	return 0
+}
+
+func (x *Port) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Port) GetInset() float64 {
+	if x != nil {
+		return x.Inset
+	}
+	return 0
+}
+
+var File_proto_replay_proto protoreflect.FileDescriptor
+
+const file_proto_replay_proto_rawDesc = "" +
+	"\n" +
+	"\x12proto/replay.proto\x12\x0egridder.replay\"\xaf\x01\n" +
+	"\x06Replay\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12(\n" +
+	"\x04grid\x18\x04 \x01(\v2\x14.gridder.replay.GridR\x04grid\x123\n" +
+	"\bcommands\x18\x05 \x03(\v2\x17.gridder.replay.CommandR\bcommands\"\xd3\x03\n" +
+	"\x04Grid\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\x05R\x04rows\x12\x18\n" +
+	"\acolumns\x18\x02 \x01(\x05R\acolumns\x12\x16\n" +
+	"\x06margin\x18\x03 \x01(\x05R\x06margin\x127\n" +
+	"\vrow_offsets\x18\x04 \x03(\v2\x16.gridder.replay.OffsetR\n" +
+	"rowOffsets\x12=\n" +
+	"\x0ecolumn_offsets\x18\x05 \x03(\v2\x16.gridder.replay.OffsetR\rcolumnOffsets\x12\x1f\n" +
+	"\vline_dashes\x18\x06 \x01(\x01R\n" +
+	"lineDashes\x12*\n" +
+	"\x11line_stroke_width\x18\a \x01(\x01R\x0flineStrokeWidth\x12#\n" +
+	"\rborder_dashes\x18\b \x01(\x01R\fborderDashes\x12.\n" +
+	"\x13border_stroke_width\x18\t \x01(\x01R\x11borderStrokeWidth\x12\x1d\n" +
+	"\n" +
+	"line_color\x18\n" +
+	" \x01(\tR\tlineColor\x12!\n" +
+	"\fborder_color\x18\v \x01(\tR\vborderColor\x12)\n" +
+	"\x10background_color\x18\f \x01(\tR\x0fbackgroundColor\"6\n" +
+	"\x06Offset\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x01R\x06offset\"\x9a\x01\n" +
+	"\aCommand\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12*\n" +
+	"\x05cells\x18\x03 \x03(\v2\x14.gridder.replay.CellR\x05cells\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\tR\x05color\x12-\n" +
+	"\x06shapes\x18\x05 \x03(\v2\x15.gridder.replay.ShapeR\x06shapes\"0\n" +
+	"\x04Cell\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\x05R\x06column\"\x9c\x03\n" +
+	"\x05Shape\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x16\n" +
+	"\x06radius\x18\x04 \x01(\x01R\x06radius\x12\x16\n" +
+	"\x06length\x18\x05 \x01(\x01R\x06length\x12\x16\n" +
+	"\x06rotate\x18\x06 \x01(\x01R\x06rotate\x12\x16\n" +
+	"\x06dashes\x18\a \x01(\x01R\x06dashes\x12!\n" +
+	"\fstroke_width\x18\b \x01(\x01R\vstrokeWidth\x12\x16\n" +
+	"\x06stroke\x18\t \x01(\bR\x06stroke\x12\x14\n" +
+	"\x05color\x18\n" +
+	" \x01(\tR\x05color\x12\x19\n" +
+	"\brow_span\x18\v \x01(\x05R\arowSpan\x12\x1f\n" +
+	"\vcolumn_span\x18\f \x01(\x05R\n" +
+	"columnSpan\x123\n" +
+	"\n" +
+	"start_port\x18\r \x01(\v2\x14.gridder.replay.PortR\tstartPort\x12/\n" +
+	"\bend_port\x18\x0e \x01(\v2\x14.gridder.replay.PortR\aendPort\"H\n" +
+	"\x04Port\x12\x12\n" +
+	"\x04side\x18\x01 \x01(\x05R\x04side\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x01R\x06offset\x12\x14\n" +
+	"\x05inset\x18\x03 \x01(\x01R\x05insetB.Z,github.com/rageofgods/gridder/proto/replaypbb\x06proto3"
+
+var (
+	file_proto_replay_proto_rawDescOnce sync.Once
+	file_proto_replay_proto_rawDescData []byte
+)
+
+func file_proto_replay_proto_rawDescGZIP() []byte {
+	file_proto_replay_proto_rawDescOnce.Do(func() {
+		file_proto_replay_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_replay_proto_rawDesc), len(file_proto_replay_proto_rawDesc)))
+	})
+	return file_proto_replay_proto_rawDescData
+}
+
+var file_proto_replay_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_replay_proto_goTypes = []any{
+	(*Replay)(nil),  // 0: gridder.replay.Replay
+	(*Grid)(nil),    // 1: gridder.replay.Grid
+	(*Offset)(nil),  // 2: gridder.replay.Offset
+	(*Command)(nil), // 3: gridder.replay.Command
+	(*Cell)(nil),    // 4: gridder.replay.Cell
+	(*Shape)(nil),   // 5: gridder.replay.Shape
+	(*Port)(nil),    // 6: gridder.replay.Port
+}
+var file_proto_replay_proto_depIdxs = []int32{
+	1, // 0: gridder.replay.Replay.grid:type_name -> gridder.replay.Grid
+	3, // 1: gridder.replay.Replay.commands:type_name -> gridder.replay.Command
+	2, // 2: gridder.replay.Grid.row_offsets:type_name -> gridder.replay.Offset
+	2, // 3: gridder.replay.Grid.column_offsets:type_name -> gridder.replay.Offset
+	4, // 4: gridder.replay.Command.cells:type_name -> gridder.replay.Cell
+	5, // 5: gridder.replay.Command.shapes:type_name -> gridder.replay.Shape
+	6, // 6: gridder.replay.Shape.start_port:type_name -> gridder.replay.Port
+	6, // 7: gridder.replay.Shape.end_port:type_name -> gridder.replay.Port
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_proto_replay_proto_init() }
+func file_proto_replay_proto_init() {
+	if File_proto_replay_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_replay_proto_rawDesc), len(file_proto_replay_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_replay_proto_goTypes,
+		DependencyIndexes: file_proto_replay_proto_depIdxs,
+		MessageInfos:      file_proto_replay_proto_msgTypes,
+	}.Build()
+	File_proto_replay_proto = out.File
+	file_proto_replay_proto_goTypes = nil
+	file_proto_replay_proto_depIdxs = nil
+}
@@ -0,0 +1,412 @@
+package gridder
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+)
+
+// Replay is a recording of cell paints, shapes, paths, entities and frames that can be rendered again at any
+// resolution. Lengths are scaled with the image size
+type Replay struct {
+	Version  int             `json:"version"`
+	Width    int             `json:"width"`
+	Height   int             `json:"height"`
+	Grid     replayGrid      `json:"grid"`
+	Commands []replayCommand `json:"commands"`
+}
+
+type replayGrid struct {
+	Rows              int            `json:"rows"`
+	Columns           int            `json:"columns"`
+	MarginWidth       int            `json:"margin,omitempty"`
+	RowOffsets        []replayOffset `json:"rowOffsets,omitempty"`
+	ColumnOffsets     []replayOffset `json:"columnOffsets,omitempty"`
+	LineDashes        float64        `json:"lineDashes,omitempty"`
+	LineStrokeWidth   float64        `json:"lineStrokeWidth,omitempty"`
+	BorderDashes      float64        `json:"borderDashes,omitempty"`
+	BorderStrokeWidth float64        `json:"borderStrokeWidth,omitempty"`
+	LineColor         string         `json:"lineColor,omitempty"`
+	BorderColor       string         `json:"borderColor,omitempty"`
+	BackgroundColor   string         `json:"backgroundColor,omitempty"`
+}
+
+type replayOffset struct {
+	Index  int     `json:"index"`
+	Offset float64 `json:"offset"`
+}
+
+type replayPort struct {
+	Side   int     `json:"side"`
+	Offset float64 `json:"offset,omitempty"`
+	Inset  float64 `json:"inset,omitempty"`
+}
+
+type replayCommand struct {
+	Op     string        `json:"op"`
+	ID     string        `json:"id,omitempty"`
+	Cells  [][2]int      `json:"cells,omitempty"`
+	Color  string        `json:"color,omitempty"`
+	Shapes []replayShape `json:"shapes,omitempty"`
+}
+
+type replayShape struct {
+	Kind        string      `json:"kind"`
+	Width       float64     `json:"width,omitempty"`
+	Height      float64     `json:"height,omitempty"`
+	Radius      float64     `json:"radius,omitempty"`
+	Length      float64     `json:"length,omitempty"`
+	Rotate      float64     `json:"rotate,omitempty"`
+	Dashes      float64     `json:"dashes,omitempty"`
+	StrokeWidth float64     `json:"strokeWidth,omitempty"`
+	Stroke      bool        `json:"stroke,omitempty"`
+	Color       string      `json:"color,omitempty"`
+	RowSpan     int         `json:"rowSpan,omitempty"`
+	ColumnSpan  int         `json:"columnSpan,omitempty"`
+	StartPort   *replayPort `json:"startPort,omitempty"`
+	EndPort     *replayPort `json:"endPort,omitempty"`
+}
+
+// StartReplay starts recording a replay, discarding the previous recording. Only top level calls to PaintCell,
+// DrawRectangle, DrawCircle, DrawLine, DrawPath and to the entity methods are recorded. Grid changes and entity
+// images are not
+func (g *Gridder) StartReplay() {
+	g.replay = &Replay{
+		Version: replayVersion(),
+		Width:   g.imageConfig.GetWidth(),
+		Height:  g.imageConfig.GetHeight(),
+		Grid:    newReplayGrid(g.gridConfig),
+	}
+}
+
+// RecordFrame ends the current frame of the replay
+func (g *Gridder) RecordFrame() {
+	g.recordReplay(nil, func() replayCommand { return replayCommand{Op: "frame"} })
+}
+
+// ExportReplay writes the recorded replay as gzip compressed JSON
+func (g *Gridder) ExportReplay(w io.Writer) error {
+	replay := g.replay
+	if replay == nil {
+		replay = &Replay{Version: replayVersion()}
+	}
+
+	writer := gzip.NewWriter(w)
+	err := json.NewEncoder(writer).Encode(replay)
+	if err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+// LoadReplay reads a replay written by ExportReplay, migrating replays written by older versions of the format
+func LoadReplay(r io.Reader) (*Replay, error) {
+	reader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var document map[string]interface{}
+	err = json.NewDecoder(reader).Decode(&document)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeReplayDocument(document)
+}
+
+// Render plays the replay on a new grid and returns an image for every recorded frame
+func (r *Replay) Render(imageConfig ImageConfig) ([]image.Image, error) {
+	if r.Width <= 0 || r.Height <= 0 {
+		return nil, fmt.Errorf("%w: size %dx%d", errInvalidReplay, r.Width, r.Height)
+	}
+
+	scale := math.Min(float64(imageConfig.GetWidth())/float64(r.Width), float64(imageConfig.GetHeight())/float64(r.Height))
+	gridConfig, err := r.Grid.gridConfig(scale)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := New(imageConfig, gridConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []image.Image
+	for _, command := range r.Commands {
+		if command.Op == "frame" {
+			images = append(images, toNRGBA(g.image()))
+			continue
+		}
+
+		err = g.playReplayCommand(command, scale)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return images, nil
+}
+
+func (g *Gridder) playReplayCommand(command replayCommand, scale float64) error {
+	cells := make([]Cell, len(command.Cells))
+	for i, cell := range command.Cells {
+		cells[i] = Cell{Row: cell[0], Column: cell[1]}
+	}
+
+	switch command.Op {
+	case "paint", "place":
+		if len(cells) != 1 {
+			return fmt.Errorf("%w: %s needs one cell", errInvalidReplay, command.Op)
+		}
+		if command.Op == "place" {
+			return g.SetEntityCell(command.ID, cells[0].Row, cells[0].Column)
+		}
+
+		c, err := parseOptionalColor(command.Color)
+		if err != nil {
+			return err
+		}
+		return g.PaintCell(cells[0].Row, cells[0].Column, c)
+	case "shape":
+		if len(command.Shapes) != 1 || len(cells) == 0 {
+			return fmt.Errorf("%w: shape needs a shape and cells", errInvalidReplay)
+		}
+		return command.Shapes[0].draw(g, cells, scale)
+	case "entity":
+		entity := Entity{}
+		for _, shape := range command.Shapes {
+			err := shape.applyToEntity(&entity, scale)
+			if err != nil {
+				return err
+			}
+		}
+		return g.RegisterEntity(command.ID, entity)
+	case "remove":
+		g.RemoveEntity(command.ID)
+		return nil
+	}
+	return fmt.Errorf("%w: unknown command %q", errInvalidReplay, command.Op)
+}
+
+// recordReplay records a command for a successful top level call while a replay is recording
+func (g *Gridder) recordReplay(err error, command func() replayCommand) error {
+	if err != nil || g.retaining {
+		return err
+	}
+
+	if g.parent != nil {
+		return g.parent.recordReplay(err, command)
+	}
+
+	if g.replay != nil {
+		g.replay.Commands = append(g.replay.Commands, command())
+	}
+	return nil
+}
+
+func replayCells(cells ...Cell) [][2]int {
+	replayed := make([][2]int, len(cells))
+	for i, cell := range cells {
+		replayed[i] = [2]int{cell.Row, cell.Column}
+	}
+	return replayed
+}
+
+func paintCommand(row int, column int, c color.Color) replayCommand {
+	return replayCommand{Op: "paint", Cells: replayCells(Cell{Row: row, Column: column}), Color: formatOptionalColor(c)}
+}
+
+func placeCommand(id string, row int, column int) replayCommand {
+	return replayCommand{Op: "place", ID: id, Cells: replayCells(Cell{Row: row, Column: column})}
+}
+
+func shapeCommand(shape replayShape, cells ...Cell) replayCommand {
+	return replayCommand{Op: "shape", Cells: replayCells(cells...), Shapes: []replayShape{shape}}
+}
+
+func entityCommand(id string, entity Entity) replayCommand {
+	command := replayCommand{Op: "entity", ID: id}
+	if entity.Rectangle != nil {
+		command.Shapes = append(command.Shapes, rectangleShape(*entity.Rectangle))
+	}
+	if entity.Circle != nil {
+		command.Shapes = append(command.Shapes, circleShape(*entity.Circle))
+	}
+	if entity.Line != nil {
+		command.Shapes = append(command.Shapes, lineShape(*entity.Line))
+	}
+	return command
+}
+
+func rectangleShape(config RectangleConfig) replayShape {
+	return replayShape{
+		Kind: "rectangle", Width: config.Width, Height: config.Height, Rotate: config.Rotate, Dashes: config.Dashes,
+		StrokeWidth: config.StrokeWidth, Stroke: config.Stroke, Color: formatOptionalColor(config.Color),
+		RowSpan: config.Span.Rows, ColumnSpan: config.Span.Columns,
+	}
+}
+
+func circleShape(config CircleConfig) replayShape {
+	return replayShape{
+		Kind: "circle", Radius: config.Radius, Dashes: config.Dashes, StrokeWidth: config.StrokeWidth,
+		Stroke: config.Stroke, Color: formatOptionalColor(config.Color),
+	}
+}
+
+func lineShape(config LineConfig) replayShape {
+	return replayShape{
+		Kind: "line", Length: config.Length, Rotate: config.Rotate, Dashes: config.Dashes,
+		StrokeWidth: config.StrokeWidth, Color: formatOptionalColor(config.Color),
+	}
+}
+
+func pathShape(config PathConfig) replayShape {
+	return replayShape{
+		Kind: "path", Dashes: config.Dashes, StrokeWidth: config.StrokeWidth, Color: formatOptionalColor(config.Color),
+		StartPort: newReplayPort(config.StartPort), EndPort: newReplayPort(config.EndPort),
+	}
+}
+
+func newReplayPort(port *Port) *replayPort {
+	if port == nil {
+		return nil
+	}
+	return &replayPort{Side: int(port.Side), Offset: port.Offset, Inset: port.Inset}
+}
+
+// port gets the port of a path, with its inset scaled
+func (r *replayPort) port(scale float64) *Port {
+	if r == nil {
+		return nil
+	}
+	return &Port{Side: Side(r.Side), Offset: r.Offset, Inset: r.Inset * scale}
+}
+
+func (s replayShape) draw(g *Gridder, cells []Cell, scale float64) error {
+	if s.Kind == "path" {
+		if len(cells) != 2 {
+			return fmt.Errorf("%w: path needs two cells", errInvalidReplay)
+		}
+
+		c, err := parseOptionalColor(s.Color)
+		if err != nil {
+			return err
+		}
+		config := PathConfig{
+			Dashes: s.Dashes * scale, StrokeWidth: s.StrokeWidth * scale, Color: c,
+			StartPort: s.StartPort.port(scale), EndPort: s.EndPort.port(scale),
+		}
+		return g.DrawPath(cells[0].Row, cells[0].Column, cells[1].Row, cells[1].Column, config)
+	}
+
+	entity := Entity{}
+	err := s.applyToEntity(&entity, scale)
+	if err != nil {
+		return err
+	}
+
+	row, column := cells[0].Row, cells[0].Column
+	switch {
+	case entity.Rectangle != nil:
+		return g.DrawRectangle(row, column, *entity.Rectangle)
+	case entity.Circle != nil:
+		return g.DrawCircle(row, column, *entity.Circle)
+	default:
+		return g.DrawLine(row, column, *entity.Line)
+	}
+}
+
+func (s replayShape) applyToEntity(entity *Entity, scale float64) error {
+	c, err := parseOptionalColor(s.Color)
+	if err != nil {
+		return err
+	}
+
+	switch s.Kind {
+	case "rectangle":
+		entity.Rectangle = &RectangleConfig{
+			Width: s.Width * scale, Height: s.Height * scale, Rotate: s.Rotate, Dashes: s.Dashes * scale,
+			StrokeWidth: s.StrokeWidth * scale, Stroke: s.Stroke, Color: c, Span: Span{Rows: s.RowSpan, Columns: s.ColumnSpan},
+		}
+	case "circle":
+		entity.Circle = &CircleConfig{
+			Radius: s.Radius * scale, Dashes: s.Dashes * scale, StrokeWidth: s.StrokeWidth * scale, Stroke: s.Stroke, Color: c,
+		}
+	case "line":
+		entity.Line = &LineConfig{
+			Length: s.Length * scale, Rotate: s.Rotate, Dashes: s.Dashes * scale, StrokeWidth: s.StrokeWidth * scale, Color: c,
+		}
+	default:
+		return fmt.Errorf("%w: unknown shape %q", errInvalidReplay, s.Kind)
+	}
+	return nil
+}
+
+func newReplayGrid(gridConfig GridConfig) replayGrid {
+	grid := replayGrid{
+		Rows:              gridConfig.GetRows(),
+		Columns:           gridConfig.GetColumns(),
+		MarginWidth:       gridConfig.MarginWidth,
+		LineDashes:        gridConfig.LineDashes,
+		LineStrokeWidth:   gridConfig.LineStrokeWidth,
+		BorderDashes:      gridConfig.BorderDashes,
+		BorderStrokeWidth: gridConfig.BorderStrokeWidth,
+		LineColor:         formatOptionalColor(gridConfig.LineColor),
+		BorderColor:       formatOptionalColor(gridConfig.BorderColor),
+		BackgroundColor:   formatOptionalColor(gridConfig.BackgroundColor),
+	}
+	for _, offset := range gridConfig.RowsHeightOffset {
+		grid.RowOffsets = append(grid.RowOffsets, replayOffset{Index: offset.Row, Offset: offset.Offset})
+	}
+	for _, offset := range gridConfig.ColumnsWidthOffset {
+		grid.ColumnOffsets = append(grid.ColumnOffsets, replayOffset{Index: offset.Column, Offset: offset.Offset})
+	}
+	return grid
+}
+
+func (r *replayGrid) gridConfig(scale float64) (GridConfig, error) {
+	gridConfig := GridConfig{
+		Rows:              r.Rows,
+		Columns:           r.Columns,
+		MarginWidth:       int(math.Round(float64(r.MarginWidth) * scale)),
+		LineDashes:        r.LineDashes * scale,
+		LineStrokeWidth:   r.LineStrokeWidth * scale,
+		BorderDashes:      r.BorderDashes * scale,
+		BorderStrokeWidth: r.BorderStrokeWidth * scale,
+	}
+	for _, offset := range r.RowOffsets {
+		gridConfig.RowsHeightOffset = append(gridConfig.RowsHeightOffset, &RowHeightOffset{Row: offset.Index, Offset: offset.Offset * scale})
+	}
+	for _, offset := range r.ColumnOffsets {
+		gridConfig.ColumnsWidthOffset = append(gridConfig.ColumnsWidthOffset, &ColumnWidthOffset{Column: offset.Index, Offset: offset.Offset * scale})
+	}
+
+	var err error
+	for _, field := range []struct {
+		value  string
+		target *color.Color
+	}{
+		{r.LineColor, &gridConfig.LineColor},
+		{r.BorderColor, &gridConfig.BorderColor},
+		{r.BackgroundColor, &gridConfig.BackgroundColor},
+	} {
+		*field.target, err = parseOptionalColor(field.value)
+		if err != nil {
+			return GridConfig{}, err
+		}
+	}
+	return gridConfig, nil
+}
+
+func formatOptionalColor(c color.Color) string {
+	if c == nil {
+		return ""
+	}
+	return FormatColor(c)
+}
@@ -0,0 +1,96 @@
+package gridder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplay(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{
+		Rows: 2, Columns: 2, LineStrokeWidth: 2, BackgroundColor: color.White,
+		ColumnsWidthOffset: []*ColumnWidthOffset{{Column: 0, Offset: 10}},
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	gridder.StartReplay()
+	assert.Nil(t, gridder.PaintCell(1, 1, color.Black))
+	assert.Nil(t, gridder.DrawDiagram("A1: rect red", nil))
+	assert.NotNil(t, gridder.DrawCircle(5, 5))
+	assert.Nil(t, gridder.RegisterEntity("piece", Entity{Circle: &CircleConfig{Radius: 10, Color: color.Black}}))
+	assert.Nil(t, gridder.SetEntityCell("piece", 0, 1))
+	gridder.RecordFrame()
+
+	assert.Nil(t, gridder.DrawPath(0, 0, 1, 1, PathConfig{StrokeWidth: 4}))
+	assert.Nil(t, gridder.Overlay().DrawRectangle(1, 0, RectangleConfig{Width: 10, Color: color.Black}))
+	assert.Nil(t, gridder.DrawLine(1, 0))
+	gridder.RemoveEntity("piece")
+	gridder.RecordFrame()
+	assert.Equal(t, len(gridder.replay.Commands), 9)
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(t, gridder.ExportReplay(buffer))
+
+	replay, err := LoadReplay(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, replay.Grid.ColumnOffsets, []replayOffset{{Index: 0, Offset: 10}})
+
+	frames, err := replay.Render(ImageConfig{Width: 200, Height: 200})
+	assert.Nil(t, err)
+	assert.Equal(t, len(frames), 2)
+	assert.Equal(t, frames[0].Bounds().Dx(), 200)
+	assert.Equal(t, color.GrayModel.Convert(frames[0].At(20, 20)), color.Gray{Y: 255})
+	assert.Equal(t, color.GrayModel.Convert(frames[0].At(150, 150)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(frames[0].At(150, 50)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(frames[1].At(150, 50)), color.Gray{Y: 255})
+	assert.Equal(t, color.GrayModel.Convert(frames[1].At(52, 148)), color.Gray{})
+}
+
+func TestLoadReplay(t *testing.T) {
+	_, err := LoadReplay(bytes.NewBufferString("replay"))
+	assert.NotNil(t, err)
+
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(t, gridder.ExportReplay(buffer))
+	replay, err := LoadReplay(buffer)
+	assert.Nil(t, err)
+
+	_, err = replay.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidReplay))
+
+	buffer.Reset()
+	writer := gzip.NewWriter(buffer)
+	writer.Write([]byte(`{"version":2}`))
+	writer.Close()
+	_, err = LoadReplay(buffer)
+	assert.True(t, errors.Is(err, errInvalidReplay))
+}
+
+func TestReplayCommands(t *testing.T) {
+	replay := Replay{Version: replayVersion(), Width: 10, Height: 10, Grid: replayGrid{Rows: 1, Columns: 1}}
+	for _, command := range []replayCommand{
+		{Op: "unknown"},
+		{Op: "paint"},
+		{Op: "paint", Cells: [][2]int{{0, 0}}, Color: "nope"},
+		{Op: "shape", Cells: [][2]int{{0, 0}}},
+		{Op: "shape", Cells: [][2]int{{0, 0}}, Shapes: []replayShape{{Kind: "path"}}},
+		{Op: "shape", Cells: [][2]int{{0, 0}}, Shapes: []replayShape{{Kind: "star"}}},
+		{Op: "entity", Shapes: []replayShape{{Kind: "circle", Color: "nope"}}},
+	} {
+		replay.Commands = []replayCommand{command}
+		_, err := replay.Render(ImageConfig{})
+		assert.NotNil(t, err, command.Op)
+	}
+
+	replay.Grid.LineColor = "nope"
+	_, err := replay.Render(ImageConfig{})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,216 @@
+package gridder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/rageofgods/gridder/proto/replaypb"
+	"google.golang.org/protobuf/proto"
+)
+
+// ExportReplayProto writes the recorded replay in the protocol buffer format defined in proto/replay.proto
+func (g *Gridder) ExportReplayProto(w io.Writer) error {
+	replay := g.replay
+	if replay == nil {
+		replay = &Replay{Version: replayVersion()}
+	}
+
+	data, err := proto.Marshal(replay.ToProto())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// LoadReplayProto reads a replay in the protocol buffer format defined in proto/replay.proto, migrating replays
+// written by older versions of the format
+func LoadReplayProto(r io.Reader) (*Replay, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var message replaypb.Replay
+	err = proto.Unmarshal(data, &message)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidReplay, err)
+	}
+	return ReplayFromProto(&message)
+}
+
+// ReplayFromProto converts a replay message generated from proto/replay.proto, such as one embedded in a request of
+// a rendering service, migrating replays written by older versions of the format
+func ReplayFromProto(message *replaypb.Replay) (*Replay, error) {
+	replay := &Replay{
+		Version:  int(message.GetVersion()),
+		Width:    int(message.GetWidth()),
+		Height:   int(message.GetHeight()),
+		Grid:     replayGridFromProto(message.GetGrid()),
+		Commands: make([]replayCommand, len(message.GetCommands())),
+	}
+	for i, command := range message.GetCommands() {
+		replay.Commands[i] = replayCommandFromProto(command)
+	}
+	if len(replay.Commands) == 0 {
+		replay.Commands = nil
+	}
+
+	if replay.Version == replayVersion() {
+		return replay, nil
+	}
+
+	// older versions go through the migrations of the JSON format
+	encoded, err := json.Marshal(replay)
+	if err != nil {
+		return nil, err
+	}
+
+	var document map[string]interface{}
+	err = json.Unmarshal(encoded, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeReplayDocument(document)
+}
+
+// ToProto converts the replay to a message generated from proto/replay.proto
+func (r *Replay) ToProto() *replaypb.Replay {
+	message := &replaypb.Replay{
+		Version: int32(r.Version),
+		Width:   int32(r.Width),
+		Height:  int32(r.Height),
+		Grid:    r.Grid.toProto(),
+	}
+	for i := range r.Commands {
+		message.Commands = append(message.Commands, r.Commands[i].toProto())
+	}
+	return message
+}
+
+func (g *replayGrid) toProto() *replaypb.Grid {
+	return &replaypb.Grid{
+		Rows:              int32(g.Rows),
+		Columns:           int32(g.Columns),
+		Margin:            int32(g.MarginWidth),
+		RowOffsets:        replayOffsetsToProto(g.RowOffsets),
+		ColumnOffsets:     replayOffsetsToProto(g.ColumnOffsets),
+		LineDashes:        g.LineDashes,
+		LineStrokeWidth:   g.LineStrokeWidth,
+		BorderDashes:      g.BorderDashes,
+		BorderStrokeWidth: g.BorderStrokeWidth,
+		LineColor:         g.LineColor,
+		BorderColor:       g.BorderColor,
+		BackgroundColor:   g.BackgroundColor,
+	}
+}
+
+func replayGridFromProto(message *replaypb.Grid) replayGrid {
+	return replayGrid{
+		Rows:              int(message.GetRows()),
+		Columns:           int(message.GetColumns()),
+		MarginWidth:       int(message.GetMargin()),
+		RowOffsets:        replayOffsetsFromProto(message.GetRowOffsets()),
+		ColumnOffsets:     replayOffsetsFromProto(message.GetColumnOffsets()),
+		LineDashes:        message.GetLineDashes(),
+		LineStrokeWidth:   message.GetLineStrokeWidth(),
+		BorderDashes:      message.GetBorderDashes(),
+		BorderStrokeWidth: message.GetBorderStrokeWidth(),
+		LineColor:         message.GetLineColor(),
+		BorderColor:       message.GetBorderColor(),
+		BackgroundColor:   message.GetBackgroundColor(),
+	}
+}
+
+func replayOffsetsToProto(offsets []replayOffset) []*replaypb.Offset {
+	var messages []*replaypb.Offset
+	for _, offset := range offsets {
+		messages = append(messages, &replaypb.Offset{Index: int32(offset.Index), Offset: offset.Offset})
+	}
+	return messages
+}
+
+func replayOffsetsFromProto(messages []*replaypb.Offset) []replayOffset {
+	var offsets []replayOffset
+	for _, message := range messages {
+		offsets = append(offsets, replayOffset{Index: int(message.GetIndex()), Offset: message.GetOffset()})
+	}
+	return offsets
+}
+
+func (c *replayCommand) toProto() *replaypb.Command {
+	message := &replaypb.Command{Op: c.Op, Id: c.ID, Color: c.Color}
+	for _, cell := range c.Cells {
+		message.Cells = append(message.Cells, &replaypb.Cell{Row: int32(cell[0]), Column: int32(cell[1])})
+	}
+	for i := range c.Shapes {
+		message.Shapes = append(message.Shapes, c.Shapes[i].toProto())
+	}
+	return message
+}
+
+func replayCommandFromProto(message *replaypb.Command) replayCommand {
+	command := replayCommand{Op: message.GetOp(), ID: message.GetId(), Color: message.GetColor()}
+	for _, cell := range message.GetCells() {
+		command.Cells = append(command.Cells, [2]int{int(cell.GetRow()), int(cell.GetColumn())})
+	}
+	for _, shape := range message.GetShapes() {
+		command.Shapes = append(command.Shapes, replayShapeFromProto(shape))
+	}
+	return command
+}
+
+func (s *replayShape) toProto() *replaypb.Shape {
+	return &replaypb.Shape{
+		Kind:        s.Kind,
+		Width:       s.Width,
+		Height:      s.Height,
+		Radius:      s.Radius,
+		Length:      s.Length,
+		Rotate:      s.Rotate,
+		Dashes:      s.Dashes,
+		StrokeWidth: s.StrokeWidth,
+		Stroke:      s.Stroke,
+		Color:       s.Color,
+		RowSpan:     int32(s.RowSpan),
+		ColumnSpan:  int32(s.ColumnSpan),
+		StartPort:   s.StartPort.toProto(),
+		EndPort:     s.EndPort.toProto(),
+	}
+}
+
+func replayShapeFromProto(message *replaypb.Shape) replayShape {
+	return replayShape{
+		Kind:        message.GetKind(),
+		Width:       message.GetWidth(),
+		Height:      message.GetHeight(),
+		Radius:      message.GetRadius(),
+		Length:      message.GetLength(),
+		Rotate:      message.GetRotate(),
+		Dashes:      message.GetDashes(),
+		StrokeWidth: message.GetStrokeWidth(),
+		Stroke:      message.GetStroke(),
+		Color:       message.GetColor(),
+		RowSpan:     int(message.GetRowSpan()),
+		ColumnSpan:  int(message.GetColumnSpan()),
+		StartPort:   replayPortFromProto(message.GetStartPort()),
+		EndPort:     replayPortFromProto(message.GetEndPort()),
+	}
+}
+
+func (r *replayPort) toProto() *replaypb.Port {
+	if r == nil {
+		return nil
+	}
+	return &replaypb.Port{Side: int32(r.Side), Offset: r.Offset, Inset: r.Inset}
+}
+
+func replayPortFromProto(message *replaypb.Port) *replayPort {
+	if message == nil {
+		return nil
+	}
+	return &replayPort{Side: int(message.GetSide()), Offset: message.GetOffset(), Inset: message.GetInset()}
+}
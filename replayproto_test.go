@@ -0,0 +1,90 @@
+package gridder
+
+import (
+	"bytes"
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayProto(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{
+		Rows: 2, Columns: 2, LineStrokeWidth: 2, LineColor: color.Black,
+		RowsHeightOffset: []*RowHeightOffset{{Row: 1, Offset: -5}},
+	})
+	assert.Nil(t, err)
+
+	gridder.StartReplay()
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	assert.Nil(t, gridder.DrawRectangle(1, 1, RectangleConfig{Width: 10, Height: 5, Stroke: true, Color: color.White}))
+	assert.Nil(t, gridder.DrawPath(0, 0, 1, 1))
+	assert.Nil(t, gridder.DrawRectangleSpan(0, 0, 2, 2, RectangleConfig{Stroke: true}))
+	assert.Nil(t, gridder.DrawPath(0, 0, 0, 1, PathConfig{StartPort: &Port{Side: SideRight, Inset: 2}, EndPort: &Port{Side: SideLeft, Offset: -0.25}}))
+	assert.Nil(t, gridder.RegisterEntity("piece", Entity{Circle: &CircleConfig{Radius: 3}}))
+	assert.Nil(t, gridder.SetEntityCell("piece", 0, 1))
+	gridder.RecordFrame()
+
+	protoBuffer := new(bytes.Buffer)
+	assert.Nil(t, gridder.ExportReplayProto(protoBuffer))
+	jsonBuffer := new(bytes.Buffer)
+	assert.Nil(t, gridder.ExportReplay(jsonBuffer))
+
+	fromProto, err := LoadReplayProto(protoBuffer)
+	assert.Nil(t, err)
+	fromJSON, err := LoadReplay(jsonBuffer)
+	assert.Nil(t, err)
+	assert.Equal(t, fromProto, fromJSON)
+
+	frames, err := fromProto.Render(ImageConfig{Width: 50, Height: 50})
+	assert.Nil(t, err)
+	assert.Equal(t, len(frames), 1)
+}
+
+func TestLoadReplayProto(t *testing.T) {
+	data := []byte{
+		0x08, 0x01, // version 1
+		0x10, 0xac, 0x02, // width 300
+		0x18, 0x02, // height 2
+		0x22, 0x04, 0x08, 0x01, 0x10, 0x01, // grid with 1 row and 1 column
+		0x2a, 0x0b, 0x0a, 0x05, 'p', 'a', 'i', 'n', 't', 0x1a, 0x02, 0x08, 0x03, // paint of row 3, column 0
+		0x7d, 0x00, 0x00, 0x00, 0x00, // unknown fixed32 field
+	}
+	replay, err := LoadReplayProto(bytes.NewReader(data))
+	assert.Nil(t, err)
+	assert.Equal(t, replay, &Replay{
+		Version: 1, Width: 300, Height: 2,
+		Grid:     replayGrid{Rows: 1, Columns: 1},
+		Commands: []replayCommand{{Op: "paint", Cells: [][2]int{{3, 0}}}},
+	})
+
+	for _, data := range [][]byte{
+		{0x08},
+		{0x80},
+		{0x22, 0x05, 0x08},
+		{0x31, 0x00},
+		{0x0b},
+		{},
+		{0x08, 0x63},
+	} {
+		_, err := LoadReplayProto(bytes.NewReader(data))
+		assert.True(t, errors.Is(err, errInvalidReplay), data)
+	}
+}
+
+func TestReplayFromProto(t *testing.T) {
+	replay := &Replay{
+		Version: replayVersion(), Width: 100, Height: 50,
+		Grid: replayGrid{Rows: 2, Columns: 3, RowOffsets: []replayOffset{{Index: 1, Offset: 2}}, LineColor: "#000000ff"},
+		Commands: []replayCommand{{Op: "shape", Cells: [][2]int{{0, 1}}, Shapes: []replayShape{
+			{Kind: "path", StrokeWidth: 2, StartPort: &replayPort{Side: 1, Offset: 0.25}},
+		}}},
+	}
+	message := replay.ToProto()
+	assert.Equal(t, message.GetCommands()[0].GetShapes()[0].GetStartPort().GetSide(), int32(1))
+
+	converted, err := ReplayFromProto(message)
+	assert.Nil(t, err)
+	assert.Equal(t, converted, replay)
+}
@@ -0,0 +1,51 @@
+package gridder
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/fogleman/gg"
+)
+
+// stroke strokes the path traced by trace on the grid context, in the rough style of the image when it has one
+func (g *Gridder) stroke(width float64, trace func()) {
+	strokePath(g.ctx, g.imageConfig.GetRough(), width, trace)
+}
+
+// strokePath strokes the path traced by trace with a line width. A rough style traces the path again for every pass,
+// offset and with a varied width. Passes are seeded by the end of the path, so that drawing the same stroke twice
+// draws the same passes
+func strokePath(ctx *gg.Context, rough *RoughStyle, width float64, trace func()) {
+	ctx.SetLineWidth(width)
+	// strokes without width draw nothing, tracing and rasterizing them is skipped
+	if width <= 0 {
+		ctx.ClearPath()
+		return
+	}
+
+	trace()
+	if rough == nil {
+		ctx.Stroke()
+		return
+	}
+
+	end, ok := ctx.GetCurrentPoint()
+	ctx.ClearPath()
+	if !ok {
+		return
+	}
+
+	seed := splitMix64(uint64(rough.GetSeed()) ^ splitMix64(math.Float64bits(end.X)^splitMix64(math.Float64bits(end.Y))))
+	random := rand.New(rand.NewSource(int64(seed)))
+	spread := func(max float64) float64 {
+		return (random.Float64()*2 - 1) * max
+	}
+	for pass := 0; pass < rough.GetPasses(); pass++ {
+		ctx.Push()
+		ctx.Translate(spread(rough.GetRoughness()), spread(rough.GetRoughness()))
+		ctx.SetLineWidth(width * (1 + spread(rough.GetWidthVariation())))
+		trace()
+		ctx.Stroke()
+		ctx.Pop()
+	}
+}
@@ -0,0 +1,56 @@
+package gridder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fogleman/gg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoughStroke(t *testing.T) {
+	encode := func(gridder *Gridder) []byte {
+		var buffer bytes.Buffer
+		assert.Nil(t, gridder.EncodePNG(&buffer))
+		return buffer.Bytes()
+	}
+	render := func(rough *RoughStyle) *Gridder {
+		gridder, err := New(ImageConfig{Width: 100, Height: 100, Rough: rough}, GridConfig{Rows: 4, Columns: 4, LineStrokeWidth: 2})
+		assert.Nil(t, err)
+		assert.Nil(t, gridder.DrawCircle(1, 1, CircleConfig{Radius: 8, Stroke: true, StrokeWidth: 2}))
+		assert.Nil(t, gridder.DrawPath(0, 0, 3, 3))
+		return gridder
+	}
+
+	plain := encode(render(nil))
+	roughImage := encode(render(&RoughStyle{Seed: 1}))
+	assert.NotEqual(t, roughImage, plain)
+	assert.Equal(t, encode(render(&RoughStyle{Seed: 1})), roughImage)
+	assert.NotEqual(t, encode(render(&RoughStyle{Seed: 2})), roughImage)
+}
+
+func TestStrokePath(t *testing.T) {
+	ctx := gg.NewContext(10, 10)
+	traces := 0
+	strokePath(ctx, &RoughStyle{Passes: 3}, 1, func() {
+		traces++
+		ctx.DrawLine(0, 0, 10, 10)
+	})
+	assert.Equal(t, traces, 4)
+
+	traces = 0
+	strokePath(ctx, nil, 1, func() {
+		traces++
+		ctx.DrawLine(0, 0, 10, 10)
+	})
+	assert.Equal(t, traces, 1)
+
+	traces = 0
+	strokePath(ctx, &RoughStyle{}, 1, func() { traces++ })
+	assert.Equal(t, traces, 1)
+
+	// strokes without width are not traced
+	traces = 0
+	strokePath(ctx, nil, 0, func() { traces++ })
+	assert.Equal(t, traces, 0)
+}
@@ -0,0 +1,191 @@
+package gridder
+
+import (
+	"math"
+	"sort"
+
+	"github.com/fogleman/gg"
+)
+
+// pathRoutingTolerance is the distance in pixels within which paths are on the same line, and within which crossings
+// are at the ends of paths
+const pathRoutingTolerance = 0.5
+
+// pathSegment is a straight path from a start to an end point
+type pathSegment struct {
+	x1 float64
+	y1 float64
+	x2 float64
+	y2 float64
+}
+
+// routedPath is a path drawn with routing, with the segment between its points and the segment drawn after nudging
+type routedPath struct {
+	segment pathSegment
+	drawn   pathSegment
+}
+
+// SetPathRouting sets how the paths drawn on this gridder avoid each other, nil turns routing off. Paths overlapping
+// parallel paths drawn before them are nudged apart, and paths jump over the paths drawn before them with small arcs
+// where they cross. The base grid and its overlay are routed separately. Routing applies to paths drawn afterwards and
+// to every path when the grid is re-rendered
+func (g *Gridder) SetPathRouting(pathRoutingConfig *PathRoutingConfig) error {
+	if pathRoutingConfig != nil {
+		err := pathRoutingConfig.validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	g.pathRouting = pathRoutingConfig
+	return nil
+}
+
+// pathJump is the part of a path jumping over the paths it crosses, between distances along the path
+type pathJump struct {
+	from float64
+	to   float64
+}
+
+// routePath nudges a path off the parallel paths it overlaps and finds where it crosses the paths routed before it,
+// recording it. It gets the segment to draw and its jumps, in order
+func (g *Gridder) routePath(start *gg.Point, end *gg.Point) (pathSegment, []pathJump) {
+	segment := pathSegment{x1: start.X, y1: start.Y, x2: end.X, y2: end.Y}
+	length := segment.length()
+	if length == 0 {
+		return segment, nil
+	}
+
+	var overlaps int
+	for _, routed := range g.routedPaths {
+		if segment.overlaps(routed.segment) {
+			overlaps++
+		}
+	}
+
+	// overlapping paths alternate sides of the first one, further out every other path
+	drawn := segment
+	if overlaps > 0 {
+		offset := g.pathRouting.GetSpacing() * float64((overlaps+1)/2)
+		if overlaps%2 == 0 {
+			offset = -offset
+		}
+		normalX, normalY := segment.normal()
+		drawn = pathSegment{
+			x1: segment.x1 + normalX*offset, y1: segment.y1 + normalY*offset,
+			x2: segment.x2 + normalX*offset, y2: segment.y2 + normalY*offset,
+		}
+	}
+
+	radius := g.pathRouting.GetJumpRadius()
+	var crossings []float64
+	for _, routed := range g.routedPaths {
+		t, u, ok := drawn.intersect(routed.drawn)
+		if !ok {
+			continue
+		}
+		// paths meeting at their ends, such as at a node, do not jump
+		along, across := t*length, u*routed.drawn.length()
+		if along < radius || along > length-radius || across < pathRoutingTolerance || across > routed.drawn.length()-pathRoutingTolerance {
+			continue
+		}
+		crossings = append(crossings, along)
+	}
+	sort.Float64s(crossings)
+
+	// crossings closer than a jump, such as of nudged paths, are jumped at once
+	var jumps []pathJump
+	for _, crossing := range crossings {
+		if len(jumps) > 0 && crossing-radius <= jumps[len(jumps)-1].to {
+			jumps[len(jumps)-1].to = crossing + radius
+			continue
+		}
+		jumps = append(jumps, pathJump{from: crossing - radius, to: crossing + radius})
+	}
+
+	g.routedPaths = append(g.routedPaths, routedPath{segment: segment, drawn: drawn})
+	return drawn, jumps
+}
+
+// traceRoutedPath traces a routed segment, jumping with half ellipses as high as the jump radius. Jumps bulge to the
+// same side of a line whichever way it is drawn, up or left
+func (g *Gridder) traceRoutedPath(segment pathSegment, jumps []pathJump) {
+	length := segment.length()
+	angle := math.Atan2(segment.y2-segment.y1, segment.x2-segment.x1)
+	// half ellipses from behind the jump turning clockwise bulge to the left of the segment, up unless it is reversed
+	sweep := math.Pi
+	if segment.isReversed() {
+		sweep = -math.Pi
+	}
+
+	radius := g.pathRouting.GetJumpRadius()
+	g.ctx.MoveTo(segment.x1, segment.y1)
+	for _, jump := range jumps {
+		middle := (jump.from + jump.to) / 2
+		x := segment.x1 + (segment.x2-segment.x1)*middle/length
+		y := segment.y1 + (segment.y2-segment.y1)*middle/length
+		// the path keeps the points traced while the context is rotated along the segment
+		g.ctx.Push()
+		g.ctx.RotateAbout(angle, x, y)
+		g.ctx.DrawEllipticalArc(x, y, (jump.to-jump.from)/2, radius, math.Pi, math.Pi+sweep)
+		g.ctx.Pop()
+	}
+	g.ctx.LineTo(segment.x2, segment.y2)
+}
+
+func (s pathSegment) length() float64 {
+	return math.Hypot(s.x2-s.x1, s.y2-s.y1)
+}
+
+// isReversed determines if the segment runs left, or down when it is vertical
+func (s pathSegment) isReversed() bool {
+	return s.x2 < s.x1 || (s.x2 == s.x1 && s.y2 > s.y1)
+}
+
+// normal gets the unit normal of the line of the segment pointing up, or left for vertical lines, the same for both
+// directions of the line
+func (s pathSegment) normal() (float64, float64) {
+	length := s.length()
+	dx, dy := (s.x2-s.x1)/length, (s.y2-s.y1)/length
+	if s.isReversed() {
+		dx, dy = -dx, -dy
+	}
+	return dy, -dx
+}
+
+// overlaps determines if another segment lies on the line of the segment and shares a part of it
+func (s pathSegment) overlaps(other pathSegment) bool {
+	length := s.length()
+	if length == 0 {
+		return false
+	}
+
+	dx, dy := (s.x2-s.x1)/length, (s.y2-s.y1)/length
+	distance1 := dx*(other.y1-s.y1) - dy*(other.x1-s.x1)
+	distance2 := dx*(other.y2-s.y1) - dy*(other.x2-s.x1)
+	if math.Abs(distance1) > pathRoutingTolerance || math.Abs(distance2) > pathRoutingTolerance {
+		return false
+	}
+
+	projection1 := dx*(other.x1-s.x1) + dy*(other.y1-s.y1)
+	projection2 := dx*(other.x2-s.x1) + dy*(other.y2-s.y1)
+	return math.Max(projection1, projection2) > pathRoutingTolerance && math.Min(projection1, projection2) < length-pathRoutingTolerance
+}
+
+// intersect gets where the segment crosses another segment, as fractions of the lengths of both segments. It reports
+// false for parallel segments and segments that do not cross
+func (s pathSegment) intersect(other pathSegment) (float64, float64, bool) {
+	dx1, dy1 := s.x2-s.x1, s.y2-s.y1
+	dx2, dy2 := other.x2-other.x1, other.y2-other.y1
+	denominator := dx1*dy2 - dy1*dx2
+	if denominator == 0 {
+		return 0, 0, false
+	}
+
+	t := ((other.x1-s.x1)*dy2 - (other.y1-s.y1)*dx2) / denominator
+	u := ((other.x1-s.x1)*dy1 - (other.y1-s.y1)*dx1) / denominator
+	if t <= 0 || t >= 1 || u <= 0 || u >= 1 {
+		return 0, 0, false
+	}
+	return t, u, true
+}
@@ -0,0 +1,87 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/fogleman/gg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathSegment(t *testing.T) {
+	horizontal := pathSegment{x1: 10, y1: 50, x2: 90, y2: 50}
+	assert.Equal(t, horizontal.length(), 80.0)
+	assert.False(t, horizontal.isReversed())
+	normalX, normalY := horizontal.normal()
+	assert.Equal(t, normalX, 0.0)
+	assert.Equal(t, normalY, -1.0)
+
+	reversed := pathSegment{x1: 90, y1: 50, x2: 30, y2: 50}
+	assert.True(t, reversed.isReversed())
+	normalX, normalY = reversed.normal()
+	assert.Equal(t, normalX, 0.0)
+	assert.Equal(t, normalY, -1.0)
+
+	assert.True(t, horizontal.overlaps(reversed))
+	assert.False(t, horizontal.overlaps(pathSegment{x1: 90, y1: 50, x2: 95, y2: 50}))
+	assert.False(t, horizontal.overlaps(pathSegment{x1: 10, y1: 60, x2: 90, y2: 60}))
+
+	vertical := pathSegment{x1: 30, y1: 10, x2: 30, y2: 90}
+	t1, t2, ok := horizontal.intersect(vertical)
+	assert.True(t, ok)
+	assert.Equal(t, t1, 0.25)
+	assert.Equal(t, t2, 0.5)
+
+	_, _, ok = horizontal.intersect(pathSegment{x1: 10, y1: 60, x2: 90, y2: 60})
+	assert.False(t, ok)
+	_, _, ok = horizontal.intersect(pathSegment{x1: 30, y1: 60, x2: 30, y2: 90})
+	assert.False(t, ok)
+}
+
+func TestRoutePath(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 5, Columns: 5})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetPathRouting(&PathRoutingConfig{}))
+
+	segment, jumps := gridder.routePath(&gg.Point{X: 10, Y: 50}, &gg.Point{X: 90, Y: 50})
+	assert.Equal(t, segment, pathSegment{x1: 10, y1: 50, x2: 90, y2: 50})
+	assert.Nil(t, jumps)
+
+	segment, _ = gridder.routePath(&gg.Point{X: 90, Y: 50}, &gg.Point{X: 10, Y: 50})
+	assert.Equal(t, segment, pathSegment{x1: 90, y1: 46, x2: 10, y2: 46})
+	segment, _ = gridder.routePath(&gg.Point{X: 10, Y: 50}, &gg.Point{X: 90, Y: 50})
+	assert.Equal(t, segment, pathSegment{x1: 10, y1: 54, x2: 90, y2: 54})
+
+	// the crossings of the nudged paths are jumped at once
+	segment, jumps = gridder.routePath(&gg.Point{X: 50, Y: 10}, &gg.Point{X: 50, Y: 90})
+	assert.Equal(t, segment, pathSegment{x1: 50, y1: 10, x2: 50, y2: 90})
+	assert.Equal(t, jumps, []pathJump{{from: 32, to: 48}})
+
+	// paths meeting at their ends do not jump
+	_, jumps = gridder.routePath(&gg.Point{X: 10, Y: 10}, &gg.Point{X: 10, Y: 50})
+	assert.Nil(t, jumps)
+}
+
+func TestSetPathRouting(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	draw := func(pathRoutingConfig *PathRoutingConfig) *Gridder {
+		gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 5, Columns: 5})
+		assert.Nil(t, err)
+		assert.Nil(t, gridder.SetPathRouting(pathRoutingConfig))
+		assert.Nil(t, gridder.DrawPath(2, 0, 2, 4, PathConfig{StrokeWidth: 2, Color: red}))
+		assert.Nil(t, gridder.DrawPath(0, 2, 4, 2, PathConfig{StrokeWidth: 2, Color: red}))
+		return gridder
+	}
+
+	img := draw(nil).Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 47)), red)
+
+	gridder := draw(&PathRoutingConfig{})
+	img = gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 47)), white)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 30)), red)
+	assert.NotEqual(t, color.RGBAModel.Convert(img.At(46, 47)), white)
+
+	assert.ErrorIs(t, gridder.SetPathRouting(&PathRoutingConfig{Spacing: -1}), errInvalidValue)
+}
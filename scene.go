@@ -0,0 +1,485 @@
+package gridder
+
+import (
+	"image/color"
+	"math"
+
+	"golang.org/x/image/font"
+)
+
+// operation is a retained drawing call. Cells are the cells it draws in, so that it can follow its content when
+// rows or columns are inserted or deleted, operations without cells cover the whole grid. A partial operation
+// draws every cell independently and keeps drawing the remaining cells when some of them are deleted
+type operation struct {
+	cells   []Cell
+	partial bool
+	draw    func(cells []Cell) error
+	key     string
+}
+
+// cellMapping maps a cell to its new position, reporting false when the cell is removed
+type cellMapping func(cell Cell) (Cell, bool)
+
+// removedCell marks cells of partial operations that were deleted
+var removedCell = Cell{Row: -1, Column: -1}
+
+// InsertRow inserts an empty row before a row, shifting the content below it down. Inserting at the row count
+// appends a row
+func (g *Gridder) InsertRow(at int) error {
+	gridConfig := g.GridConfig()
+	if at < 0 || at > gridConfig.GetRows() {
+		return errOutOfBounds
+	}
+
+	gridConfig.Rows = gridConfig.GetRows() + 1
+	return g.restructure(gridConfig, func(cell Cell) (Cell, bool) {
+		if cell.Row >= at {
+			cell.Row++
+		}
+		return cell, true
+	})
+}
+
+// DeleteRow deletes a row and its content, shifting the content below it up
+func (g *Gridder) DeleteRow(at int) error {
+	gridConfig := g.GridConfig()
+	if at < 0 || at >= gridConfig.GetRows() {
+		return errOutOfBounds
+	}
+
+	gridConfig.Rows = gridConfig.GetRows() - 1
+	return g.restructure(gridConfig, func(cell Cell) (Cell, bool) {
+		if cell.Row == at {
+			return cell, false
+		}
+		if cell.Row > at {
+			cell.Row--
+		}
+		return cell, true
+	})
+}
+
+// InsertColumn inserts an empty column before a column, shifting the content right of it. Inserting at the
+// column count appends a column
+func (g *Gridder) InsertColumn(at int) error {
+	gridConfig := g.GridConfig()
+	if at < 0 || at > gridConfig.GetColumns() {
+		return errOutOfBounds
+	}
+
+	gridConfig.Columns = gridConfig.GetColumns() + 1
+	return g.restructure(gridConfig, func(cell Cell) (Cell, bool) {
+		if cell.Column >= at {
+			cell.Column++
+		}
+		return cell, true
+	})
+}
+
+// DeleteColumn deletes a column and its content, shifting the content right of it left
+func (g *Gridder) DeleteColumn(at int) error {
+	gridConfig := g.GridConfig()
+	if at < 0 || at >= gridConfig.GetColumns() {
+		return errOutOfBounds
+	}
+
+	gridConfig.Columns = gridConfig.GetColumns() - 1
+	return g.restructure(gridConfig, func(cell Cell) (Cell, bool) {
+		if cell.Column == at {
+			return cell, false
+		}
+		if cell.Column > at {
+			cell.Column--
+		}
+		return cell, true
+	})
+}
+
+// CopyCell duplicates the drawing operations, state, link, tooltip and style of a cell into another cell and
+// re-renders. Operations that span other cells too, such as paths, are not copied
+func (g *Gridder) CopyCell(srcRow int, srcColumn int, dstRow int, dstColumn int) error {
+	src, dst, err := g.verifyCellPair(srcRow, srcColumn, dstRow, dstColumn)
+	if err != nil {
+		return err
+	}
+
+	err = g.verifyOperationCount(g.operations.countCopies(src))
+	if err != nil {
+		return err
+	}
+
+	g.operations.copyCell(src, dst)
+
+	if state, ok := g.states[src]; ok {
+		g.states[dst] = state
+	}
+	if link, ok := g.links[src]; ok {
+		g.links[dst] = link
+	}
+	if tooltip, ok := g.tooltips[src]; ok {
+		g.tooltips[dst] = tooltip
+	}
+	if style, ok := g.cellStyles[src]; ok {
+		g.cellStyles[dst] = style
+	}
+	g.relayout()
+	return nil
+}
+
+// MoveCell relocates the drawing operations, state, link, tooltip and style of a cell to another cell and
+// re-renders. Operations that span other cells too, such as paths, keep their other ends in place
+func (g *Gridder) MoveCell(srcRow int, srcColumn int, dstRow int, dstColumn int) error {
+	src, dst, err := g.verifyCellPair(srcRow, srcColumn, dstRow, dstColumn)
+	if err != nil {
+		return err
+	}
+
+	mapping := func(cell Cell) (Cell, bool) {
+		if cell == src {
+			return dst, true
+		}
+		return cell, true
+	}
+	g.operations = g.operations.remap(mapping)
+
+	if state, ok := g.states[src]; ok {
+		delete(g.states, src)
+		g.states[dst] = state
+	}
+	if link, ok := g.links[src]; ok {
+		delete(g.links, src)
+		g.links[dst] = link
+	}
+	if tooltip, ok := g.tooltips[src]; ok {
+		delete(g.tooltips, src)
+		g.tooltips[dst] = tooltip
+	}
+	if style, ok := g.cellStyles[src]; ok {
+		delete(g.cellStyles, src)
+		g.cellStyles[dst] = style
+	}
+	g.relayout()
+	return nil
+}
+
+// retain runs a whole grid drawing operation and records it so that it can be replayed when the layout changes
+func (g *Gridder) retain(draw func() error) error {
+	return g.retainOperation(operation{draw: func([]Cell) error { return draw() }})
+}
+
+// retainCells runs a drawing operation in cells and records it so that it can be replayed when the layout changes
+func (g *Gridder) retainCells(cells []Cell, draw func(cells []Cell) error) error {
+	return g.retainOperation(operation{cells: cells, draw: draw})
+}
+
+// retainStrings draws a matrix of strings as a partial operation, so that every string follows its cell
+func (g *Gridder) retainStrings(texts [][]string, fontFace font.Face, stringConfig StringConfig, colorAt func(row int, column int) color.Color) error {
+	var cells []Cell
+	var values []string
+	for row := range texts {
+		for column, text := range texts[row] {
+			if text == "" {
+				continue
+			}
+			cells = append(cells, Cell{Row: row, Column: column})
+			values = append(values, text)
+		}
+	}
+
+	return g.retainOperation(operation{cells: cells, partial: true, draw: func(cells []Cell) error {
+		return g.drawStrings(textMatrix(cells, values), fontFace, stringConfig, colorAt)
+	}})
+}
+
+// retainOperation runs and records an operation. Operations nested in a retained operation are recorded as
+// part of it
+func (g *Gridder) retainOperation(op operation) error {
+	if g.retaining {
+		return op.draw(op.cells)
+	}
+
+	err := g.verifyOperationCount(1)
+	if err != nil {
+		return err
+	}
+
+	g.retaining = true
+	err = op.draw(op.cells)
+	g.retaining = false
+	if err != nil {
+		return err
+	}
+
+	op.key = g.operationKey
+	g.operations.add(op)
+	return nil
+}
+
+// retainDescribed runs retain with the operations it retains identified by a drawing call and its arguments, so that
+// EncodeCached can tell scenes apart without rendering them. Operations keep no key when an argument cannot be
+// described, such as a function
+func (g *Gridder) retainDescribed(call string, args []interface{}, retain func() error) error {
+	if g.retaining {
+		return retain()
+	}
+
+	g.operationKey, _ = describeCall(call, args)
+	defer func() {
+		g.operationKey = ""
+	}()
+	return retain()
+}
+
+// retainPaint paints a cell and records it like retainOperation, stored by value without a closure
+func (g *Gridder) retainPaint(paint paintOperation) error {
+	if g.retaining {
+		return g.paintCell(paint.cell.Row, paint.cell.Column, paint.color())
+	}
+
+	err := g.verifyOperationCount(1)
+	if err != nil {
+		return err
+	}
+
+	err = g.paintCell(paint.cell.Row, paint.cell.Column, paint.color())
+	if err != nil {
+		return err
+	}
+
+	g.operations.addPaint(paint)
+	return nil
+}
+
+// restructure applies a new grid configuration, moving the retained content with a cell mapping, and re-renders.
+// A nil mapping keeps every cell in place
+func (g *Gridder) restructure(gridConfig GridConfig, mapping cellMapping) error {
+	if g.parent != nil {
+		return g.parent.restructure(gridConfig, mapping)
+	}
+
+	if gridConfig.GetRows() == 0 {
+		return errNoRows
+	}
+	if gridConfig.GetColumns() == 0 {
+		return errNoColumns
+	}
+
+	if mapping != nil {
+		remapGridConfig(&gridConfig, mapping)
+	}
+
+	err := verifyCells(gridConfig.GetRows(), gridConfig.GetColumns(), g.imageConfig.Limits.GetMaxCells())
+	if err != nil {
+		return err
+	}
+
+	err = gridConfig.validate(g.imageConfig.GetWidth(), g.imageConfig.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	for _, gridder := range []*Gridder{g, g.overlay} {
+		if gridder == nil {
+			continue
+		}
+
+		if mapping != nil {
+			gridder.remap(mapping)
+		}
+		gridder.gridConfig = cloneGridConfig(gridConfig)
+		gridder.relayout()
+	}
+	return nil
+}
+
+func (g *Gridder) remap(mapping cellMapping) {
+	g.operations = g.operations.remap(mapping)
+
+	if g.states != nil {
+		states := make(map[Cell]string, len(g.states))
+		for cell, state := range g.states {
+			if cell, ok := mapping(cell); ok {
+				states[cell] = state
+			}
+		}
+		g.states = states
+	}
+
+	if g.links != nil {
+		links := make(map[Cell]string, len(g.links))
+		for cell, link := range g.links {
+			if cell, ok := mapping(cell); ok {
+				links[cell] = link
+			}
+		}
+		g.links = links
+	}
+
+	if g.tooltips != nil {
+		tooltips := make(map[Cell]string, len(g.tooltips))
+		for cell, tooltip := range g.tooltips {
+			if cell, ok := mapping(cell); ok {
+				tooltips[cell] = tooltip
+			}
+		}
+		g.tooltips = tooltips
+	}
+
+	for _, position := range g.entities {
+		if !position.placed {
+			continue
+		}
+
+		cell, ok := mapping(Cell{Row: int(math.Round(position.row)), Column: int(math.Round(position.column))})
+		position.row, position.column, position.placed = float64(cell.Row), float64(cell.Column), ok
+	}
+
+	if g.cellStyles != nil {
+		cellStyles := make(map[Cell]CellStyle, len(g.cellStyles))
+		for cell, style := range g.cellStyles {
+			if cell, ok := mapping(cell); ok {
+				cellStyles[cell] = style
+			}
+		}
+		g.cellStyles = cellStyles
+	}
+}
+
+func (op operation) remap(mapping cellMapping) (operation, bool) {
+	if op.cells == nil {
+		return op, true
+	}
+
+	cells := make([]Cell, len(op.cells))
+	for i, cell := range op.cells {
+		remapped, ok := cell, cell != removedCell
+		if ok {
+			remapped, ok = mapping(cell)
+		}
+		if !ok && !op.partial {
+			return op, false
+		}
+		if !ok {
+			remapped = removedCell
+		}
+		cells[i] = remapped
+	}
+	op.cells = cells
+	return op, true
+}
+
+// copyCell returns a copy of the operation drawing only in the destination cell, reporting false when the
+// operation does not belong to the source cell
+func (op operation) copyCell(src Cell, dst Cell) (operation, bool) {
+	cells := make([]Cell, len(op.cells))
+	copied := false
+	for i, cell := range op.cells {
+		switch {
+		case cell == src:
+			cells[i] = dst
+			copied = true
+		case op.partial:
+			cells[i] = removedCell
+		default:
+			return op, false
+		}
+	}
+	op.cells = cells
+	return op, copied
+}
+
+func (g *Gridder) verifyCellPair(srcRow int, srcColumn int, dstRow int, dstColumn int) (Cell, Cell, error) {
+	err := g.verifyInBounds(srcRow, srcColumn)
+	if err != nil {
+		return Cell{}, Cell{}, err
+	}
+
+	err = g.verifyInBounds(dstRow, dstColumn)
+	if err != nil {
+		return Cell{}, Cell{}, err
+	}
+	return Cell{Row: srcRow, Column: srcColumn}, Cell{Row: dstRow, Column: dstColumn}, nil
+}
+
+func (g *Gridder) relayout() {
+	g.layout = nil
+	resetContext(g.ctx, g.gridConfig)
+	g.paintBackground()
+	g.labels = nil
+	g.labelBoxes = nil
+	g.routedPaths = nil
+
+	operations := g.operations
+	g.operations = operationStore{}
+	g.retaining = true
+	for _, ref := range operations.order {
+		if ref.kind == paintOperationKind {
+			paint := operations.paints[ref.index]
+			if g.paintCell(paint.cell.Row, paint.cell.Column, paint.color()) == nil {
+				g.operations.addPaint(paint)
+			}
+			continue
+		}
+
+		op := operations.operation(ref)
+		if op.draw(op.cells) == nil {
+			g.operations.add(op)
+		}
+	}
+	g.retaining = false
+}
+
+// textMatrix places texts in a matrix at their cells, skipping removed cells
+func textMatrix(cells []Cell, texts []string) [][]string {
+	var matrix [][]string
+	for i, cell := range cells {
+		if cell == removedCell {
+			continue
+		}
+		for len(matrix) <= cell.Row {
+			matrix = append(matrix, nil)
+		}
+		for len(matrix[cell.Row]) <= cell.Column {
+			matrix[cell.Row] = append(matrix[cell.Row], "")
+		}
+		matrix[cell.Row][cell.Column] = texts[i]
+	}
+	return matrix
+}
+
+// remapGridConfig moves the row and column offsets and styles of a grid configuration with a cell mapping.
+// Rows are mapped as cells of column -1 and columns as cells of row -1, so they only follow their own axis
+func remapGridConfig(gridConfig *GridConfig, mapping cellMapping) {
+	var rowOffsets []*RowHeightOffset
+	for _, offset := range gridConfig.RowsHeightOffset {
+		if cell, ok := mapping(Cell{Row: offset.Row, Column: -1}); ok {
+			rowOffsets = append(rowOffsets, &RowHeightOffset{Row: cell.Row, Offset: offset.Offset})
+		}
+	}
+	gridConfig.RowsHeightOffset = rowOffsets
+
+	var columnOffsets []*ColumnWidthOffset
+	for _, offset := range gridConfig.ColumnsWidthOffset {
+		if cell, ok := mapping(Cell{Row: -1, Column: offset.Column}); ok {
+			columnOffsets = append(columnOffsets, &ColumnWidthOffset{Column: cell.Column, Offset: offset.Offset})
+		}
+	}
+	gridConfig.ColumnsWidthOffset = columnOffsets
+
+	var rowStyles []*RowStyle
+	for _, style := range gridConfig.RowStyles {
+		if cell, ok := mapping(Cell{Row: style.Row, Column: -1}); ok {
+			rowStyles = append(rowStyles, &RowStyle{Row: cell.Row, Style: style.Style})
+		}
+	}
+	gridConfig.RowStyles = rowStyles
+
+	var columnStyles []*ColumnStyle
+	for _, style := range gridConfig.ColumnStyles {
+		if cell, ok := mapping(Cell{Row: -1, Column: style.Column}); ok {
+			columnStyles = append(columnStyles, &ColumnStyle{Column: cell.Column, Style: style.Style})
+		}
+	}
+	gridConfig.ColumnStyles = columnStyles
+	remapCellMask(gridConfig, mapping)
+}
@@ -0,0 +1,135 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestInsertRow(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 120}, GridConfig{
+		Rows: 2, Columns: 2, RowStyles: []*RowStyle{{Row: 1, Style: CellStyle{StrokeWidth: 2}}},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(1, 0, color.Black))
+	assert.Nil(t, gridder.SetState(1, 1, "on"))
+	assert.Nil(t, gridder.SetCellStyle(1, 1, CellStyle{Color: color.White}))
+
+	assert.Equal(t, gridder.InsertRow(3), errOutOfBounds)
+	assert.Nil(t, gridder.InsertRow(1))
+
+	gridConfig := gridder.GridConfig()
+	assert.Equal(t, gridConfig.Rows, 3)
+	assert.Equal(t, gridConfig.RowStyles[0].Row, 2)
+	assert.Equal(t, gridder.operations.cellsAt(0), []Cell{{Row: 2, Column: 0}})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(25, 100)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(25, 60)), color.Gray{Y: 255})
+
+	state, err := gridder.GetState(2, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, state, "on")
+	assert.Equal(t, gridder.getCellStyle(2, 1).Color, color.White)
+
+	assert.Nil(t, gridder.InsertRow(3))
+	assert.Equal(t, gridder.GridConfig().Rows, 4)
+}
+
+func TestDeleteRow(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	assert.Nil(t, gridder.PaintCell(1, 1, color.Black))
+	assert.Nil(t, gridder.DrawPath(0, 0, 1, 1))
+
+	assert.Equal(t, gridder.DeleteRow(2), errOutOfBounds)
+	assert.Nil(t, gridder.DeleteRow(0))
+	assert.Equal(t, gridder.operations.len(), 1)
+	assert.Equal(t, gridder.operations.cellsAt(0), []Cell{{Row: 0, Column: 1}})
+
+	assert.Equal(t, gridder.DeleteRow(0), errNoRows)
+}
+
+func TestInsertColumn(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{
+		Rows: 2, Columns: 2, ColumnsWidthOffset: []*ColumnWidthOffset{{Column: 1, Offset: 10}},
+		RowsHeightOffset: []*RowHeightOffset{{Row: 0, Offset: 10}},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.DrawStrings([][]string{{"a", "b"}, {"", "c"}}, testFontFace()))
+	assert.Nil(t, gridder.InsertColumn(0))
+
+	gridConfig := gridder.GridConfig()
+	assert.Equal(t, gridConfig.Columns, 3)
+	assert.Equal(t, gridConfig.ColumnsWidthOffset[0].Column, 2)
+	assert.Equal(t, gridConfig.RowsHeightOffset[0].Row, 0)
+	assert.Equal(t, gridder.operations.cellsAt(0), []Cell{{Row: 0, Column: 1}, {Row: 0, Column: 2}, {Row: 1, Column: 2}})
+	assert.Equal(t, gridder.InsertColumn(-1), errOutOfBounds)
+}
+
+func TestDeleteColumn(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{
+		Rows: 2, Columns: 3, ColumnsWidthOffset: []*ColumnWidthOffset{{Column: 1, Offset: 10}},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.DrawStrings([][]string{{"a", "b", "c"}}, testFontFace()))
+	assert.Nil(t, gridder.Overlay().DrawCircle(0, 2))
+	assert.Nil(t, gridder.Overlay().DeleteColumn(1))
+
+	assert.Equal(t, gridder.GridConfig().Columns, 2)
+	assert.Equal(t, len(gridder.GridConfig().ColumnsWidthOffset), 0)
+	assert.Equal(t, gridder.operations.cellsAt(0), []Cell{{Row: 0, Column: 0}, removedCell, {Row: 0, Column: 1}})
+	assert.Equal(t, gridder.overlay.operations.cellsAt(0), []Cell{{Row: 0, Column: 1}})
+	assert.Equal(t, gridder.overlay.GridConfig().Columns, 2)
+	assert.Equal(t, gridder.DeleteColumn(2), errOutOfBounds)
+}
+
+func testFontFace() font.Face {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	return truetype.NewFace(ttf, &truetype.Options{Size: 12})
+}
+
+func TestTextMatrix(t *testing.T) {
+	assert.Nil(t, textMatrix(nil, nil))
+	assert.Equal(t, textMatrix([]Cell{{Row: 1, Column: 1}, removedCell}, []string{"a", "b"}), [][]string{nil, {"", "a"}})
+}
+
+func TestCopyCell(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	assert.Nil(t, gridder.DrawPath(0, 0, 1, 1))
+	assert.Nil(t, gridder.DrawStrings([][]string{{"a", "b"}}, testFontFace()))
+	assert.Nil(t, gridder.SetState(0, 0, "on"))
+
+	assert.Equal(t, gridder.CopyCell(0, 0, 2, 2), errOutOfBounds)
+	assert.Nil(t, gridder.CopyCell(0, 0, 1, 0))
+	assert.Equal(t, gridder.operations.len(), 5)
+	assert.Equal(t, gridder.operations.cellsAt(3), []Cell{{Row: 1, Column: 0}})
+	assert.Equal(t, gridder.operations.cellsAt(4), []Cell{{Row: 1, Column: 0}, removedCell})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(25, 75)), color.Gray{})
+
+	state, err := gridder.GetState(1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, state, "on")
+}
+
+func TestMoveCell(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.Black))
+	assert.Nil(t, gridder.DrawPath(0, 0, 1, 1))
+	assert.Nil(t, gridder.SetCellStyle(0, 0, CellStyle{Color: color.White}))
+
+	assert.Equal(t, gridder.MoveCell(-1, 0, 0, 0), errOutOfBounds)
+	assert.Nil(t, gridder.MoveCell(0, 0, 0, 1))
+	assert.Equal(t, gridder.operations.cellsAt(0), []Cell{{Row: 0, Column: 1}})
+	assert.Equal(t, gridder.operations.cellsAt(1), []Cell{{Row: 0, Column: 1}, {Row: 1, Column: 1}})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(10, 10)), color.Gray{Y: 255})
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(60, 10)), color.Gray{})
+	assert.Equal(t, gridder.getCellStyle(0, 1).Color, color.White)
+	assert.Equal(t, gridder.getCellStyle(0, 0).Color, nil)
+}
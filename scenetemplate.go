@@ -0,0 +1,363 @@
+package gridder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"unicode"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+)
+
+const sceneTemplateVersion = 1
+
+// SceneTemplate is a scene design with named parameters, instantiated with concrete values into render jobs so
+// that one design serves many data payloads
+type SceneTemplate struct {
+	ImageConfig ImageConfig
+	GridConfig  GridConfig
+
+	// Diagram is a diagram description executed as a text/template with the parameters, so that colors and labels
+	// can be written as {{.fill}} or '{{.title}}'. Printed values are substituted after the description is parsed,
+	// so they cannot add statements: labels take any text, other values must be single words such as colors
+	Diagram string
+	// Strings names the parameter holding a matrix of strings drawn with DrawStrings
+	Strings string
+	// Numbers names the parameter holding a matrix of numbers drawn with DrawNumbers
+	Numbers      string
+	NumberFormat NumberFormat
+	// Defaults holds the values of parameters that are not passed to Instantiate
+	Defaults map[string]interface{}
+
+	// Font draws labels, strings and numbers at FontFraction of the cell height. It is not saved with the template
+	Font         *truetype.Font
+	FontFraction float64
+}
+
+// sceneTemplateFile is the saved form of a template, grids are stored as in replays
+type sceneTemplateFile struct {
+	Version      int                    `json:"version"`
+	Width        int                    `json:"width"`
+	Height       int                    `json:"height"`
+	Grid         replayGrid             `json:"grid"`
+	Diagram      string                 `json:"diagram,omitempty"`
+	Strings      string                 `json:"strings,omitempty"`
+	Numbers      string                 `json:"numbers,omitempty"`
+	NumberFormat NumberFormat           `json:"numberFormat"`
+	Defaults     map[string]interface{} `json:"defaults,omitempty"`
+}
+
+// SaveSceneTemplate writes a template as JSON. Cell, row and column styles of the grid are not saved
+func SaveSceneTemplate(w io.Writer, t SceneTemplate) error {
+	return json.NewEncoder(w).Encode(sceneTemplateFile{
+		Version:      sceneTemplateVersion,
+		Width:        t.ImageConfig.Width,
+		Height:       t.ImageConfig.Height,
+		Grid:         newReplayGrid(t.GridConfig),
+		Diagram:      t.Diagram,
+		Strings:      t.Strings,
+		Numbers:      t.Numbers,
+		NumberFormat: t.NumberFormat,
+		Defaults:     t.Defaults,
+	})
+}
+
+// LoadSceneTemplate reads a template written by SaveSceneTemplate
+func LoadSceneTemplate(r io.Reader) (SceneTemplate, error) {
+	var file sceneTemplateFile
+	err := json.NewDecoder(r).Decode(&file)
+	if err != nil {
+		return SceneTemplate{}, err
+	}
+
+	if file.Version != sceneTemplateVersion {
+		return SceneTemplate{}, fmt.Errorf("%w: template version %d", errInvalidValue, file.Version)
+	}
+
+	gridConfig, err := file.Grid.gridConfig(1)
+	if err != nil {
+		return SceneTemplate{}, err
+	}
+
+	return SceneTemplate{
+		ImageConfig:  ImageConfig{Width: file.Width, Height: file.Height},
+		GridConfig:   gridConfig,
+		Diagram:      file.Diagram,
+		Strings:      file.Strings,
+		Numbers:      file.Numbers,
+		NumberFormat: file.NumberFormat,
+		Defaults:     file.Defaults,
+	}, nil
+}
+
+// Instantiate substitutes parameters into the template and returns the render job of the concrete scene.
+// Parameters override the defaults, every parameter used by the template must be set by one of them
+func (t SceneTemplate) Instantiate(id string, params map[string]interface{}) (BatchJob, error) {
+	values := make(map[string]interface{}, len(t.Defaults)+len(params))
+	for name, value := range t.Defaults {
+		values[name] = value
+	}
+	for name, value := range params {
+		values[name] = value
+	}
+
+	var diagram []DiagramOperation
+	if t.Diagram != "" {
+		var err error
+		diagram, err = instantiateDiagram(t.Diagram, values)
+		if err != nil {
+			return BatchJob{}, err
+		}
+	}
+
+	var texts [][]string
+	if t.Strings != "" {
+		var err error
+		texts, err = stringMatrixParam(t.Strings, values[t.Strings])
+		if err != nil {
+			return BatchJob{}, err
+		}
+	}
+
+	var numbers [][]float64
+	if t.Numbers != "" {
+		var err error
+		numbers, err = numberMatrixParam(t.Numbers, values[t.Numbers])
+		if err != nil {
+			return BatchJob{}, err
+		}
+	}
+
+	scene := func() (*Gridder, error) {
+		g, err := New(t.ImageConfig, cloneGridConfig(t.GridConfig))
+		if err != nil {
+			return nil, err
+		}
+
+		var fontFace font.Face
+		if t.Font != nil {
+			fontFace = g.FontFace(t.Font, t.FontFraction)
+		}
+
+		if diagram != nil {
+			err = g.retain(func() error {
+				return g.drawDiagramOperations(diagram, fontFace)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		if texts != nil {
+			err = g.DrawStrings(texts, fontFace)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if numbers != nil {
+			err = g.DrawNumbers(numbers, fontFace, t.NumberFormat)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return g, nil
+	}
+	return BatchJob{ID: id, Scene: scene}, nil
+}
+
+// instantiateDiagram executes a diagram template and parses the result. Every printed value is replaced with a
+// placeholder while the template runs, then put back as a word of the description, or into the parsed label when
+// the placeholder is quoted, so that values cannot end labels or statements
+func instantiateDiagram(source string, values map[string]interface{}) ([]DiagramOperation, error) {
+	var printed []string
+	funcs := template.FuncMap{diagramParamFunc: func(value interface{}) (string, error) {
+		text := fmt.Sprint(value)
+		if strings.ContainsAny(text, string([]rune{diagramPlaceholderStart, diagramPlaceholderEnd})) {
+			return "", fmt.Errorf("%w: parameter value %q", errInvalidValue, text)
+		}
+		printed = append(printed, text)
+		return fmt.Sprintf("%c%d%c", diagramPlaceholderStart, len(printed)-1, diagramPlaceholderEnd), nil
+	}}
+
+	tmpl, err := template.New("diagram").Option("missingkey=error").Funcs(funcs).Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	param, err := template.New("param").Funcs(funcs).Parse("{{. | " + diagramParamFunc + "}}")
+	if err != nil {
+		return nil, err
+	}
+	paramCommand := param.Tree.Root.Nodes[0].(*parse.ActionNode).Pipe.Cmds[1]
+	for _, defined := range tmpl.Templates() {
+		if defined.Tree != nil {
+			printDiagramParams(defined.Tree.Root, paramCommand)
+		}
+	}
+
+	var builder strings.Builder
+	err = tmpl.Execute(&builder, values)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err = substituteDiagramWords(builder.String(), printed)
+	if err != nil {
+		return nil, err
+	}
+	operations, err := ParseDiagram(source)
+	if err != nil {
+		return nil, err
+	}
+
+	replacements := make([]string, 0, 2*len(printed))
+	for i, text := range printed {
+		replacements = append(replacements, fmt.Sprintf("%c%d%c", diagramPlaceholderStart, i, diagramPlaceholderEnd), text)
+	}
+	replacer := strings.NewReplacer(replacements...)
+	for i := range operations {
+		operations[i].Label = replacer.Replace(operations[i].Label)
+	}
+	return operations, nil
+}
+
+const (
+	diagramParamFunc        = "diagramParam"
+	diagramPlaceholderStart = '\ue000'
+	diagramPlaceholderEnd   = '\ue001'
+)
+
+// printDiagramParams ends the pipeline of every action printing a value with a command, the way html/template
+// escapes values
+func printDiagramParams(node parse.Node, command *parse.CommandNode) {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return
+		}
+		for _, child := range node.Nodes {
+			printDiagramParams(child, command)
+		}
+	case *parse.ActionNode:
+		if len(node.Pipe.Decl) == 0 {
+			node.Pipe.Cmds = append(node.Pipe.Cmds, command)
+		}
+	case *parse.IfNode:
+		printDiagramParams(node.List, command)
+		printDiagramParams(node.ElseList, command)
+	case *parse.RangeNode:
+		printDiagramParams(node.List, command)
+		printDiagramParams(node.ElseList, command)
+	case *parse.WithNode:
+		printDiagramParams(node.List, command)
+		printDiagramParams(node.ElseList, command)
+	}
+}
+
+// substituteDiagramWords puts the printed values of placeholders outside labels back into a diagram description,
+// failing for values that are not a single word. Placeholders in labels are kept, and dropped in comments
+func substituteDiagramWords(source string, printed []string) (string, error) {
+	var builder strings.Builder
+	var quote rune
+	comment := false
+	runes := []rune(source)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == diagramPlaceholderStart {
+			end := i + 1
+			for end < len(runes) && runes[end] != diagramPlaceholderEnd {
+				end++
+			}
+			index, err := strconv.Atoi(string(runes[i+1 : minInt(end, len(runes))]))
+			if err != nil || end == len(runes) || index >= len(printed) {
+				return "", fmt.Errorf("%w: diagram placeholder", errInvalidDiagram)
+			}
+
+			switch {
+			case comment:
+			case quote != 0:
+				builder.WriteString(string(runes[i : end+1]))
+			default:
+				value := printed[index]
+				if strings.IndexFunc(value, unicode.IsSpace) >= 0 || strings.ContainsAny(value, ":;'\"") ||
+					strings.Contains(value, "->") || strings.HasSuffix(value, "#") {
+					return "", fmt.Errorf("%w: parameter value %q is not a single word", errInvalidValue, value)
+				}
+				builder.WriteString(value)
+			}
+			i = end
+			continue
+		}
+
+		switch {
+		case r == '\n':
+			quote, comment = 0, false
+		case comment:
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == 0 && (r == '\'' || r == '"'):
+			quote = r
+		case quote == 0 && r == '#' && (i+1 == len(runes) || runes[i+1] == ' ' || runes[i+1] == '\t' || runes[i+1] == '\n'):
+			comment = true
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String(), nil
+}
+
+// stringMatrixParam converts a parameter to a matrix of strings, accepting the generic slices decoded from JSON
+func stringMatrixParam(name string, value interface{}) ([][]string, error) {
+	switch matrix := value.(type) {
+	case [][]string:
+		return matrix, nil
+	case []interface{}:
+		texts := make([][]string, len(matrix))
+		for row, values := range matrix {
+			rowValues, ok := values.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%w: parameter %q row %d is not a list", errInvalidValue, name, row)
+			}
+
+			texts[row] = make([]string, len(rowValues))
+			for column, value := range rowValues {
+				text, ok := value.(string)
+				if !ok {
+					return nil, fmt.Errorf("%w: parameter %q cell %d,%d is not a string", errInvalidValue, name, row, column)
+				}
+				texts[row][column] = text
+			}
+		}
+		return texts, nil
+	}
+	return nil, fmt.Errorf("%w: parameter %q is not a matrix of strings", errInvalidValue, name)
+}
+
+// numberMatrixParam converts a parameter to a matrix of numbers, accepting the generic slices decoded from JSON
+func numberMatrixParam(name string, value interface{}) ([][]float64, error) {
+	switch matrix := value.(type) {
+	case [][]float64:
+		return matrix, nil
+	case []interface{}:
+		numbers := make([][]float64, len(matrix))
+		for row, values := range matrix {
+			rowValues, ok := values.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%w: parameter %q row %d is not a list", errInvalidValue, name, row)
+			}
+
+			numbers[row] = make([]float64, len(rowValues))
+			for column, value := range rowValues {
+				number, ok := value.(float64)
+				if !ok {
+					return nil, fmt.Errorf("%w: parameter %q cell %d,%d is not a number", errInvalidValue, name, row, column)
+				}
+				numbers[row][column] = number
+			}
+		}
+		return numbers, nil
+	}
+	return nil, fmt.Errorf("%w: parameter %q is not a matrix of numbers", errInvalidValue, name)
+}
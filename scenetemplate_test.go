@@ -0,0 +1,139 @@
+package gridder
+
+import (
+	"bytes"
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func testSceneTemplate() SceneTemplate {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	return SceneTemplate{
+		ImageConfig: ImageConfig{Width: 100, Height: 100},
+		GridConfig:  GridConfig{Rows: 2, Columns: 2, BackgroundColor: color.White},
+		Diagram:     "A1: fill {{.fill}}",
+		Strings:     "labels",
+		Numbers:     "values",
+		Defaults:    map[string]interface{}{"fill": "black", "values": [][]float64{nil, {1}}},
+		Font:        ttf,
+	}
+}
+
+func TestInstantiate(t *testing.T) {
+	sceneTemplate := testSceneTemplate()
+	job, err := sceneTemplate.Instantiate("job", map[string]interface{}{"labels": [][]string{nil, {"", "x"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, job.ID, "job")
+
+	gridder, err := job.Scene()
+	assert.Nil(t, err)
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(25, 25)), color.Gray{})
+
+	job, err = sceneTemplate.Instantiate("red", map[string]interface{}{"fill": "#ff0000", "labels": [][]string{}})
+	assert.Nil(t, err)
+	gridder, err = job.Scene()
+	assert.Nil(t, err)
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(25, 25)), color.NRGBA{R: 255, A: 255})
+
+	_, err = sceneTemplate.Instantiate("missing", nil)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	sceneTemplate.Strings = ""
+	sceneTemplate.Diagram = "A1: fill {{.color}}"
+	_, err = sceneTemplate.Instantiate("missing", nil)
+	assert.NotNil(t, err)
+
+	sceneTemplate.Diagram = "A9: fill"
+	job, err = sceneTemplate.Instantiate("out", nil)
+	assert.Nil(t, err)
+	_, err = job.Scene()
+	assert.True(t, errors.Is(err, errOutOfBounds))
+}
+
+func TestInstantiateDiagramParams(t *testing.T) {
+	source := "A1: rect {{.color}} '{{.title}}' # {{.note}}\n{{range .cells}}{{.}}: circle{{if eq $.mode \"dark\"}} black{{end}}\n{{end}}"
+	operations, err := instantiateDiagram(source, map[string]interface{}{
+		"color": "#ff0000",
+		"title": "it's \"done\"; B2: fill red\nA2: fill",
+		"note":  "not a word",
+		"cells": []string{"A2", "B2"},
+		"mode":  "dark",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, len(operations), 3)
+	assert.Equal(t, operations[0].Shape, "rect")
+	assert.Equal(t, FormatColor(operations[0].Color), "#ff0000ff")
+	assert.Equal(t, operations[0].Label, "it's \"done\"; B2: fill red\nA2: fill")
+	assert.Equal(t, operations[1].Cell, Cell{Row: 1, Column: 0})
+	assert.Equal(t, FormatColor(operations[1].Color), "#000000ff")
+	assert.Equal(t, operations[2].Cell, Cell{Row: 1, Column: 1})
+
+	for _, value := range []string{"red; B2: fill", "red\nB2: fill", "red 'label'", "A1 -> B2", "red#"} {
+		_, err = instantiateDiagram("A1: fill {{.color}} 'x'", map[string]interface{}{"color": value})
+		assert.True(t, errors.Is(err, errInvalidValue), value)
+	}
+
+	_, err = instantiateDiagram("A1: text '{{.title}}'", map[string]interface{}{"title": "\ue0000\ue001"})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	sceneTemplate := testSceneTemplate()
+	sceneTemplate.Diagram, sceneTemplate.Numbers = "A1: text '{{.title}}'", ""
+	job, err := sceneTemplate.Instantiate("quoted", map[string]interface{}{"title": "'; A2: fill\n", "labels": [][]string{}})
+	assert.Nil(t, err)
+	gridder, err := job.Scene()
+	assert.Nil(t, err)
+	assert.Equal(t, color.GrayModel.Convert(gridder.image().At(25, 75)), color.Gray{Y: 255})
+}
+
+func TestSceneTemplateSaveLoad(t *testing.T) {
+	sceneTemplate := testSceneTemplate()
+	sceneTemplate.NumberFormat = NumberFormat{Precision: 2}
+	sceneTemplate.Defaults = map[string]interface{}{"fill": "black", "values": [][]float64{{1.5}}}
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(t, SaveSceneTemplate(buffer, sceneTemplate))
+	loaded, err := LoadSceneTemplate(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, loaded.Diagram, sceneTemplate.Diagram)
+	assert.Equal(t, loaded.NumberFormat, sceneTemplate.NumberFormat)
+	assert.Equal(t, loaded.GridConfig.GetRows(), 2)
+	assert.Nil(t, loaded.Font)
+
+	loaded.Font = sceneTemplate.Font
+	job, err := loaded.Instantiate("loaded", map[string]interface{}{
+		"labels": []interface{}{[]interface{}{"a", "b"}},
+	})
+	assert.Nil(t, err)
+	gridder, err := job.Scene()
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.operations.len(), 3)
+
+	_, err = LoadSceneTemplate(bytes.NewBufferString(`{"version":2}`))
+	assert.True(t, errors.Is(err, errInvalidValue))
+	_, err = LoadSceneTemplate(bytes.NewBufferString(`{`))
+	assert.NotNil(t, err)
+}
+
+func TestMatrixParams(t *testing.T) {
+	_, err := stringMatrixParam("a", []interface{}{"row"})
+	assert.True(t, errors.Is(err, errInvalidValue))
+	_, err = stringMatrixParam("a", []interface{}{[]interface{}{1.0}})
+	assert.True(t, errors.Is(err, errInvalidValue))
+	_, err = stringMatrixParam("a", 1)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	numbers, err := numberMatrixParam("a", []interface{}{[]interface{}{1.0, 2.0}})
+	assert.Nil(t, err)
+	assert.Equal(t, numbers, [][]float64{{1, 2}})
+	_, err = numberMatrixParam("a", []interface{}{"row"})
+	assert.True(t, errors.Is(err, errInvalidValue))
+	_, err = numberMatrixParam("a", []interface{}{[]interface{}{"1"}})
+	assert.True(t, errors.Is(err, errInvalidValue))
+	_, err = numberMatrixParam("a", nil)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
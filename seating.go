@@ -0,0 +1,222 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+
+	"golang.org/x/image/font"
+)
+
+// SeatBlock is a block of seats, such as a section of a venue
+type SeatBlock struct {
+	Name    string
+	Rows    int
+	Columns int
+	// Labels overrides the labels of seats by their position in the block, empty labels keep the default label
+	Labels [][]string
+}
+
+// SeatingChart lays out blocks of seats side by side from the top, separated by aisles. Seat rows are shared by
+// all blocks and seats are numbered from the left across blocks, so that the default label of the fifth seat of
+// the third row is C5
+type SeatingChart struct {
+	Blocks []SeatBlock
+	// States holds the state of seats by label, seats without a state take the first configured state
+	States map[string]string
+}
+
+// SeatLabel gets the default label of a seat from its row and its number, both counted from 0
+func SeatLabel(row int, number int) string {
+	return columnLetters(row) + strconv.Itoa(number+1)
+}
+
+// seatPosition is the cell of a seat in the chart grid and its label
+type seatPosition struct {
+	cell  Cell
+	label string
+}
+
+// Render creates the seating chart image. A header row holds the block names, a column holds the row letters and
+// a legend of the states follows the seats. Texts are drawn when a font is configured, and the image is sized for
+// square cells unless its dimensions are set
+func (c SeatingChart) Render(imageConfig ImageConfig, seatingConfigs ...SeatingConfig) (*Gridder, error) {
+	seatingConfig := getFirstSeatingConfig(seatingConfigs...)
+	if len(c.Blocks) == 0 {
+		return nil, errNoColumns
+	}
+
+	stateColors := make(map[string]int)
+	states := seatingConfig.GetStates()
+	for i, state := range states {
+		stateColors[state.Name] = i
+	}
+
+	seats, rows, columns, err := c.layout(seatingConfig.GetAisleWidth())
+	if err != nil {
+		return nil, err
+	}
+
+	seatStates := make([]int, len(seats))
+	labels := make(map[string]bool, len(seats))
+	for i, seat := range seats {
+		labels[seat.label] = true
+		state, ok := c.States[seat.label]
+		if !ok {
+			continue
+		}
+
+		seatStates[i], ok = stateColors[state]
+		if !ok {
+			return nil, fmt.Errorf("%w: state %q of seat %s", errInvalidValue, state, seat.label)
+		}
+	}
+	for label := range c.States {
+		if !labels[label] {
+			return nil, fmt.Errorf("%w: seat %s", errOutOfBounds, label)
+		}
+	}
+
+	// a header row, the seats, a spacing row and the legend
+	gridRows := rows + 3
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultSeatingCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = gridRows * defaultSeatingCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            gridRows,
+		Columns:         columns,
+		LineStrokeWidth: defaultSeatingGap,
+		LineColor:       seatingConfig.GetBackgroundColor(),
+		BorderColor:     seatingConfig.GetBackgroundColor(),
+		BackgroundColor: seatingConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if seatingConfig.Font != nil {
+		fontFace = g.FontFace(seatingConfig.Font, seatingConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		for i, seat := range seats {
+			fill := states[seatStates[i]].Color
+			err := g.paintCell(seat.cell.Row, seat.cell.Column, fill)
+			if err != nil {
+				return err
+			}
+
+			if fontFace != nil {
+				err = g.drawString(seat.cell.Row, seat.cell.Column, seat.label, fontFace, StringConfig{Color: contrastColor(fill)})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if fontFace == nil {
+			return nil
+		}
+
+		stringConfig := StringConfig{Color: seatingConfig.GetTextColor()}
+		for row := 0; row < rows; row++ {
+			err := g.drawString(row+1, 0, columnLetters(row), fontFace, stringConfig)
+			if err != nil {
+				return err
+			}
+		}
+
+		g.ctx.Push()
+		defer g.ctx.Pop()
+		g.ctx.SetFontFace(fontFace)
+		g.ctx.SetColor(seatingConfig.GetTextColor())
+		column := 1
+		for _, block := range c.Blocks {
+			first := g.getCellCenter(0, column)
+			last := g.getCellCenter(0, column+block.Columns-1)
+			g.ctx.DrawStringAnchored(block.Name, (first.X+last.X)/2, first.Y, 0.5, 0.35)
+			g.addLabel(0, column, block.Name)
+			column += block.Columns + seatingConfig.GetAisleWidth()
+		}
+
+		names := make([]string, len(states))
+		colors := make([]color.Color, len(states))
+		for i, state := range states {
+			names[i], colors[i] = state.Name, state.Color
+		}
+		g.drawLegend(gridRows-1, 1, names, colors)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// layout places the seats of every block and gets the number of seat rows and of grid columns
+func (c SeatingChart) layout(aisleWidth int) ([]seatPosition, int, int, error) {
+	var seats []seatPosition
+	var rows int
+	column, number := 1, 0
+	for i, block := range c.Blocks {
+		if block.Rows <= 0 || block.Columns <= 0 {
+			return nil, 0, 0, fmt.Errorf("%w: block %d of %dx%d seats", errInvalidValue, i, block.Rows, block.Columns)
+		}
+		if len(block.Labels) > block.Rows {
+			return nil, 0, 0, fmt.Errorf("%w: labels of block %d", errMatrixDimensions, i)
+		}
+
+		for row := 0; row < block.Rows; row++ {
+			var rowLabels []string
+			if row < len(block.Labels) {
+				rowLabels = block.Labels[row]
+			}
+			if len(rowLabels) > block.Columns {
+				return nil, 0, 0, fmt.Errorf("%w: labels of block %d", errMatrixDimensions, i)
+			}
+
+			for seat := 0; seat < block.Columns; seat++ {
+				label := SeatLabel(row, number+seat)
+				if seat < len(rowLabels) && rowLabels[seat] != "" {
+					label = rowLabels[seat]
+				}
+				seats = append(seats, seatPosition{cell: Cell{Row: row + 1, Column: column + seat}, label: label})
+			}
+		}
+
+		rows = maxInt(rows, block.Rows)
+		column += block.Columns
+		number += block.Columns
+		if i < len(c.Blocks)-1 {
+			column += aisleWidth
+		}
+	}
+	return seats, rows, column, nil
+}
+
+// drawLegend draws a swatch and a name for every color along a row, starting at the left of a column, with the
+// font face and text color of the context
+func (g *Gridder) drawLegend(row int, column int, names []string, colors []color.Color) {
+	center := g.getCellCenter(row, column)
+	cellWidth, cellHeight := g.getCellDimensions(row, column)
+	size := cellHeight - g.gridConfig.GetLineStrokeWidth()
+	x := center.X - cellWidth/2
+	for i, name := range names {
+		g.ctx.Push()
+		g.ctx.DrawRectangle(x, center.Y-size/2, size, size)
+		g.ctx.SetColor(colors[i])
+		g.ctx.Fill()
+		g.ctx.Pop()
+
+		x += size + size/3
+		g.ctx.DrawStringAnchored(name, x, center.Y, 0, 0.35)
+		textWidth, _ := g.ctx.MeasureString(name)
+		x += textWidth + size
+		g.addLabel(row, column, name)
+	}
+}
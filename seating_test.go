@@ -0,0 +1,72 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+var testSeatBlocks = []SeatBlock{
+	{Name: "Left", Rows: 2, Columns: 3},
+	{Name: "Right", Rows: 3, Columns: 2, Labels: [][]string{{"", "VIP"}}},
+}
+
+func TestSeatLabel(t *testing.T) {
+	assert.Equal(t, SeatLabel(0, 0), "A1")
+	assert.Equal(t, SeatLabel(2, 4), "C5")
+	assert.Equal(t, SeatLabel(26, 9), "AA10")
+}
+
+func TestSeatingChartRender(t *testing.T) {
+	chart := SeatingChart{Blocks: testSeatBlocks, States: map[string]string{"B2": "reserved", "C4": "blocked"}}
+	gridder, err := chart.Render(ImageConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 210)
+	assert.Equal(t, gridder.ctx.Height(), 180)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(45, 45)), defaultSeatingStates[0].Color)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 75)), defaultSeatingStates[1].Color)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(165, 105)), defaultSeatingStates[2].Color)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(135, 45)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 105)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestSeatingChartRenderLabels(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	chart := SeatingChart{Blocks: testSeatBlocks, States: map[string]string{"VIP": "reserved"}}
+	gridder, err := chart.Render(ImageConfig{}, SeatingConfig{AisleWidth: 2, Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 240)
+
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(225, 35)), defaultSeatingStates[1].Color)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"Left"})
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 6}], []string{"Right"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 0}], []string{"C"})
+	assert.Equal(t, gridder.labels[Cell{Row: 2, Column: 2}], []string{"B2"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 6}], []string{"A4"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 7}], []string{"VIP"})
+	assert.Equal(t, gridder.labels[Cell{Row: 5, Column: 1}], []string{"free", "reserved", "blocked"})
+}
+
+func TestSeatingChartRenderErrors(t *testing.T) {
+	_, err := SeatingChart{}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errNoColumns))
+
+	_, err = SeatingChart{Blocks: []SeatBlock{{Rows: 0, Columns: 1}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = SeatingChart{Blocks: []SeatBlock{{Rows: 1, Columns: 1, Labels: [][]string{{"a", "b"}}}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errMatrixDimensions))
+
+	_, err = SeatingChart{Blocks: testSeatBlocks, States: map[string]string{"A1": "sold"}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = SeatingChart{Blocks: testSeatBlocks, States: map[string]string{"Z9": "free"}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errOutOfBounds))
+}
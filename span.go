@@ -0,0 +1,86 @@
+package gridder
+
+import (
+	"math"
+
+	"github.com/fogleman/gg"
+	"golang.org/x/image/font"
+)
+
+// Span is the number of rows and columns a shape or string covers from its cell to the right and down, merged into
+// one area as the header cells of tables. Unset spans cover one row or column
+type Span struct {
+	Rows    int
+	Columns int
+}
+
+// GetRows gets the number of rows
+func (s Span) GetRows() int {
+	if s.Rows <= 0 {
+		return 1
+	}
+	return s.Rows
+}
+
+// GetColumns gets the number of columns
+func (s Span) GetColumns() int {
+	if s.Columns <= 0 {
+		return 1
+	}
+	return s.Columns
+}
+
+// IsMerged determines if the span covers more than one cell
+func (s Span) IsMerged() bool {
+	return s.GetRows() > 1 || s.GetColumns() > 1
+}
+
+// cellArea is the area drawing in a cell is fitted and clipped to, centered on a point
+type cellArea struct {
+	center *gg.Point
+	width  float64
+	height float64
+}
+
+// mergeAreas gets the smallest area covering two areas
+func mergeAreas(area1 cellArea, area2 cellArea) cellArea {
+	left := math.Min(area1.center.X-area1.width/2, area2.center.X-area2.width/2)
+	top := math.Min(area1.center.Y-area1.height/2, area2.center.Y-area2.height/2)
+	right := math.Max(area1.center.X+area1.width/2, area2.center.X+area2.width/2)
+	bottom := math.Max(area1.center.Y+area1.height/2, area2.center.Y+area2.height/2)
+	return cellArea{center: &gg.Point{X: (left + right) / 2, Y: (top + bottom) / 2}, width: right - left, height: bottom - top}
+}
+
+// DrawRectangleSpan draws a rectangle centered on the cells of a number of rows and columns from a cell. Rectangles
+// without a width or height fill the cells up to the grid lines
+func (g *Gridder) DrawRectangleSpan(row int, column int, rowSpan int, columnSpan int, rectangleConfigs ...RectangleConfig) error {
+	rectangleConfig := getFirstRectangleConfig(rectangleConfigs...)
+	rectangleConfig.Span = Span{Rows: rowSpan, Columns: columnSpan}
+	return g.DrawRectangle(row, column, rectangleConfig)
+}
+
+// DrawStringSpan draws a string centered on the cells of a number of rows and columns from a cell, fitted and clipped
+// to them. It is styled as and labels the cell
+func (g *Gridder) DrawStringSpan(row int, column int, rowSpan int, columnSpan int, text string, fontFace font.Face, stringConfigs ...StringConfig) error {
+	stringConfig := getFirstStringConfig(stringConfigs...)
+	stringConfig.Span = Span{Rows: rowSpan, Columns: columnSpan}
+	return g.DrawString(row, column, text, fontFace, stringConfig)
+}
+
+// retainSpan runs a drawing operation in the area of the cells a span covers from a cell, and records it with its
+// first and last cells, which are both verified to be in the grid. The area is the area of the cell when the span
+// does not merge cells
+func (g *Gridder) retainSpan(row int, column int, span Span, draw func(row int, column int, area cellArea) error) error {
+	cells := []Cell{{Row: row, Column: column}}
+	if span.IsMerged() {
+		cells = append(cells, Cell{Row: row + span.GetRows() - 1, Column: column + span.GetColumns() - 1})
+	}
+
+	return g.retainAnchored(cells, func(cells []Cell, areas []cellArea) error {
+		area := areas[0]
+		if len(areas) > 1 {
+			area = mergeAreas(area, areas[1])
+		}
+		return draw(cells[0].Row, cells[0].Column, area)
+	})
+}
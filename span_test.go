@@ -0,0 +1,85 @@
+package gridder
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestSpan(t *testing.T) {
+	span := Span{}
+	assert.Equal(t, span.GetRows(), 1)
+	assert.Equal(t, span.GetColumns(), 1)
+	assert.False(t, span.IsMerged())
+
+	span = Span{Rows: 1, Columns: 3}
+	assert.Equal(t, span.GetColumns(), 3)
+	assert.True(t, span.IsMerged())
+}
+
+func TestMergeAreas(t *testing.T) {
+	area := mergeAreas(
+		cellArea{center: &gg.Point{X: 10, Y: 10}, width: 20, height: 20},
+		cellArea{center: &gg.Point{X: 40, Y: 50}, width: 20, height: 20},
+	)
+	assert.Equal(t, area, cellArea{center: &gg.Point{X: 25, Y: 30}, width: 50, height: 60})
+}
+
+func TestDrawRectangleSpan(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+
+	red := color.RGBA{R: 255, A: 255}
+	assert.Nil(t, gridder.DrawRectangleSpan(0, 1, 2, 3, RectangleConfig{Color: red}))
+	assert.Equal(t, gridder.operations.cellsAt(0), []Cell{{Row: 0, Column: 1}, {Row: 1, Column: 3}})
+
+	img := gridder.Image()
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 25)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(90, 40)), red)
+	assert.Equal(t, color.RGBAModel.Convert(img.At(10, 25)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, color.RGBAModel.Convert(img.At(50, 60)), color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+	assert.ErrorIs(t, gridder.DrawRectangleSpan(3, 3, 2, 1), errOutOfBounds)
+	assert.ErrorIs(t, gridder.DrawRectangle(0, 0, RectangleConfig{Span: Span{Rows: -1}}), errInvalidValue)
+}
+
+func TestDrawStringSpan(t *testing.T) {
+	font, err := truetype.Parse(goregular.TTF)
+	assert.Nil(t, err)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 12})
+
+	spanned, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, spanned.DrawStringSpan(1, 0, 2, 2, "Header", fontFace))
+	assert.Equal(t, spanned.labels[Cell{Row: 1, Column: 0}], []string{"Header"})
+
+	centered, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, centered.DrawStringAt(1.5, 0.5, "Header", fontFace))
+	assert.Equal(t, spanned.Image(), centered.Image())
+}
+
+func TestReplaySpan(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 4, Columns: 4})
+	assert.Nil(t, err)
+
+	gridder.StartReplay()
+	assert.Nil(t, gridder.DrawRectangleSpan(0, 0, 2, 4, RectangleConfig{Color: color.Black}))
+	gridder.RecordFrame()
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(t, gridder.ExportReplay(buffer))
+	replay, err := LoadReplay(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, replay.Commands[0].Shapes[0].ColumnSpan, 4)
+
+	frames, err := replay.Render(ImageConfig{Width: 100, Height: 100})
+	assert.Nil(t, err)
+	assert.Equal(t, color.GrayModel.Convert(frames[0].At(75, 40)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(frames[0].At(75, 60)), color.Gray{Y: 255})
+}
@@ -0,0 +1,111 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"io/fs"
+)
+
+// spriteSheet is an image of equally sized tiles, numbered from left to right and top to bottom
+type spriteSheet struct {
+	image      image.Image
+	tileWidth  int
+	tileHeight int
+	columns    int
+	count      int
+}
+
+// LoadSpriteSheet loads an image of equally sized tiles for DrawSprite, replacing the loaded sprite sheet. Tiles
+// are numbered from 0, from left to right and top to bottom, partial tiles at the right and bottom are ignored
+func (g *Gridder) LoadSpriteSheet(img image.Image, tileWidth int, tileHeight int) error {
+	if g.parent != nil {
+		return g.parent.LoadSpriteSheet(img, tileWidth, tileHeight)
+	}
+
+	bounds := img.Bounds()
+	if tileWidth <= 0 || tileHeight <= 0 || tileWidth > bounds.Dx() || tileHeight > bounds.Dy() {
+		return fmt.Errorf("%w: tile size %dx%d", errInvalidValue, tileWidth, tileHeight)
+	}
+
+	columns := bounds.Dx() / tileWidth
+	g.spriteSheet = &spriteSheet{
+		image:      img,
+		tileWidth:  tileWidth,
+		tileHeight: tileHeight,
+		columns:    columns,
+		count:      columns * (bounds.Dy() / tileHeight),
+	}
+	return nil
+}
+
+// LoadSpriteSheetFS decodes an image file of a file system, such as an embedded one, and loads it as the sprite
+// sheet. Formats are decoded with the decoders registered in the image package
+func (g *Gridder) LoadSpriteSheetFS(fsys fs.FS, name string, tileWidth int, tileHeight int) error {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return err
+	}
+	return g.LoadSpriteSheet(img, tileWidth, tileHeight)
+}
+
+// DrawSprite draws a tile of the loaded sprite sheet in a cell, scaled to fit the cell and mirrored as the config sets
+func (g *Gridder) DrawSprite(row int, column int, spriteIndex int, spriteConfigs ...SpriteConfig) error {
+	return g.retainDescribed("DrawSprite", []interface{}{row, column, spriteIndex, spriteConfigs}, func() error {
+		return g.retainCells([]Cell{{Row: row, Column: column}}, func(cells []Cell) error {
+			return g.drawSprite(cells[0].Row, cells[0].Column, spriteIndex, spriteConfigs...)
+		})
+	})
+}
+
+func (g *Gridder) drawSprite(row int, column int, spriteIndex int, spriteConfigs ...SpriteConfig) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	sprite, err := g.getSprite(spriteIndex)
+	if err != nil {
+		return err
+	}
+
+	spriteConfig := getFirstSpriteConfig(spriteConfigs...)
+	sprite = flipImage(sprite, spriteConfig.IsFlipHorizontal(), spriteConfig.IsFlipVertical())
+	cellWidth, cellHeight := g.getCellDimensions(row, column)
+	paintImage(g.ctx, g.getCellCenter(row, column), sprite, cellWidth, cellHeight, spriteConfig.GetFilter())
+	return nil
+}
+
+func (g *Gridder) getSprite(spriteIndex int) (image.Image, error) {
+	if g.parent != nil {
+		return g.parent.getSprite(spriteIndex)
+	}
+
+	sheet := g.spriteSheet
+	if sheet == nil {
+		return nil, errNoSpriteSheet
+	}
+
+	if spriteIndex < 0 || spriteIndex >= sheet.count {
+		return nil, fmt.Errorf("%w: sprite index %d", errInvalidValue, spriteIndex)
+	}
+
+	min := sheet.image.Bounds().Min
+	x, y := min.X+spriteIndex%sheet.columns*sheet.tileWidth, min.Y+spriteIndex/sheet.columns*sheet.tileHeight
+	tile := image.Rect(x, y, x+sheet.tileWidth, y+sheet.tileHeight)
+	if subImager, ok := sheet.image.(interface {
+		SubImage(r image.Rectangle) image.Image
+	}); ok {
+		return subImager.SubImage(tile), nil
+	}
+
+	sprite := image.NewRGBA(image.Rect(0, 0, sheet.tileWidth, sheet.tileHeight))
+	draw.Draw(sprite, sprite.Bounds(), sheet.image, tile.Min, draw.Src)
+	return sprite, nil
+}
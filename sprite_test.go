@@ -0,0 +1,109 @@
+package gridder
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testSpriteSheet has 3x2 tiles of 2x2 pixels, tile i is filled with gray level i*40 and its top left pixel is red
+func testSpriteSheet() *image.NRGBA {
+	sheet := image.NewNRGBA(image.Rect(0, 0, 7, 5))
+	for i := 0; i < 6; i++ {
+		x, y := i%3*2, i/3*2
+		for dy := 0; dy < 2; dy++ {
+			for dx := 0; dx < 2; dx++ {
+				gray := uint8(i * 40)
+				sheet.Set(x+dx, y+dy, color.NRGBA{R: gray, G: gray, B: gray, A: 255})
+			}
+		}
+		sheet.Set(x, y, color.NRGBA{R: 255, A: 255})
+	}
+	return sheet
+}
+
+func TestDrawSprite(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 60, Height: 40}, GridConfig{Rows: 2, Columns: 3, LineStrokeWidth: 0.01, BorderStrokeWidth: 0.01})
+	assert.Nil(t, err)
+
+	err = gridder.DrawSprite(0, 0, 0)
+	assert.True(t, errors.Is(err, errNoSpriteSheet))
+
+	assert.Nil(t, gridder.LoadSpriteSheet(testSpriteSheet(), 2, 2))
+	assert.Nil(t, gridder.DrawSprite(0, 1, 4, SpriteConfig{Filter: ScaleNearest}))
+	assert.Nil(t, gridder.Overlay().DrawSprite(1, 2, 5, SpriteConfig{Filter: ScaleNearest}))
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(21, 1)), color.NRGBA{R: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(29, 9)), color.NRGBA{R: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(31, 11)), color.NRGBA{R: 160, G: 160, B: 160, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(58, 38)), color.NRGBA{R: 200, G: 200, B: 200, A: 255})
+
+	err = gridder.DrawSprite(0, 0, 6)
+	assert.True(t, errors.Is(err, errInvalidValue))
+	err = gridder.DrawSprite(0, 3, 0)
+	assert.True(t, errors.Is(err, errOutOfBounds))
+}
+
+func TestDrawSpriteFlipped(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 1, Columns: 1, LineStrokeWidth: 0.01, BorderStrokeWidth: 0.01})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.LoadSpriteSheet(testSpriteSheet(), 2, 2))
+	assert.Nil(t, gridder.DrawSprite(0, 0, 4, SpriteConfig{Filter: ScaleNearest, FlipHorizontal: true, FlipVertical: true}))
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(15, 15)), color.NRGBA{R: 255, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(5, 5)), color.NRGBA{R: 160, G: 160, B: 160, A: 255})
+}
+
+func TestDrawSpriteBilinear(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 20, Height: 20}, GridConfig{Rows: 1, Columns: 1, LineStrokeWidth: 0.01, BorderStrokeWidth: 0.01})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.LoadSpriteSheet(testSpriteSheet(), 2, 2))
+	assert.Nil(t, gridder.DrawSprite(0, 0, 5))
+	gray := color.GrayModel.Convert(gridder.image().At(15, 15)).(color.Gray)
+	assert.InDelta(t, float64(gray.Y), 200, 2)
+}
+
+func TestLoadSpriteSheet(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	for _, size := range [][2]int{{0, 1}, {1, -1}, {8, 1}, {1, 6}} {
+		err = gridder.LoadSpriteSheet(testSpriteSheet(), size[0], size[1])
+		assert.True(t, errors.Is(err, errInvalidValue))
+	}
+
+	// images without SubImage are copied tile by tile
+	assert.Nil(t, gridder.Overlay().LoadSpriteSheet(struct{ image.Image }{testSpriteSheet()}, 2, 2))
+	sprite, err := gridder.getSprite(4)
+	assert.Nil(t, err)
+	assert.Equal(t, sprite.Bounds(), image.Rect(0, 0, 2, 2))
+	assert.Equal(t, color.NRGBAModel.Convert(sprite.At(1, 1)), color.NRGBA{R: 160, G: 160, B: 160, A: 255})
+}
+
+func TestLoadSpriteSheetFS(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(t, png.Encode(buffer, testSpriteSheet()))
+	fsys := fstest.MapFS{"sprites.png": {Data: buffer.Bytes()}, "sprites.txt": {Data: []byte("sprites")}}
+
+	assert.Nil(t, gridder.LoadSpriteSheetFS(fsys, "sprites.png", 2, 2))
+	sprite, err := gridder.getSprite(4)
+	assert.Nil(t, err)
+	bounds := sprite.Bounds()
+	assert.Equal(t, color.NRGBAModel.Convert(sprite.At(bounds.Min.X+1, bounds.Min.Y+1)), color.NRGBA{R: 160, G: 160, B: 160, A: 255})
+
+	assert.NotNil(t, gridder.LoadSpriteSheetFS(fsys, "missing.png", 2, 2))
+	assert.NotNil(t, gridder.LoadSpriteSheetFS(fsys, "sprites.txt", 2, 2))
+	assert.True(t, errors.Is(gridder.LoadSpriteSheetFS(fsys, "sprites.png", 0, 2), errInvalidValue))
+}
@@ -0,0 +1,122 @@
+package gridder
+
+import (
+	"image/color"
+)
+
+// StateStyle describes how cells in a given state are rendered
+type StateStyle struct {
+	Color     color.Color
+	Rectangle *RectangleConfig
+	Circle    *CircleConfig
+	Line      *LineConfig
+	// Style styles the shapes of the state over the style of their cell
+	Style CellStyle
+}
+
+// StyleMap maps cell states to their styles
+type StyleMap map[string]StateStyle
+
+// SetStyleMap sets the style map used by Render
+func (g *Gridder) SetStyleMap(styleMap StyleMap) {
+	g.styleMap = styleMap
+}
+
+// SetState sets the state of a cell. An empty state clears it
+func (g *Gridder) SetState(row int, column int, state string) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	cell := Cell{Row: row, Column: column}
+	if state == "" {
+		delete(g.states, cell)
+		return nil
+	}
+
+	if g.states == nil {
+		g.states = make(map[Cell]string)
+	}
+	g.states[cell] = state
+	return nil
+}
+
+// GetState gets the state of a cell
+func (g *Gridder) GetState(row int, column int) (string, error) {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return "", err
+	}
+	return g.states[Cell{Row: row, Column: column}], nil
+}
+
+// ClearStates clears the states of all cells
+func (g *Gridder) ClearStates() {
+	g.states = nil
+}
+
+// Render clears the canvas and paints every cell that has a state using the style map.
+// States without a style are left blank
+func (g *Gridder) Render() error {
+	g.paintBackground()
+	g.labels = nil
+	g.labelBoxes = nil
+	g.routedPaths = nil
+	g.operations = operationStore{}
+	return g.retain(g.renderStates)
+}
+
+func (g *Gridder) renderStates() error {
+	rows, columns := g.gridConfig.GetRows(), g.gridConfig.GetColumns()
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			state, ok := g.states[Cell{Row: row, Column: column}]
+			if !ok {
+				continue
+			}
+
+			style, ok := g.styleMap[state]
+			if !ok {
+				continue
+			}
+
+			err := g.renderStyle(row, column, style)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Gridder) renderStyle(row int, column int, style StateStyle) error {
+	if style.Color != nil {
+		err := g.PaintCell(row, column, style.Color)
+		if err != nil {
+			return err
+		}
+	}
+
+	if style.Rectangle != nil {
+		err := g.DrawRectangle(row, column, style.Rectangle.withStyle(style.Style))
+		if err != nil {
+			return err
+		}
+	}
+
+	if style.Circle != nil {
+		err := g.DrawCircle(row, column, style.Circle.withStyle(style.Style))
+		if err != nil {
+			return err
+		}
+	}
+
+	if style.Line != nil {
+		err := g.DrawLine(row, column, style.Line.withStyle(style.Style))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetState(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.SetState(-1, 0, "alive")
+	assert.NotNil(t, err)
+
+	err = gridder.SetState(1, 1, "alive")
+	assert.Nil(t, err)
+
+	state, err := gridder.GetState(1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, state, "alive")
+
+	err = gridder.SetState(1, 1, "")
+	assert.Nil(t, err)
+
+	state, err = gridder.GetState(1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, state, "")
+}
+
+func TestRender(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.SetState(0, 0, "infected")
+	assert.Nil(t, err)
+
+	err = gridder.SetState(1, 1, "unknown")
+	assert.Nil(t, err)
+
+	gridder.SetStyleMap(StyleMap{"infected": {Color: color.Black}})
+	err = gridder.Render()
+	assert.Nil(t, err)
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(25, 25)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(75, 75)), color.RGBAModel.Convert(color.White))
+
+	gridder.SetStyleMap(StyleMap{"infected": {Color: color.White, Circle: &CircleConfig{Color: color.Black}}})
+	err = gridder.Render()
+	assert.Nil(t, err)
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(25, 25)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(5, 5)), color.RGBAModel.Convert(color.White))
+
+	red := color.RGBA{R: 255, A: 255}
+	gridder.SetStyleMap(StyleMap{"infected": {Circle: &CircleConfig{}, Style: CellStyle{Color: red}}})
+	err = gridder.Render()
+	assert.Nil(t, err)
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(25, 25)), color.RGBAModel.Convert(red))
+}
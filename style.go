@@ -0,0 +1,170 @@
+package gridder
+
+// SetCellStyle overrides the style of a single cell
+func (g *Gridder) SetCellStyle(row int, column int, style CellStyle) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	if g.cellStyles == nil {
+		g.cellStyles = make(map[Cell]CellStyle)
+	}
+	g.cellStyles[Cell{Row: row, Column: column}] = style
+	return nil
+}
+
+// getCellStyle resolves the effective style of a cell. Cell overrides take precedence over column overrides,
+// which take precedence over row overrides and finally the grid-wide style
+func (g *Gridder) getCellStyle(row int, column int) CellStyle {
+	style := g.gridConfig.GetCellStyle()
+	style = g.gridConfig.GetRowStyle(row).inherit(style)
+	style = g.gridConfig.GetColumnStyle(column).inherit(style)
+	return g.cellStyles[Cell{Row: row, Column: column}].inherit(style)
+}
+
+func (s CellStyle) inherit(parent CellStyle) CellStyle {
+	if s.Fill == nil {
+		s.Fill = parent.Fill
+	}
+	if s.Color == nil {
+		s.Color = parent.Color
+	}
+	if s.StrokeWidth <= 0 {
+		s.StrokeWidth = parent.StrokeWidth
+	}
+	if s.Dashes <= 0 {
+		s.Dashes = parent.Dashes
+	}
+	if s.Rotate == 0 {
+		s.Rotate = parent.Rotate
+	}
+	if s.FontFace == nil {
+		s.FontFace = parent.FontFace
+	}
+	return s
+}
+
+func (g RectangleConfig) withStyle(style CellStyle) RectangleConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	if g.StrokeWidth <= 0 {
+		g.StrokeWidth = style.StrokeWidth
+	}
+	if g.Dashes <= 0 {
+		g.Dashes = style.Dashes
+	}
+	if g.Rotate == 0 {
+		g.Rotate = style.Rotate
+	}
+	return g
+}
+
+func (g CircleConfig) withStyle(style CellStyle) CircleConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	if g.StrokeWidth <= 0 {
+		g.StrokeWidth = style.StrokeWidth
+	}
+	if g.Dashes <= 0 {
+		g.Dashes = style.Dashes
+	}
+	return g
+}
+
+func (g PathConfig) withStyle(style CellStyle) PathConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	if g.StrokeWidth <= 0 {
+		g.StrokeWidth = style.StrokeWidth
+	}
+	if g.Dashes <= 0 {
+		g.Dashes = style.Dashes
+	}
+	return g
+}
+
+func (g LineConfig) withStyle(style CellStyle) LineConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	if g.StrokeWidth <= 0 {
+		g.StrokeWidth = style.StrokeWidth
+	}
+	if g.Dashes <= 0 {
+		g.Dashes = style.Dashes
+	}
+	if g.Rotate == 0 {
+		g.Rotate = style.Rotate
+	}
+	return g
+}
+
+func (g EdgeConfig) withStyle(style CellStyle) EdgeConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	if g.StrokeWidth <= 0 {
+		g.StrokeWidth = style.StrokeWidth
+	}
+	if g.Dashes <= 0 {
+		g.Dashes = style.Dashes
+	}
+	return g
+}
+
+func (g BorderConfig) withStyle(style CellStyle) BorderConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	if g.StrokeWidth <= 0 {
+		g.StrokeWidth = style.StrokeWidth
+	}
+	if g.Dashes <= 0 {
+		g.Dashes = style.Dashes
+	}
+	return g
+}
+
+func (g CornerConfig) withStyle(style CellStyle) CornerConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	return g
+}
+
+func (g StringConfig) withStyle(style CellStyle) StringConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	if g.Rotate == 0 {
+		g.Rotate = style.Rotate
+	}
+	return g
+}
+
+func (g ContourConfig) withStyle(style CellStyle) ContourConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	if g.StrokeWidth <= 0 {
+		g.StrokeWidth = style.StrokeWidth
+	}
+	if g.Dashes <= 0 {
+		g.Dashes = style.Dashes
+	}
+	return g
+}
+
+func (g VectorFieldConfig) withStyle(style CellStyle) VectorFieldConfig {
+	if g.Color == nil {
+		g.Color = style.Color
+	}
+	if g.StrokeWidth <= 0 {
+		g.StrokeWidth = style.StrokeWidth
+	}
+	return g
+}
@@ -0,0 +1,81 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestCellStyleCascade(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+	gridConfig := GridConfig{
+		Rows:         2,
+		Columns:      2,
+		CellStyle:    &CellStyle{Fill: color.Black, Color: color.Black, StrokeWidth: 2},
+		RowStyles:    []*RowStyle{{Row: 1, Style: CellStyle{Color: red, Dashes: 3}}},
+		ColumnStyles: []*ColumnStyle{{Column: 1, Style: CellStyle{Color: blue}}},
+	}
+
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, gridConfig)
+	assert.Nil(t, err)
+
+	err = gridder.SetCellStyle(2, 2, CellStyle{})
+	assert.NotNil(t, err)
+
+	err = gridder.SetCellStyle(1, 1, CellStyle{StrokeWidth: 5})
+	assert.Nil(t, err)
+
+	assert.Equal(t, gridder.getCellStyle(0, 0), CellStyle{Fill: color.Black, Color: color.Black, StrokeWidth: 2})
+	assert.Equal(t, gridder.getCellStyle(1, 0), CellStyle{Fill: color.Black, Color: red, StrokeWidth: 2, Dashes: 3})
+	assert.Equal(t, gridder.getCellStyle(0, 1), CellStyle{Fill: color.Black, Color: blue, StrokeWidth: 2})
+	assert.Equal(t, gridder.getCellStyle(1, 1), CellStyle{Fill: color.Black, Color: blue, StrokeWidth: 5, Dashes: 3})
+
+	err = gridder.PaintCell(0, 0, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(25, 25)), color.RGBAModel.Convert(color.Black))
+}
+
+func TestCellStyleConfigs(t *testing.T) {
+	style := CellStyle{Color: color.Black, StrokeWidth: 2, Dashes: 3, Rotate: 45}
+
+	rectangleConfig := RectangleConfig{Color: color.White}.withStyle(style)
+	assert.Equal(t, rectangleConfig, RectangleConfig{Color: color.White, StrokeWidth: 2, Dashes: 3, Rotate: 45})
+
+	circleConfig := CircleConfig{StrokeWidth: 1}.withStyle(style)
+	assert.Equal(t, circleConfig, CircleConfig{Color: color.Black, StrokeWidth: 1, Dashes: 3})
+
+	lineConfig := LineConfig{Rotate: 90}.withStyle(style)
+	assert.Equal(t, lineConfig, LineConfig{Color: color.Black, StrokeWidth: 2, Dashes: 3, Rotate: 90})
+
+	pathConfig := PathConfig{}.withStyle(style)
+	assert.Equal(t, pathConfig, PathConfig{Color: color.Black, StrokeWidth: 2, Dashes: 3})
+
+	stringConfig := StringConfig{}.withStyle(style)
+	assert.Equal(t, stringConfig, StringConfig{Color: color.Black, Rotate: 45})
+}
+
+func TestCellStyleFontFace(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	err = gridder.DrawString(0, 0, "Test", nil)
+	assert.NotNil(t, err)
+
+	err = gridder.DrawStrings([][]string{{"Test"}}, nil)
+	assert.NotNil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 24})
+	err = gridder.SetCellStyle(0, 0, CellStyle{FontFace: fontFace})
+	assert.Nil(t, err)
+
+	err = gridder.DrawString(0, 0, "Test", nil)
+	assert.Nil(t, err)
+
+	err = gridder.DrawStrings([][]string{{"Test"}}, nil)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,298 @@
+package gridder
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+)
+
+// Stylesheet describes grid, cell and state styles that can be loaded from a JSON file
+type Stylesheet struct {
+	Grid    *GridStyleDefinition       `json:"grid"`
+	Default *StyleDefinition           `json:"default"`
+	Rows    map[int]StyleDefinition    `json:"rows"`
+	Columns map[int]StyleDefinition    `json:"columns"`
+	Cells   []CellStyleDefinition      `json:"cells"`
+	States  map[string]StyleDefinition `json:"states"`
+
+	dir   string
+	fsys  fs.FS
+	fonts map[FontDefinition]font.Face
+}
+
+// GridStyleDefinition describes the grid lines, border and background
+type GridStyleDefinition struct {
+	LineColor         string   `json:"lineColor"`
+	LineDashes        *float64 `json:"lineDashes"`
+	LineStrokeWidth   *float64 `json:"lineStrokeWidth"`
+	BorderColor       string   `json:"borderColor"`
+	BorderDashes      *float64 `json:"borderDashes"`
+	BorderStrokeWidth *float64 `json:"borderStrokeWidth"`
+	BackgroundColor   string   `json:"backgroundColor"`
+}
+
+// StyleDefinition describes a cell style. Colors are names or hex strings
+type StyleDefinition struct {
+	Fill        string          `json:"fill"`
+	Color       string          `json:"color"`
+	StrokeWidth float64         `json:"strokeWidth"`
+	Dashes      float64         `json:"dashes"`
+	Rotate      float64         `json:"rotate"`
+	Font        *FontDefinition `json:"font"`
+}
+
+// CellStyleDefinition describes the style of a single cell
+type CellStyleDefinition struct {
+	Row    int             `json:"row"`
+	Column int             `json:"column"`
+	Style  StyleDefinition `json:"style"`
+}
+
+// FontDefinition describes a TrueType font file and its size in points.
+// Relative paths are resolved against the stylesheet directory
+type FontDefinition struct {
+	Path string  `json:"path"`
+	Size float64 `json:"size"`
+}
+
+// LoadStylesheet decodes a JSON stylesheet
+func LoadStylesheet(r io.Reader) (*Stylesheet, error) {
+	var stylesheet Stylesheet
+	err := json.NewDecoder(r).Decode(&stylesheet)
+	if err != nil {
+		return nil, err
+	}
+	return &stylesheet, nil
+}
+
+// LoadStylesheetFile decodes a JSON stylesheet file
+func LoadStylesheetFile(path string) (*Stylesheet, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stylesheet, err := LoadStylesheet(file)
+	if err != nil {
+		return nil, err
+	}
+	stylesheet.dir = filepath.Dir(path)
+	return stylesheet, nil
+}
+
+// LoadStylesheetFS decodes a JSON stylesheet file of a file system, such as an embedded one. Fonts are loaded from
+// the same file system
+func LoadStylesheetFS(fsys fs.FS, name string) (*Stylesheet, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stylesheet, err := LoadStylesheet(file)
+	if err != nil {
+		return nil, err
+	}
+	stylesheet.dir = path.Dir(name)
+	stylesheet.fsys = fsys
+	return stylesheet, nil
+}
+
+// Apply applies the grid, default, row and column styles to a grid configuration
+func (s *Stylesheet) Apply(gridConfig *GridConfig) error {
+	if s.Grid != nil {
+		err := s.Grid.apply(gridConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.Default != nil {
+		style, err := s.cellStyle(*s.Default)
+		if err != nil {
+			return err
+		}
+		gridConfig.CellStyle = &style
+	}
+
+	for row, definition := range s.Rows {
+		style, err := s.cellStyle(definition)
+		if err != nil {
+			return err
+		}
+		gridConfig.RowStyles = append(gridConfig.RowStyles, &RowStyle{Row: row, Style: style})
+	}
+
+	for column, definition := range s.Columns {
+		style, err := s.cellStyle(definition)
+		if err != nil {
+			return err
+		}
+		gridConfig.ColumnStyles = append(gridConfig.ColumnStyles, &ColumnStyle{Column: column, Style: style})
+	}
+	return nil
+}
+
+// ApplyCells applies the cell styles and the state styles to a gridder. State styles are merged into the style map
+// of the gridder, keeping the shapes of its states
+func (s *Stylesheet) ApplyCells(g *Gridder) error {
+	for _, definition := range s.Cells {
+		style, err := s.cellStyle(definition.Style)
+		if err != nil {
+			return err
+		}
+
+		err = g.SetCellStyle(definition.Row, definition.Column, style)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(s.States) == 0 {
+		return nil
+	}
+
+	styleMap := make(StyleMap, len(g.styleMap)+len(s.States))
+	for state, stateStyle := range g.styleMap {
+		styleMap[state] = stateStyle
+	}
+
+	for state, definition := range s.States {
+		style, err := s.cellStyle(definition)
+		if err != nil {
+			return err
+		}
+
+		stateStyle := styleMap[state]
+		if style.Fill != nil {
+			stateStyle.Color = style.Fill
+		}
+		stateStyle.Style = style
+		styleMap[state] = stateStyle
+	}
+	g.SetStyleMap(styleMap)
+	return nil
+}
+
+func (s *Stylesheet) cellStyle(definition StyleDefinition) (CellStyle, error) {
+	style := CellStyle{
+		StrokeWidth: definition.StrokeWidth,
+		Dashes:      definition.Dashes,
+		Rotate:      definition.Rotate,
+	}
+
+	var err error
+	style.Fill, err = parseOptionalColor(definition.Fill)
+	if err != nil {
+		return CellStyle{}, err
+	}
+
+	style.Color, err = parseOptionalColor(definition.Color)
+	if err != nil {
+		return CellStyle{}, err
+	}
+
+	if definition.Font != nil {
+		style.FontFace, err = s.loadFontFace(*definition.Font)
+		if err != nil {
+			return CellStyle{}, err
+		}
+	}
+	return style, nil
+}
+
+func (s *Stylesheet) loadFontFace(definition FontDefinition) (font.Face, error) {
+	if fontFace, ok := s.fonts[definition]; ok {
+		return fontFace, nil
+	}
+
+	var fontFace font.Face
+	var err error
+	if s.fsys != nil {
+		fontFace, err = loadFontFaceFS(s.fsys, path.Join(s.dir, definition.Path), definition.Size)
+	} else {
+		fontPath := definition.Path
+		if !filepath.IsAbs(fontPath) && s.dir != "" {
+			fontPath = filepath.Join(s.dir, fontPath)
+		}
+		fontFace, err = gg.LoadFontFace(fontPath, definition.Size)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading font %q: %w", definition.Path, err)
+	}
+
+	if s.fonts == nil {
+		s.fonts = make(map[FontDefinition]font.Face)
+	}
+	s.fonts[definition] = fontFace
+	return fontFace, nil
+}
+
+// loadFontFaceFS loads a TrueType font file of a file system with a size in points, like gg.LoadFontFace
+func loadFontFaceFS(fsys fs.FS, name string, points float64) (font.Face, error) {
+	fontBytes, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	ttf, err := truetype.Parse(fontBytes)
+	if err != nil {
+		return nil, err
+	}
+	return truetype.NewFace(ttf, &truetype.Options{Size: points}), nil
+}
+
+func (d *GridStyleDefinition) apply(gridConfig *GridConfig) error {
+	var err error
+	if d.LineColor != "" {
+		gridConfig.LineColor, err = ParseColor(d.LineColor)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.BorderColor != "" {
+		gridConfig.BorderColor, err = ParseColor(d.BorderColor)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.BackgroundColor != "" {
+		gridConfig.BackgroundColor, err = ParseColor(d.BackgroundColor)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.LineStrokeWidth != nil {
+		gridConfig.LineStrokeWidth = *d.LineStrokeWidth
+	}
+	if d.BorderStrokeWidth != nil {
+		gridConfig.BorderStrokeWidth = *d.BorderStrokeWidth
+	}
+	if d.LineDashes != nil {
+		gridConfig.LineDashes = *d.LineDashes
+	}
+	if d.BorderDashes != nil {
+		gridConfig.BorderDashes = *d.BorderDashes
+	}
+	return nil
+}
+
+func parseOptionalColor(value string) (color.Color, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return ParseColor(value)
+}
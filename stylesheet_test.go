@@ -0,0 +1,150 @@
+package gridder
+
+import (
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const testStylesheet = `{
+	"grid": {"lineColor": "gray", "lineStrokeWidth": 1, "backgroundColor": "#eeeeee"},
+	"default": {"color": "black", "strokeWidth": 2, "font": {"path": "regular.ttf", "size": 12}},
+	"rows": {"0": {"fill": "#ff0000"}},
+	"columns": {"1": {"dashes": 3}},
+	"cells": [{"row": 1, "column": 1, "style": {"fill": "blue"}}],
+	"states": {"infected": {"fill": "red"}}
+}`
+
+func TestLoadStylesheetFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gridder")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "regular.ttf"), goregular.TTF, 0644)
+	assert.Nil(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "style.json"), []byte(testStylesheet), 0644)
+	assert.Nil(t, err)
+
+	_, err = LoadStylesheetFile(filepath.Join(dir, "missing.json"))
+	assert.NotNil(t, err)
+
+	stylesheet, err := LoadStylesheetFile(filepath.Join(dir, "style.json"))
+	assert.Nil(t, err)
+
+	gridConfig := GridConfig{Rows: 2, Columns: 2}
+	err = stylesheet.Apply(&gridConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, gridConfig.GetLineColor(), color.RGBA{R: 128, G: 128, B: 128, A: 255})
+	assert.Equal(t, gridConfig.GetLineStrokeWidth(), 1.0)
+	assert.Equal(t, gridConfig.GetBackgroundColor(), color.NRGBA{R: 0xee, G: 0xee, B: 0xee, A: 255})
+	assert.Equal(t, gridConfig.GetCellStyle().StrokeWidth, 2.0)
+	assert.NotNil(t, gridConfig.GetCellStyle().FontFace)
+	assert.Equal(t, gridConfig.GetRowStyle(0).Fill, color.NRGBA{R: 255, A: 255})
+	assert.Equal(t, gridConfig.GetColumnStyle(1).Dashes, 3.0)
+
+	gridder, err := New(ImageConfig{}, gridConfig)
+	assert.Nil(t, err)
+
+	err = stylesheet.ApplyCells(gridder)
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.getCellStyle(1, 1).Fill, color.RGBA{B: 255, A: 255})
+	assert.Equal(t, gridder.styleMap["infected"].Color, color.RGBA{R: 255, A: 255})
+
+	err = gridder.DrawString(0, 0, "Styled", nil)
+	assert.Nil(t, err)
+}
+
+func TestLoadStylesheetFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"styles/style.json":  {Data: []byte(testStylesheet)},
+		"styles/regular.ttf": {Data: goregular.TTF},
+		"broken.json":        {Data: []byte(`{"default": {"font": {"path": "missing.ttf", "size": 12}}}`)},
+	}
+
+	_, err := LoadStylesheetFS(fsys, "styles/missing.json")
+	assert.NotNil(t, err)
+
+	stylesheet, err := LoadStylesheetFS(fsys, "styles/style.json")
+	assert.Nil(t, err)
+
+	gridConfig := GridConfig{Rows: 2, Columns: 2}
+	err = stylesheet.Apply(&gridConfig)
+	assert.Nil(t, err)
+	assert.NotNil(t, gridConfig.GetCellStyle().FontFace)
+	assert.Equal(t, gridConfig.GetCellStyle().FontFace.Metrics().Height.Round(), 12)
+
+	stylesheet, err = LoadStylesheetFS(fsys, "broken.json")
+	assert.Nil(t, err)
+	err = stylesheet.Apply(&GridConfig{Rows: 2, Columns: 2})
+	assert.NotNil(t, err)
+}
+
+func TestLoadStylesheet(t *testing.T) {
+	_, err := LoadStylesheet(strings.NewReader("{"))
+	assert.NotNil(t, err)
+
+	stylesheet, err := LoadStylesheet(strings.NewReader(`{"default": {"color": "nope"}}`))
+	assert.Nil(t, err)
+
+	err = stylesheet.Apply(&GridConfig{})
+	assert.NotNil(t, err)
+
+	stylesheet, err = LoadStylesheet(strings.NewReader(`{"default": {"font": {"path": "missing.ttf", "size": 12}}}`))
+	assert.Nil(t, err)
+
+	err = stylesheet.Apply(&GridConfig{})
+	assert.NotNil(t, err)
+}
+
+func TestStylesheetGridDashes(t *testing.T) {
+	stylesheet, err := LoadStylesheet(strings.NewReader(`{"grid": {"lineColor": "gray"}}`))
+	assert.Nil(t, err)
+
+	gridConfig := GridConfig{Rows: 2, Columns: 2, LineDashes: 4, BorderDashes: 6}
+	err = stylesheet.Apply(&gridConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, gridConfig.LineDashes, 4.0)
+	assert.Equal(t, gridConfig.BorderDashes, 6.0)
+
+	stylesheet, err = LoadStylesheet(strings.NewReader(`{"grid": {"lineDashes": 0, "borderDashes": 2}}`))
+	assert.Nil(t, err)
+
+	err = stylesheet.Apply(&gridConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, gridConfig.LineDashes, 0.0)
+	assert.Equal(t, gridConfig.BorderDashes, 2.0)
+}
+
+func TestStylesheetStates(t *testing.T) {
+	stylesheet, err := LoadStylesheet(strings.NewReader(
+		`{"states": {"infected": {"fill": "red", "color": "blue", "strokeWidth": 3, "dashes": 2}}}`))
+	assert.Nil(t, err)
+
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	gridder.SetStyleMap(StyleMap{"infected": {Rectangle: &RectangleConfig{Stroke: true}}})
+	err = stylesheet.ApplyCells(gridder)
+	assert.Nil(t, err)
+
+	style := gridder.styleMap["infected"]
+	assert.Equal(t, style.Color, color.RGBA{R: 255, A: 255})
+	assert.Equal(t, style.Style.Color, color.RGBA{B: 255, A: 255})
+	assert.Equal(t, style.Style.StrokeWidth, 3.0)
+	assert.Equal(t, style.Style.Dashes, 2.0)
+	assert.NotNil(t, style.Rectangle)
+
+	err = gridder.SetState(0, 0, "infected")
+	assert.Nil(t, err)
+
+	err = gridder.Render()
+	assert.Nil(t, err)
+}
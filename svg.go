@@ -0,0 +1,41 @@
+package gridder
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// EncodeSVG renders the grid and writes it to w as an SVG document embedding the rendered image, with a link over
+// every linked cell and a hover title over every cell with a tooltip
+func (g *Gridder) EncodeSVG(w io.Writer) error {
+	var png bytes.Buffer
+	err := encodePNG(&png, g.image())
+	if err != nil {
+		return err
+	}
+
+	width, height := g.imageConfig.GetWidth(), g.imageConfig.GetHeight()
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", width, height, width, height)
+	fmt.Fprintf(bw, `<image width="%d" height="%d" xlink:href="data:image/png;base64,%s"/>`+"\n", width, height, base64.StdEncoding.EncodeToString(png.Bytes()))
+	for _, region := range g.linkRegions() {
+		url := svgEscape(region.text)
+		fmt.Fprintf(bw, `<a href="%s" xlink:href="%s"><rect x="%g" y="%g" width="%g" height="%g" fill="transparent"/></a>`+"\n", url, url, region.x, region.y, region.width, region.height)
+	}
+	for _, region := range g.cellRegions(g.tooltips) {
+		fmt.Fprintf(bw, `<rect x="%g" y="%g" width="%g" height="%g" fill="transparent"><title>%s</title></rect>`+"\n", region.x, region.y, region.width, region.height, svgEscape(region.text))
+	}
+	fmt.Fprint(bw, "</svg>\n")
+	return bw.Flush()
+}
+
+// svgEscape escapes a text for an XML attribute value
+func svgEscape(text string) string {
+	var escaped bytes.Buffer
+	xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}
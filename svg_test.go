@@ -0,0 +1,33 @@
+package gridder
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSVG(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetLink(1, 2, `https://example.com/?a=1&b="2"`))
+
+	var buffer bytes.Buffer
+	assert.Nil(t, gridder.Encode(&buffer, "svg"))
+	svg := buffer.String()
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg"`))
+	assert.True(t, strings.Contains(svg, `data:image/png;base64,`))
+	assert.True(t, strings.Contains(svg, `<a href="https://example.com/?a=1&amp;b=&#34;2&#34;"`))
+	assert.True(t, strings.Contains(svg, `<rect x="100" y="50" width="50" height="50"`))
+
+	decoder := xml.NewDecoder(&buffer)
+	for {
+		_, err = decoder.Token()
+		if err != nil {
+			break
+		}
+	}
+	assert.Equal(t, err.Error(), "EOF")
+}
@@ -0,0 +1,76 @@
+package gridder
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"text/template"
+
+	"golang.org/x/image/font"
+)
+
+// maxCachedTemplates bounds the parsed templates kept in templateCache, evicting the least recently used
+const maxCachedTemplates = 256
+
+var templateCache = struct {
+	sync.Mutex
+	templates map[string]*list.Element
+	order     *list.List
+}{templates: make(map[string]*list.Element), order: list.New()}
+
+type cachedTemplate struct {
+	text     string
+	template *template.Template
+}
+
+// DrawStringTemplate executes a text/template with the provided data and draws the result in a cell.
+// The most recently used parsed templates are cached and shared across gridders
+func (g *Gridder) DrawStringTemplate(row int, column int, text string, data interface{}, fontFace font.Face, stringConfigs ...StringConfig) error {
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	label, err := executeTemplate(text, data)
+	if err != nil {
+		return err
+	}
+	return g.DrawString(row, column, label, fontFace, stringConfigs...)
+}
+
+func executeTemplate(text string, data interface{}) (string, error) {
+	tmpl, err := getTemplate(text)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	err = tmpl.Execute(&builder, data)
+	if err != nil {
+		return "", err
+	}
+	return builder.String(), nil
+}
+
+func getTemplate(text string) (*template.Template, error) {
+	templateCache.Lock()
+	defer templateCache.Unlock()
+
+	if element, ok := templateCache.templates[text]; ok {
+		templateCache.order.MoveToFront(element)
+		return element.Value.(*cachedTemplate).template, nil
+	}
+
+	tmpl, err := template.New("label").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if templateCache.order.Len() >= maxCachedTemplates {
+		oldest := templateCache.order.Back()
+		templateCache.order.Remove(oldest)
+		delete(templateCache.templates, oldest.Value.(*cachedTemplate).text)
+	}
+	templateCache.templates[text] = templateCache.order.PushFront(&cachedTemplate{text: text, template: tmpl})
+	return tmpl, nil
+}
@@ -0,0 +1,71 @@
+package gridder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestExecuteTemplate(t *testing.T) {
+	data := struct {
+		Name  string
+		Score int
+	}{Name: "Alice", Score: 42}
+
+	label, err := executeTemplate("{{.Name}} ({{.Score}})", data)
+	assert.Nil(t, err)
+	assert.Equal(t, label, "Alice (42)")
+
+	tmpl1, err := getTemplate("{{.Name}} ({{.Score}})")
+	assert.Nil(t, err)
+
+	tmpl2, err := getTemplate("{{.Name}} ({{.Score}})")
+	assert.Nil(t, err)
+	assert.True(t, tmpl1 == tmpl2)
+
+	_, err = executeTemplate("{{.Name", data)
+	assert.NotNil(t, err)
+
+	_, err = executeTemplate("{{.Missing}}", data)
+	assert.NotNil(t, err)
+}
+
+func TestTemplateCacheBounded(t *testing.T) {
+	first, err := getTemplate("{{.}} first")
+	assert.Nil(t, err)
+	for i := 0; i < maxCachedTemplates*2; i++ {
+		_, err = getTemplate(fmt.Sprintf("{{.}} %d", i))
+		assert.Nil(t, err)
+
+		// the first template is used again, so it outlives the others
+		if i%(maxCachedTemplates/2) == 0 {
+			used, err := getTemplate("{{.}} first")
+			assert.Nil(t, err)
+			assert.True(t, used == first)
+		}
+	}
+
+	templateCache.Lock()
+	assert.Equal(t, len(templateCache.templates), maxCachedTemplates)
+	assert.Equal(t, templateCache.order.Len(), maxCachedTemplates)
+	_, ok := templateCache.templates["{{.}} 0"]
+	templateCache.Unlock()
+	assert.False(t, ok)
+}
+
+func TestDrawStringTemplate(t *testing.T) {
+	gridder, err := New(ImageConfig{}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 24})
+
+	err = gridder.DrawStringTemplate(1, 1, "{{.}}", 1, fontFace)
+	assert.NotNil(t, err)
+
+	err = gridder.DrawStringTemplate(0, 0, "{{.}}", 1, fontFace)
+	assert.Nil(t, err)
+}
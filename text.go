@@ -0,0 +1,90 @@
+package gridder
+
+import (
+	"math"
+	"strings"
+)
+
+// textLine is a line of a string with the point it is anchored at, horizontally by ax and vertically on its middle
+type textLine struct {
+	text string
+	x    float64
+	y    float64
+	ax   float64
+}
+
+// isTextBlock determines if a string is laid out in lines rather than drawn centered on its cell in one line
+func isTextBlock(text string, stringConfig StringConfig) bool {
+	return stringConfig.IsWrap() || stringConfig.GetHorizontalAlign() != AlignCenter ||
+		stringConfig.GetVerticalAlign() != AlignMiddle || strings.Contains(text, "\n")
+}
+
+// drawText draws a string in an area with the font face of the context, decluttered. Strings of one line centered on
+// the area are drawn as they are, others are broken into lines and aligned
+func (g *Gridder) drawText(row int, column int, area cellArea, text string, stringConfig StringConfig) {
+	if !isTextBlock(text, stringConfig) {
+		if drawn, ok := g.declutterLabel(row, column, area, text); ok {
+			g.ctx.DrawStringAnchored(drawn, area.center.X, area.center.Y, 0.5, 0.35)
+		}
+		return
+	}
+
+	lines, box := g.layoutText(area, text, stringConfig)
+	texts := make([]string, len(lines))
+	for i, line := range lines {
+		texts[i] = line.text
+	}
+	texts, ok := g.declutterLines(row, column, area, texts, box)
+	if !ok {
+		return
+	}
+	for i, line := range lines {
+		g.ctx.DrawStringAnchored(texts[i], line.x, line.y, line.ax, 0.35)
+	}
+}
+
+// layoutText breaks a string into lines at its line breaks, and between words to fit the width of an area when it
+// wraps, and anchors the lines in the area as aligned. It gets the lines and the box around them
+func (g *Gridder) layoutText(area cellArea, text string, stringConfig StringConfig) ([]textLine, labelBox) {
+	padding := stringConfig.GetPadding()
+	left, right := area.center.X-area.width/2+padding, area.center.X+area.width/2-padding
+	top, bottom := area.center.Y-area.height/2+padding, area.center.Y+area.height/2-padding
+
+	var texts []string
+	for _, line := range strings.Split(text, "\n") {
+		if !stringConfig.IsWrap() || line == "" {
+			texts = append(texts, line)
+			continue
+		}
+		texts = append(texts, g.ctx.WordWrap(line, right-left)...)
+	}
+
+	fontHeight := g.ctx.FontHeight()
+	lineHeight := fontHeight * stringConfig.GetLineSpacing()
+	height := lineHeight*float64(len(texts)-1) + fontHeight
+	y := area.center.Y - height/2
+	switch stringConfig.GetVerticalAlign() {
+	case AlignTop:
+		y = top
+	case AlignBottom:
+		y = bottom - height
+	}
+
+	x, ax := area.center.X, 0.5
+	switch stringConfig.GetHorizontalAlign() {
+	case AlignLeft:
+		x, ax = left, 0
+	case AlignRight:
+		x, ax = right, 1
+	}
+
+	lines := make([]textLine, len(texts))
+	box := labelBox{x0: x, y0: y, x1: x, y1: y + height}
+	for i, line := range texts {
+		lines[i] = textLine{text: line, x: x, y: y + fontHeight/2 + float64(i)*lineHeight, ax: ax}
+		width, _ := g.ctx.MeasureString(line)
+		box.x0 = math.Min(box.x0, x-ax*width)
+		box.x1 = math.Max(box.x1, x+(1-ax)*width)
+	}
+	return lines, box
+}
@@ -0,0 +1,103 @@
+package gridder
+
+import (
+	"image"
+	"testing"
+
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func newTextFontFace(t *testing.T) font.Face {
+	font, err := truetype.Parse(goregular.TTF)
+	assert.Nil(t, err)
+	return truetype.NewFace(font, &truetype.Options{Size: 12})
+}
+
+func TestIsTextBlock(t *testing.T) {
+	assert.False(t, isTextBlock("text", StringConfig{}))
+	assert.True(t, isTextBlock("two\nlines", StringConfig{}))
+	assert.True(t, isTextBlock("text", StringConfig{Wrap: true}))
+	assert.True(t, isTextBlock("text", StringConfig{HorizontalAlign: AlignLeft}))
+	assert.True(t, isTextBlock("text", StringConfig{VerticalAlign: AlignBottom}))
+}
+
+func TestLayoutText(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1})
+	assert.Nil(t, err)
+	gridder.ctx.SetFontFace(newTextFontFace(t))
+	fontHeight := gridder.ctx.FontHeight()
+	area := cellArea{center: &gg.Point{X: 50, Y: 50}, width: 100, height: 100}
+
+	lines, box := gridder.layoutText(area, "a\n\nb", StringConfig{})
+	assert.Equal(t, len(lines), 3)
+	assert.Equal(t, lines[1], textLine{text: "", x: 50, y: 50, ax: 0.5})
+	assert.Equal(t, lines[0].y, 50-fontHeight)
+	assert.Equal(t, box.y0, 50-1.5*fontHeight)
+	assert.Equal(t, box.y1, 50+1.5*fontHeight)
+
+	lines, _ = gridder.layoutText(area, "a\nb", StringConfig{LineSpacing: 2})
+	assert.Equal(t, lines[1].y-lines[0].y, 2*fontHeight)
+
+	lines, box = gridder.layoutText(area, "a", StringConfig{HorizontalAlign: AlignLeft, VerticalAlign: AlignTop, Padding: 4})
+	assert.Equal(t, lines, []textLine{{text: "a", x: 4, y: 4 + fontHeight/2, ax: 0}})
+	assert.Equal(t, box.x0, 4.0)
+
+	lines, box = gridder.layoutText(area, "a", StringConfig{HorizontalAlign: AlignRight, VerticalAlign: AlignBottom, Padding: 4})
+	assert.Equal(t, lines, []textLine{{text: "a", x: 96, y: 96 - fontHeight/2, ax: 1}})
+	assert.Equal(t, box.x1, 96.0)
+
+	lines, _ = gridder.layoutText(area, "wrapping a long sentence to the width of the cell", StringConfig{Wrap: true})
+	assert.Greater(t, len(lines), 1)
+	for _, line := range lines {
+		width, _ := gridder.ctx.MeasureString(line.text)
+		assert.LessOrEqual(t, width, 100.0, line.text)
+	}
+}
+
+func TestDrawStringAligned(t *testing.T) {
+	fontFace := newTextFontFace(t)
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.DrawString(0, 0, "Left\nTop", fontFace, StringConfig{HorizontalAlign: AlignLeft, VerticalAlign: AlignTop, Padding: 2}))
+	img := gridder.Image()
+	assert.Greater(t, countInkPixels(img, image.Rect(0, 0, 50, 50)), 0)
+	assert.Equal(t, countInkPixels(img, image.Rect(50, 0, 100, 100)), 0)
+	assert.Equal(t, countInkPixels(img, image.Rect(0, 50, 100, 100)), 0)
+
+	assert.ErrorIs(t, gridder.DrawString(0, 0, "text", fontFace, StringConfig{HorizontalAlign: HorizontalAlign(3)}), errInvalidValue)
+	assert.ErrorIs(t, gridder.DrawString(0, 0, "text", fontFace, StringConfig{VerticalAlign: VerticalAlign(-1)}), errInvalidValue)
+	assert.ErrorIs(t, gridder.DrawString(0, 0, "text", fontFace, StringConfig{LineSpacing: -1}), errInvalidValue)
+}
+
+func TestDrawStringsWrapped(t *testing.T) {
+	fontFace := newTextFontFace(t)
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 1, Columns: 2, LineStrokeWidth: 0, BorderStrokeWidth: 0})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.SetLabelDeclutter(LabelDeclutterConfig{Mode: DeclutterHide}))
+	assert.Nil(t, gridder.DrawStrings([][]string{{"a label wrapped in its cell", ""}}, fontFace, StringConfig{Wrap: true, Padding: 5}))
+	img := gridder.Image()
+	assert.Greater(t, countInkPixels(img, image.Rect(0, 0, 100, 100)), 0)
+	assert.Equal(t, countInkPixels(img, image.Rect(100, 0, 200, 100)), 0)
+	assert.Equal(t, len(gridder.labelBoxes), 1)
+	assert.LessOrEqual(t, gridder.labelBoxes[0].x1, 100.0)
+}
+
+// countInkPixels counts the pixels of an image in a rectangle darker than white
+func countInkPixels(img image.Image, rect image.Rectangle) int {
+	var count int
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			if r < 0xf000 || g < 0xf000 || b < 0xf000 {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,119 @@
+package gridder
+
+import (
+	"fmt"
+	"math"
+
+	"golang.org/x/image/font"
+)
+
+// ThinCellPolicy decides how lines and content that do not fit their cells are drawn, when cells are narrower than a
+// stroke width or shorter than the height of a font
+type ThinCellPolicy int
+
+const (
+	// ThinCellsDraw draws lines and content as requested, overlapping neighboring cells
+	ThinCellsDraw ThinCellPolicy = iota
+	// ThinCellsError fails creating grids with lines wider than their cells and drawing content that does not fit
+	ThinCellsError
+	// ThinCellsClamp narrows lines and strokes to the size of their cells and clips strings to their cells
+	ThinCellsClamp
+	// ThinCellsSkip leaves out lines and content that do not fit
+	ThinCellsSkip
+)
+
+// minCellSize gets the smallest width or height of the cells of a grid in an image
+func (g *GridConfig) minCellSize(imageWidth int, imageHeight int) float64 {
+	var sumWidthOffset, sumHeightOffset float64
+	for _, v := range g.ColumnsWidthOffset {
+		sumWidthOffset += v.Offset
+	}
+	for _, v := range g.RowsHeightOffset {
+		sumHeightOffset += v.Offset
+	}
+
+	columns, rows := g.GetColumns(), g.GetRows()
+	cellWidth := (float64(g.GetWidth(imageWidth)) - sumWidthOffset) / float64(columns)
+	cellHeight := (float64(g.GetHeight(imageHeight)) - sumHeightOffset) / float64(rows)
+	size := math.Inf(1)
+	for column := 0; column < columns; column++ {
+		size = math.Min(size, cellWidth+g.ColumnOffset(column))
+	}
+	for row := 0; row < rows; row++ {
+		size = math.Min(size, cellHeight+g.RowOffset(row))
+	}
+	return size
+}
+
+// validateCellSize verifies that the cells of a grid in an image are not smaller than the minimum cell size, nor
+// narrower than the lines when thin cells are errors
+func (g *GridConfig) validateCellSize(imageWidth int, imageHeight int) error {
+	if g.ThinCells < ThinCellsDraw || g.ThinCells > ThinCellsSkip {
+		return fmt.Errorf("%w: thin cell policy %d", errInvalidValue, g.ThinCells)
+	}
+
+	size := g.minCellSize(imageWidth, imageHeight)
+	if size < g.MinCellSize {
+		return fmt.Errorf("%w: cells of %g pixels, smaller than the minimum of %g", errCellTooSmall, size, g.MinCellSize)
+	}
+	if g.ThinCells == ThinCellsError && size < g.GetLineStrokeWidth() {
+		return fmt.Errorf("%w: cells of %g pixels, narrower than lines of %g", errCellTooSmall, size, g.GetLineStrokeWidth())
+	}
+	return nil
+}
+
+// getLineStrokeWidth gets the width of the lines of the grid, narrowed to the smallest cell or zero when the thin
+// cell policy clamps or skips lines wider than cells
+func (g *Gridder) getLineStrokeWidth() float64 {
+	width := g.gridConfig.GetLineStrokeWidth()
+	size := g.getLayout().minCellSize
+	if width <= size {
+		return width
+	}
+
+	switch g.gridConfig.ThinCells {
+	case ThinCellsClamp:
+		return size
+	case ThinCellsSkip:
+		return 0
+	}
+	return width
+}
+
+// fitCell applies the thin cell policy to content of a size drawn in a cell, bounded by a limit of the cell. It gets
+// the size to draw the content with, and false when the content is left out
+func (g *Gridder) fitCell(row int, column int, content string, size float64, limit float64) (float64, bool, error) {
+	if size <= limit {
+		return size, true, nil
+	}
+
+	switch g.gridConfig.ThinCells {
+	case ThinCellsError:
+		return 0, false, fmt.Errorf("%w: %s of %g pixels in cell %d, %d of %g pixels", errCellTooSmall, content, size, row, column, limit)
+	case ThinCellsClamp:
+		return limit, true, nil
+	case ThinCellsSkip:
+		return 0, false, nil
+	}
+	return size, true, nil
+}
+
+// fitStroke applies the thin cell policy to a stroke width in the area of a cell, bounded by the smaller side of the
+// area
+func (g *Gridder) fitStroke(row int, column int, area cellArea, width float64) (float64, bool, error) {
+	return g.fitCell(row, column, "stroke", width, math.Min(area.width, area.height))
+}
+
+// fitFont applies the thin cell policy to a font in the area of a cell, bounded by the height of the area. It reports
+// whether the string is clipped to the area, and false when it is left out
+func (g *Gridder) fitFont(row int, column int, area cellArea, fontFace font.Face) (bool, bool, error) {
+	height := fontHeight(fontFace)
+	fitted, ok, err := g.fitCell(row, column, "font", height, area.height)
+	return fitted < height, ok, err
+}
+
+// clipToArea clips drawing to an area, until the context is popped
+func (g *Gridder) clipToArea(area cellArea) {
+	g.ctx.DrawRectangle(area.center.X-area.width/2, area.center.Y-area.height/2, area.width, area.height)
+	g.ctx.Clip()
+}
@@ -0,0 +1,140 @@
+package gridder
+
+import (
+	"image"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestMinCellSize(t *testing.T) {
+	_, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 10, Columns: 5, MinCellSize: 10})
+	assert.Nil(t, err)
+
+	_, err = New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 20, Columns: 5, MinCellSize: 10})
+	assert.ErrorIs(t, err, errCellTooSmall)
+
+	_, err = New(ImageConfig{Width: 100, Height: 100}, GridConfig{
+		Rows:               10,
+		Columns:            5,
+		MinCellSize:        10,
+		ColumnsWidthOffset: []*ColumnWidthOffset{{Column: 1, Offset: -15}},
+	})
+	assert.ErrorIs(t, err, errCellTooSmall)
+
+	_, err = New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 10, Columns: 5, MinCellSize: -1})
+	assert.ErrorIs(t, err, errInvalidValue)
+
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 10, Columns: 5, MinCellSize: 10})
+	assert.Nil(t, err)
+	assert.ErrorIs(t, gridder.AddRow(), errCellTooSmall)
+	assert.Equal(t, gridder.gridConfig.GetRows(), 10)
+}
+
+func TestThinCellLines(t *testing.T) {
+	_, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1, ThinCells: ThinCellsSkip + 1})
+	assert.ErrorIs(t, err, errInvalidValue)
+
+	_, err = New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 20, Columns: 20, LineStrokeWidth: 8, ThinCells: ThinCellsError})
+	assert.ErrorIs(t, err, errCellTooSmall)
+
+	tests := []struct {
+		policy   ThinCellPolicy
+		expected float64
+	}{
+		{policy: ThinCellsDraw, expected: 8},
+		{policy: ThinCellsClamp, expected: 5},
+		{policy: ThinCellsSkip, expected: 0},
+	}
+	for _, test := range tests {
+		gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 20, Columns: 20, LineStrokeWidth: 8, ThinCells: test.policy})
+		assert.Nil(t, err)
+		assert.Equal(t, gridder.getLineStrokeWidth(), test.expected)
+	}
+
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, LineStrokeWidth: 8, ThinCells: ThinCellsSkip})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.getLineStrokeWidth(), 8.0)
+}
+
+func TestThinCellStrokes(t *testing.T) {
+	newGridder := func(policy ThinCellPolicy) *Gridder {
+		gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 20, Columns: 20, LineStrokeWidth: 0.5, ThinCells: policy})
+		assert.Nil(t, err)
+		return gridder
+	}
+	circleConfig := CircleConfig{Radius: 2, Stroke: true, StrokeWidth: 8}
+
+	gridder := newGridder(ThinCellsError)
+	assert.ErrorIs(t, gridder.DrawCircle(5, 5, circleConfig), errCellTooSmall)
+	assert.ErrorIs(t, gridder.DrawRectangle(5, 5, RectangleConfig{Stroke: true, StrokeWidth: 8}), errCellTooSmall)
+	assert.ErrorIs(t, gridder.DrawLine(5, 5, LineConfig{StrokeWidth: 8}), errCellTooSmall)
+	assert.Nil(t, gridder.DrawCircle(5, 5, CircleConfig{Radius: 2, Stroke: true, StrokeWidth: 2}))
+
+	gridder = newGridder(ThinCellsSkip)
+	assert.Nil(t, gridder.DrawCircle(5, 5, circleConfig))
+	assert.Nil(t, gridder.DrawLine(5, 5, LineConfig{StrokeWidth: 8}))
+	assert.Zero(t, countChangedPixels(gridder.image(), newGridder(ThinCellsSkip).image()))
+
+	gridder = newGridder(ThinCellsClamp)
+	assert.Nil(t, gridder.DrawCircle(5, 5, circleConfig))
+	expected := newGridder(ThinCellsDraw)
+	assert.Nil(t, expected.DrawCircle(5, 5, CircleConfig{Radius: 2, Stroke: true, StrokeWidth: 5}))
+	assert.Zero(t, countChangedPixels(gridder.image(), expected.image()))
+}
+
+func TestThinCellStrings(t *testing.T) {
+	font, err := truetype.Parse(goregular.TTF)
+	assert.Nil(t, err)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 24})
+	newGridder := func(policy ThinCellPolicy) *Gridder {
+		gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 10, Columns: 2, ThinCells: policy})
+		assert.Nil(t, err)
+		return gridder
+	}
+
+	gridder := newGridder(ThinCellsError)
+	assert.ErrorIs(t, gridder.DrawString(5, 0, "Wide", fontFace), errCellTooSmall)
+	assert.ErrorIs(t, gridder.DrawStrings([][]string{{"a"}}, fontFace), errCellTooSmall)
+
+	gridder = newGridder(ThinCellsSkip)
+	blank := newGridder(ThinCellsSkip).image()
+	assert.Nil(t, gridder.DrawString(5, 0, "Wide", fontFace))
+	assert.Nil(t, gridder.DrawStrings([][]string{{"a"}}, fontFace))
+	assert.Zero(t, countChangedPixels(gridder.image(), blank))
+	assert.Empty(t, gridder.labels)
+
+	gridder = newGridder(ThinCellsClamp)
+	assert.Nil(t, gridder.DrawString(5, 0, "Wide", fontFace))
+	assert.Nil(t, gridder.DrawStrings([][]string{{"", "a"}}, fontFace))
+	img := gridder.image()
+	inside := func(x int, y int) bool {
+		return (x < 50 && y >= 50 && y < 60) || (x >= 50 && y < 10)
+	}
+	var drawn int
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 100; x++ {
+			if img.At(x, y) == blank.At(x, y) {
+				continue
+			}
+			assert.True(t, inside(x, y), "pixel %d, %d drawn outside of its cell", x, y)
+			drawn++
+		}
+	}
+	assert.NotZero(t, drawn)
+}
+
+func countChangedPixels(img1 image.Image, img2 image.Image) int {
+	var changed int
+	bounds := img1.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if img1.At(x, y) != img2.At(x, y) {
+				changed++
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,199 @@
+package gridder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"io"
+
+	"golang.org/x/image/bmp"
+)
+
+const (
+	tiffImageWidth                = 256
+	tiffImageLength               = 257
+	tiffBitsPerSample             = 258
+	tiffCompression               = 259
+	tiffPhotometricInterpretation = 262
+	tiffStripOffsets              = 273
+	tiffSamplesPerPixel           = 277
+	tiffRowsPerStrip              = 278
+	tiffStripByteCounts           = 279
+	tiffXResolution               = 282
+	tiffYResolution               = 283
+	tiffPlanarConfiguration       = 284
+	tiffResolutionUnit            = 296
+	tiffExtraSamples              = 338
+
+	tiffShort    = 3
+	tiffLong     = 4
+	tiffRational = 5
+
+	tiffCompressionLZW = 5
+	tiffPhotometricRGB = 2
+	tiffResolutionInch = 2
+	tiffUnassociated   = 2
+
+	lzwClearCode = 256
+	lzwEOICode   = 257
+	lzwMaxCode   = 4094
+)
+
+// EncodeTIFF renders the grid and writes it to w as an LZW-compressed TIFF, using the configured DPI as resolution
+func (g *Gridder) EncodeTIFF(w io.Writer) error {
+	return encodeTIFF(w, g.image(), g.imageConfig.GetDPI())
+}
+
+// EncodeBMP renders the grid and writes it to w as a BMP
+func (g *Gridder) EncodeBMP(w io.Writer) error {
+	return bmp.Encode(w, g.image())
+}
+
+type tiffEntry struct {
+	tag      uint16
+	datatype uint16
+	count    uint32
+	value    uint32
+}
+
+func encodeTIFF(w io.Writer, img image.Image, dpi float64) error {
+	bounds := img.Bounds()
+	nrgba := toNRGBA(img)
+
+	pixels := nrgba.Pix
+	if nrgba.Stride != 4*bounds.Dx() {
+		pixels = make([]byte, 0, 4*bounds.Dx()*bounds.Dy())
+		for y := 0; y < bounds.Dy(); y++ {
+			pixels = append(pixels, nrgba.Pix[y*nrgba.Stride:y*nrgba.Stride+4*bounds.Dx()]...)
+		}
+	}
+
+	data := compressLZW(pixels)
+	if len(data)%2 == 1 {
+		data = append(data, 0)
+	}
+
+	const entries = 14
+	ifdOffset := uint32(8 + len(data))
+	extraOffset := ifdOffset + 2 + entries*12 + 4
+	resolution := uint32(dpi + 0.5)
+	if resolution == 0 {
+		resolution = 1
+	}
+
+	ifd := []tiffEntry{
+		{tiffImageWidth, tiffLong, 1, uint32(bounds.Dx())},
+		{tiffImageLength, tiffLong, 1, uint32(bounds.Dy())},
+		{tiffBitsPerSample, tiffShort, 4, extraOffset},
+		{tiffCompression, tiffShort, 1, tiffCompressionLZW},
+		{tiffPhotometricInterpretation, tiffShort, 1, tiffPhotometricRGB},
+		{tiffStripOffsets, tiffLong, 1, 8},
+		{tiffSamplesPerPixel, tiffShort, 1, 4},
+		{tiffRowsPerStrip, tiffLong, 1, uint32(bounds.Dy())},
+		{tiffStripByteCounts, tiffLong, 1, uint32(len(data))},
+		{tiffXResolution, tiffRational, 1, extraOffset + 8},
+		{tiffYResolution, tiffRational, 1, extraOffset + 16},
+		{tiffPlanarConfiguration, tiffShort, 1, 1},
+		{tiffResolutionUnit, tiffShort, 1, tiffResolutionInch},
+		{tiffExtraSamples, tiffShort, 1, tiffUnassociated},
+	}
+
+	buffer := new(bytes.Buffer)
+	buffer.WriteString("II")
+	binary.Write(buffer, binary.LittleEndian, uint16(42))
+	binary.Write(buffer, binary.LittleEndian, ifdOffset)
+	buffer.Write(data)
+
+	binary.Write(buffer, binary.LittleEndian, uint16(len(ifd)))
+	for _, entry := range ifd {
+		binary.Write(buffer, binary.LittleEndian, entry.tag)
+		binary.Write(buffer, binary.LittleEndian, entry.datatype)
+		binary.Write(buffer, binary.LittleEndian, entry.count)
+		// Short values are left-justified within the value field
+		if entry.datatype == tiffShort && entry.count == 1 {
+			binary.Write(buffer, binary.LittleEndian, uint16(entry.value))
+			binary.Write(buffer, binary.LittleEndian, uint16(0))
+		} else {
+			binary.Write(buffer, binary.LittleEndian, entry.value)
+		}
+	}
+	binary.Write(buffer, binary.LittleEndian, uint32(0))
+
+	binary.Write(buffer, binary.LittleEndian, [4]uint16{8, 8, 8, 8})
+	binary.Write(buffer, binary.LittleEndian, [2]uint32{resolution, 1})
+	binary.Write(buffer, binary.LittleEndian, [2]uint32{resolution, 1})
+
+	_, err := w.Write(buffer.Bytes())
+	return err
+}
+
+// compressLZW compresses data with the TIFF flavor of LZW: codes are packed most significant bit first and
+// the code width grows one code earlier than in GIF
+func compressLZW(data []byte) []byte {
+	writer := &lzwWriter{width: 9}
+	writer.write(lzwClearCode)
+	if len(data) == 0 {
+		writer.write(lzwEOICode)
+		return writer.flush()
+	}
+
+	table := make(map[uint32]uint16)
+	next := uint16(lzwEOICode + 1)
+	prefix := uint16(data[0])
+	for _, b := range data[1:] {
+		key := uint32(prefix)<<8 | uint32(b)
+		if code, ok := table[key]; ok {
+			prefix = code
+			continue
+		}
+
+		writer.write(prefix)
+		table[key] = next
+		next++
+		if next >= lzwMaxCode {
+			writer.write(lzwClearCode)
+			table = make(map[uint32]uint16)
+			next = lzwEOICode + 1
+			writer.width = 9
+		} else {
+			writer.grow(next)
+		}
+		prefix = uint16(b)
+	}
+
+	// The decoder allocates a code for the final prefix too, which may widen the end of information code
+	writer.write(prefix)
+	writer.grow(next + 1)
+	writer.write(lzwEOICode)
+	return writer.flush()
+}
+
+type lzwWriter struct {
+	output []byte
+	bits   uint32
+	count  uint
+	width  uint
+}
+
+func (w *lzwWriter) grow(next uint16) {
+	if next == 1<<w.width && w.width < 12 {
+		w.width++
+	}
+}
+
+func (w *lzwWriter) write(code uint16) {
+	w.bits = w.bits<<w.width | uint32(code)
+	w.count += w.width
+	for w.count >= 8 {
+		w.output = append(w.output, byte(w.bits>>(w.count-8)))
+		w.count -= 8
+	}
+}
+
+func (w *lzwWriter) flush() []byte {
+	if w.count > 0 {
+		w.output = append(w.output, byte(w.bits<<(8-w.count)))
+		w.count = 0
+	}
+	return w.output
+}
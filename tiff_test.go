@@ -0,0 +1,80 @@
+package gridder
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/tiff"
+)
+
+func TestEncodeTIFF(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 60, Height: 40}, GridConfig{Rows: 2, Columns: 3})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(1, 2, color.RGBA{R: 255, A: 255}))
+
+	buffer := new(bytes.Buffer)
+	err = gridder.EncodeTIFF(buffer)
+	assert.Nil(t, err)
+
+	decoded, err := tiff.Decode(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, decoded.Bounds(), gridder.image().Bounds())
+	assertSamePixels(t, decoded, gridder.image())
+}
+
+func TestEncodeTIFFNoise(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	img := image.NewNRGBA(image.Rect(0, 0, 97, 61))
+	for i := range img.Pix {
+		img.Pix[i] = byte(random.Intn(8) * 32)
+	}
+
+	buffer := new(bytes.Buffer)
+	err := encodeTIFF(buffer, img, 300)
+	assert.Nil(t, err)
+
+	decoded, err := tiff.Decode(buffer)
+	assert.Nil(t, err)
+	assertSamePixels(t, decoded, img)
+}
+
+func TestCompressLZW(t *testing.T) {
+	assert.Equal(t, compressLZW(nil), []byte{0x80, 0x40, 0x40})
+	assert.Equal(t, len(compressLZW(make([]byte, 10000))) < 200, true)
+}
+
+func TestEncodeBMP(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 60, Height: 40}, GridConfig{Rows: 2, Columns: 3})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 0, color.RGBA{B: 255, A: 255}))
+
+	buffer := new(bytes.Buffer)
+	err = gridder.EncodeBMP(buffer)
+	assert.Nil(t, err)
+
+	decoded, err := bmp.Decode(buffer)
+	assert.Nil(t, err)
+	assertSamePixels(t, decoded, gridder.image())
+
+	buffer.Reset()
+	err = gridder.Encode(buffer, "tif")
+	assert.Nil(t, err)
+	_, err = tiff.Decode(buffer)
+	assert.Nil(t, err)
+}
+
+func assertSamePixels(t *testing.T, actual image.Image, expected image.Image) {
+	bounds := expected.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if !assert.Equal(t, color.NRGBAModel.Convert(actual.At(x, y)), color.NRGBAModel.Convert(expected.At(x, y))) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,645 @@
+package gridder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+// tile flip flags stored in the high bits of global tile ids
+const (
+	tiledFlipHorizontal = 0x80000000
+	tiledFlipVertical   = 0x40000000
+	tiledFlipDiagonal   = 0x20000000
+	tiledFlipHexagonal  = 0x10000000
+)
+
+// TiledOpener opens a file referenced by a Tiled map, such as an external tileset or a tileset image. Names are
+// relative to the directory of the map
+type TiledOpener func(name string) (io.ReadCloser, error)
+
+// TiledFS opens the files referenced by a Tiled map from a file system, with the map in its root directory
+func TiledFS(fsys fs.FS) TiledOpener {
+	return func(name string) (io.ReadCloser, error) {
+		return fsys.Open(name)
+	}
+}
+
+// TiledMap holds the visible tile layers and the tilesets of an orthogonal map made with the Tiled editor
+type TiledMap struct {
+	Rows       int
+	Columns    int
+	TileWidth  int
+	TileHeight int
+	Layers     []TiledLayer
+	Tilesets   []TiledTileset
+}
+
+// TiledLayer is a tile layer, GIDs holds the global tile id of every cell row by row with 0 for empty cells
+type TiledLayer struct {
+	Name    string
+	Opacity float64
+	GIDs    []uint32
+}
+
+// TiledTileset is a tileset image of equally sized tiles, its tiles are numbered from FirstGID
+type TiledTileset struct {
+	FirstGID   uint32
+	Image      image.Image
+	TileWidth  int
+	TileHeight int
+	Columns    int
+	TileCount  int
+	Spacing    int
+	Margin     int
+}
+
+type tmxMap struct {
+	Orientation string       `xml:"orientation,attr"`
+	Infinite    int          `xml:"infinite,attr"`
+	Width       int          `xml:"width,attr"`
+	Height      int          `xml:"height,attr"`
+	TileWidth   int          `xml:"tilewidth,attr"`
+	TileHeight  int          `xml:"tileheight,attr"`
+	Tilesets    []tmxTileset `xml:"tileset"`
+}
+
+type tmxLayer struct {
+	Name    string  `xml:"name,attr"`
+	Visible *int    `xml:"visible,attr"`
+	Opacity *string `xml:"opacity,attr"`
+	Data    struct {
+		Encoding    string `xml:"encoding,attr"`
+		Compression string `xml:"compression,attr"`
+		Text        string `xml:",chardata"`
+		Tiles       []struct {
+			GID uint32 `xml:"gid,attr"`
+		} `xml:"tile"`
+	} `xml:"data"`
+}
+
+// tmxGroup holds the layers and nested groups of a map or a group in document order, which is the drawing order
+type tmxGroup struct {
+	Visible  *int
+	Opacity  *string
+	Children []tmxGroupChild
+}
+
+type tmxGroupChild struct {
+	Layer *tmxLayer
+	Group *tmxGroup
+}
+
+func (g *tmxGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		value := attr.Value
+		switch attr.Name.Local {
+		case "visible":
+			visible, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("%w: visible %q", errInvalidTiledMap, value)
+			}
+			g.Visible = &visible
+		case "opacity":
+			g.Opacity = &value
+		}
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			switch element.Name.Local {
+			case "layer":
+				var layer tmxLayer
+				err = d.DecodeElement(&layer, &element)
+				g.Children = append(g.Children, tmxGroupChild{Layer: &layer})
+			case "group":
+				var group tmxGroup
+				err = d.DecodeElement(&group, &element)
+				g.Children = append(g.Children, tmxGroupChild{Group: &group})
+			default:
+				err = d.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+type tmxTileset struct {
+	FirstGID   uint32 `xml:"firstgid,attr"`
+	Source     string `xml:"source,attr"`
+	TileWidth  int    `xml:"tilewidth,attr"`
+	TileHeight int    `xml:"tileheight,attr"`
+	Columns    int    `xml:"columns,attr"`
+	TileCount  int    `xml:"tilecount,attr"`
+	Spacing    int    `xml:"spacing,attr"`
+	Margin     int    `xml:"margin,attr"`
+	Image      *struct {
+		Source string `xml:"source,attr"`
+	} `xml:"image"`
+}
+
+type tiledJSONMap struct {
+	Orientation string             `json:"orientation"`
+	Infinite    bool               `json:"infinite"`
+	Width       int                `json:"width"`
+	Height      int                `json:"height"`
+	TileWidth   int                `json:"tilewidth"`
+	TileHeight  int                `json:"tileheight"`
+	Layers      []tiledJSONLayer   `json:"layers"`
+	Tilesets    []tiledJSONTileset `json:"tilesets"`
+}
+
+type tiledJSONLayer struct {
+	Type        string           `json:"type"`
+	Name        string           `json:"name"`
+	Visible     *bool            `json:"visible"`
+	Opacity     *float64         `json:"opacity"`
+	Encoding    string           `json:"encoding"`
+	Compression string           `json:"compression"`
+	Data        json.RawMessage  `json:"data"`
+	Layers      []tiledJSONLayer `json:"layers"`
+}
+
+type tiledJSONTileset struct {
+	FirstGID   uint32 `json:"firstgid"`
+	Source     string `json:"source"`
+	Image      string `json:"image"`
+	TileWidth  int    `json:"tilewidth"`
+	TileHeight int    `json:"tileheight"`
+	Columns    int    `json:"columns"`
+	TileCount  int    `json:"tilecount"`
+	Spacing    int    `json:"spacing"`
+	Margin     int    `json:"margin"`
+}
+
+// FromTMX reads a map in the TMX format of the Tiled editor. External tilesets and tileset images are loaded with
+// open, which may be nil for maps without external files. Only orthogonal, finite maps are supported
+func FromTMX(r io.Reader, open TiledOpener) (*TiledMap, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var m tmxMap
+	err = xml.Unmarshal(content, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	var layers tmxGroup
+	err = xml.Unmarshal(content, &layers)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Infinite != 0 {
+		return nil, fmt.Errorf("%w: infinite maps are not supported", errInvalidTiledMap)
+	}
+
+	tiledMap, err := newTiledMap(m.Orientation, m.Width, m.Height, m.TileWidth, m.TileHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tileset := range m.Tilesets {
+		resolved, err := resolveTMXTileset(tileset, "", open)
+		if err != nil {
+			return nil, err
+		}
+		tiledMap.Tilesets = append(tiledMap.Tilesets, resolved)
+	}
+
+	err = tiledMap.addTMXGroup(layers, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tiledMap, nil
+}
+
+// FromTiledJSON reads a map in the JSON format of the Tiled editor. External tilesets and tileset images are loaded
+// with open, which may be nil for maps without external files. Only orthogonal, finite maps are supported
+func FromTiledJSON(r io.Reader, open TiledOpener) (*TiledMap, error) {
+	var m tiledJSONMap
+	err := json.NewDecoder(r).Decode(&m)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Infinite {
+		return nil, fmt.Errorf("%w: infinite maps are not supported", errInvalidTiledMap)
+	}
+
+	tiledMap, err := newTiledMap(m.Orientation, m.Width, m.Height, m.TileWidth, m.TileHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tileset := range m.Tilesets {
+		var resolved TiledTileset
+		if tileset.Source != "" && strings.EqualFold(path.Ext(tileset.Source), ".json") {
+			resolved, err = loadTiledJSONTileset(tileset.FirstGID, tileset.Source, open)
+		} else if tileset.Source != "" {
+			resolved, err = resolveTMXTileset(tmxTileset{FirstGID: tileset.FirstGID, Source: tileset.Source}, "", open)
+		} else {
+			resolved, err = newTiledTileset(tileset, "", open)
+		}
+		if err != nil {
+			return nil, err
+		}
+		tiledMap.Tilesets = append(tiledMap.Tilesets, resolved)
+	}
+
+	err = tiledMap.addJSONLayers(m.Layers, 1)
+	if err != nil {
+		return nil, err
+	}
+	return tiledMap, nil
+}
+
+// Render creates a gridder shaped like the map and draws its layers in order. Tiles larger than the map tiles
+// extend up and to the right of their cell, as in Tiled. The image defaults to the map size in pixels when no
+// width or height is configured, a transparent line color hides the grid
+func (m *TiledMap) Render(imageConfig ImageConfig, gridConfig GridConfig) (*Gridder, error) {
+	margin := gridConfig.GetMarginWidth() * 2
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = m.Columns*m.TileWidth + margin
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = m.Rows*m.TileHeight + margin
+	}
+
+	gridConfig.Rows, gridConfig.Columns = m.Rows, m.Columns
+	g, err := New(imageConfig, gridConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = g.retain(func() error {
+		return m.draw(g)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (m *TiledMap) draw(g *Gridder) error {
+	dst := g.ctx.Image().(draw.Image)
+	for _, layer := range m.Layers {
+		if len(layer.GIDs) != m.Rows*m.Columns {
+			return fmt.Errorf("%w: layer %q has %d tiles", errInvalidTiledMap, layer.Name, len(layer.GIDs))
+		}
+
+		var options *xdraw.Options
+		if layer.Opacity < 1 {
+			options = &xdraw.Options{SrcMask: image.NewUniform(color.Alpha{A: uint8(math.Round(layer.Opacity * 255))})}
+		}
+
+		for i, gid := range layer.GIDs {
+			if gid&^(tiledFlipHorizontal|tiledFlipVertical|tiledFlipDiagonal|tiledFlipHexagonal) == 0 {
+				continue
+			}
+
+			tileset, tile, err := m.tile(gid)
+			if err != nil {
+				return err
+			}
+
+			row, column := i/m.Columns, i%m.Columns
+			center := g.getCellCenter(row, column)
+			cellWidth, cellHeight := g.getCellDimensions(row, column)
+			left, bottom := g.ctx.TransformPoint(center.X-cellWidth/2, center.Y+cellHeight/2)
+			width := cellWidth * float64(tileset.TileWidth) / float64(m.TileWidth)
+			height := cellHeight * float64(tileset.TileHeight) / float64(m.TileHeight)
+
+			dr := image.Rect(int(math.Round(left)), int(math.Round(bottom-height)), int(math.Round(left+width)), int(math.Round(bottom)))
+			xdraw.NearestNeighbor.Scale(dst, dr, tile, tile.Bounds(), xdraw.Over, options)
+		}
+	}
+	return nil
+}
+
+// tile gets the tileset and the image of a global tile id, applying its flip flags
+func (m *TiledMap) tile(gid uint32) (TiledTileset, image.Image, error) {
+	id := gid &^ (tiledFlipHorizontal | tiledFlipVertical | tiledFlipDiagonal | tiledFlipHexagonal)
+	var tileset *TiledTileset
+	for i := range m.Tilesets {
+		if m.Tilesets[i].FirstGID <= id && (tileset == nil || m.Tilesets[i].FirstGID > tileset.FirstGID) {
+			tileset = &m.Tilesets[i]
+		}
+	}
+
+	if tileset == nil || int(id-tileset.FirstGID) >= tileset.TileCount {
+		return TiledTileset{}, nil, fmt.Errorf("%w: unknown tile %d", errInvalidTiledMap, id)
+	}
+
+	index := int(id - tileset.FirstGID)
+	min := tileset.Image.Bounds().Min
+	x := min.X + tileset.Margin + index%tileset.Columns*(tileset.TileWidth+tileset.Spacing)
+	y := min.Y + tileset.Margin + index/tileset.Columns*(tileset.TileHeight+tileset.Spacing)
+
+	tile := image.NewRGBA(image.Rect(0, 0, tileset.TileWidth, tileset.TileHeight))
+	draw.Draw(tile, tile.Bounds(), tileset.Image, image.Pt(x, y), draw.Src)
+
+	var img image.Image = tile
+	if gid&tiledFlipDiagonal != 0 {
+		img = transposeImage(tile)
+	}
+	return *tileset, flipImage(img, gid&tiledFlipHorizontal != 0, gid&tiledFlipVertical != 0), nil
+}
+
+func transposeImage(src *image.RGBA) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dy(), bounds.Dx()))
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			dst.SetRGBA(y, x, src.RGBAAt(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return dst
+}
+
+func newTiledMap(orientation string, width int, height int, tileWidth int, tileHeight int) (*TiledMap, error) {
+	if orientation != "" && orientation != "orthogonal" {
+		return nil, fmt.Errorf("%w: %s orientation is not supported", errInvalidTiledMap, orientation)
+	}
+
+	if width <= 0 || height <= 0 || tileWidth <= 0 || tileHeight <= 0 {
+		return nil, fmt.Errorf("%w: %dx%d tiles of %dx%d pixels", errInvalidTiledMap, width, height, tileWidth, tileHeight)
+	}
+	return &TiledMap{Rows: height, Columns: width, TileWidth: tileWidth, TileHeight: tileHeight}, nil
+}
+
+func (m *TiledMap) addTMXGroup(group tmxGroup, opacity float64) error {
+	for _, child := range group.Children {
+		if child.Group != nil {
+			if child.Group.Visible != nil && *child.Group.Visible == 0 {
+				continue
+			}
+
+			groupOpacity, err := parseTiledOpacity(child.Group.Opacity)
+			if err != nil {
+				return err
+			}
+
+			err = m.addTMXGroup(*child.Group, opacity*groupOpacity)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		layer := child.Layer
+		if layer.Visible != nil && *layer.Visible == 0 {
+			continue
+		}
+
+		layerOpacity, err := parseTiledOpacity(layer.Opacity)
+		if err != nil {
+			return err
+		}
+
+		var gids []uint32
+		data := layer.Data
+		switch data.Encoding {
+		case "":
+			for _, tile := range data.Tiles {
+				gids = append(gids, tile.GID)
+			}
+		case "csv":
+			gids, err = parseTiledCSV(data.Text)
+		case "base64":
+			gids, err = decodeTiledBase64(strings.TrimSpace(data.Text), data.Compression)
+		default:
+			err = fmt.Errorf("%w: %s encoding is not supported", errInvalidTiledMap, data.Encoding)
+		}
+		if err != nil {
+			return err
+		}
+		m.Layers = append(m.Layers, TiledLayer{Name: layer.Name, Opacity: opacity * layerOpacity, GIDs: gids})
+	}
+	return nil
+}
+
+func (m *TiledMap) addJSONLayers(layers []tiledJSONLayer, opacity float64) error {
+	for _, layer := range layers {
+		if layer.Visible != nil && !*layer.Visible {
+			continue
+		}
+
+		layerOpacity := opacity
+		if layer.Opacity != nil {
+			layerOpacity *= *layer.Opacity
+		}
+
+		switch layer.Type {
+		case "group":
+			err := m.addJSONLayers(layer.Layers, layerOpacity)
+			if err != nil {
+				return err
+			}
+		case "tilelayer":
+			var gids []uint32
+			var err error
+			if layer.Encoding == "base64" {
+				var data string
+				err = json.Unmarshal(layer.Data, &data)
+				if err == nil {
+					gids, err = decodeTiledBase64(data, layer.Compression)
+				}
+			} else {
+				err = json.Unmarshal(layer.Data, &gids)
+			}
+			if err != nil {
+				return fmt.Errorf("%w: layer %q: %v", errInvalidTiledMap, layer.Name, err)
+			}
+			m.Layers = append(m.Layers, TiledLayer{Name: layer.Name, Opacity: layerOpacity, GIDs: gids})
+		}
+	}
+	return nil
+}
+
+func parseTiledOpacity(value *string) (float64, error) {
+	if value == nil {
+		return 1, nil
+	}
+
+	opacity, err := strconv.ParseFloat(*value, 64)
+	if err != nil || opacity < 0 || opacity > 1 {
+		return 0, fmt.Errorf("%w: opacity %q", errInvalidTiledMap, *value)
+	}
+	return opacity, nil
+}
+
+func parseTiledCSV(text string) ([]uint32, error) {
+	var gids []uint32
+	for _, field := range strings.Split(text, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		gid, err := strconv.ParseUint(field, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: tile %q", errInvalidTiledMap, field)
+		}
+		gids = append(gids, uint32(gid))
+	}
+	return gids, nil
+}
+
+func decodeTiledBase64(text string, compression string) ([]uint32, error) {
+	data, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidTiledMap, err)
+	}
+
+	var reader io.ReadCloser
+	switch compression {
+	case "":
+	case "zlib":
+		reader, err = zlib.NewReader(bytes.NewReader(data))
+	case "gzip":
+		reader, err = gzip.NewReader(bytes.NewReader(data))
+	default:
+		return nil, fmt.Errorf("%w: %s compression is not supported", errInvalidTiledMap, compression)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidTiledMap, err)
+	}
+
+	if reader != nil {
+		data, err = ioutil.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidTiledMap, err)
+		}
+	}
+
+	if len(data)%4 != 0 {
+		return nil, fmt.Errorf("%w: %d bytes of tile data", errInvalidTiledMap, len(data))
+	}
+
+	gids := make([]uint32, len(data)/4)
+	for i := range gids {
+		gids[i] = binary.LittleEndian.Uint32(data[i*4:])
+	}
+	return gids, nil
+}
+
+// resolveTMXTileset loads an external tileset and its image, names are relative to dir
+func resolveTMXTileset(tileset tmxTileset, dir string, open TiledOpener) (TiledTileset, error) {
+	if tileset.Source != "" {
+		source := path.Join(dir, tileset.Source)
+		reader, err := openTiledFile(open, source)
+		if err != nil {
+			return TiledTileset{}, err
+		}
+		defer reader.Close()
+
+		var external tmxTileset
+		err = xml.NewDecoder(reader).Decode(&external)
+		if err != nil {
+			return TiledTileset{}, fmt.Errorf("%w: tileset %q: %v", errInvalidTiledMap, source, err)
+		}
+		external.FirstGID = tileset.FirstGID
+		return resolveTMXTileset(external, path.Dir(source), open)
+	}
+
+	if tileset.Image == nil {
+		return TiledTileset{}, fmt.Errorf("%w: tilesets without a single image are not supported", errInvalidTiledMap)
+	}
+
+	return newTiledTileset(tiledJSONTileset{
+		FirstGID:   tileset.FirstGID,
+		Image:      tileset.Image.Source,
+		TileWidth:  tileset.TileWidth,
+		TileHeight: tileset.TileHeight,
+		Columns:    tileset.Columns,
+		TileCount:  tileset.TileCount,
+		Spacing:    tileset.Spacing,
+		Margin:     tileset.Margin,
+	}, dir, open)
+}
+
+func loadTiledJSONTileset(firstGID uint32, source string, open TiledOpener) (TiledTileset, error) {
+	reader, err := openTiledFile(open, source)
+	if err != nil {
+		return TiledTileset{}, err
+	}
+	defer reader.Close()
+
+	var tileset tiledJSONTileset
+	err = json.NewDecoder(reader).Decode(&tileset)
+	if err != nil {
+		return TiledTileset{}, fmt.Errorf("%w: tileset %q: %v", errInvalidTiledMap, source, err)
+	}
+	tileset.FirstGID = firstGID
+	return newTiledTileset(tileset, path.Dir(source), open)
+}
+
+func newTiledTileset(tileset tiledJSONTileset, dir string, open TiledOpener) (TiledTileset, error) {
+	if tileset.Image == "" {
+		return TiledTileset{}, fmt.Errorf("%w: tilesets without a single image are not supported", errInvalidTiledMap)
+	}
+
+	if tileset.TileWidth <= 0 || tileset.TileHeight <= 0 || tileset.Columns <= 0 || tileset.TileCount <= 0 {
+		return TiledTileset{}, fmt.Errorf("%w: tileset %q of %d tiles of %dx%d pixels", errInvalidTiledMap, tileset.Image, tileset.TileCount, tileset.TileWidth, tileset.TileHeight)
+	}
+
+	reader, err := openTiledFile(open, path.Join(dir, tileset.Image))
+	if err != nil {
+		return TiledTileset{}, err
+	}
+	defer reader.Close()
+
+	img, _, err := image.Decode(reader)
+	if err != nil {
+		return TiledTileset{}, fmt.Errorf("%w: image %q: %v", errInvalidTiledMap, tileset.Image, err)
+	}
+
+	return TiledTileset{
+		FirstGID:   tileset.FirstGID,
+		Image:      img,
+		TileWidth:  tileset.TileWidth,
+		TileHeight: tileset.TileHeight,
+		Columns:    tileset.Columns,
+		TileCount:  tileset.TileCount,
+		Spacing:    tileset.Spacing,
+		Margin:     tileset.Margin,
+	}, nil
+}
+
+func openTiledFile(open TiledOpener, name string) (io.ReadCloser, error) {
+	if open == nil {
+		return nil, fmt.Errorf("%w: no opener for %q", errInvalidTiledMap, name)
+	}
+	return open(name)
+}
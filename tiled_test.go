@@ -0,0 +1,202 @@
+package gridder
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	tiledRed   = color.NRGBA{R: 255, A: 255}
+	tiledBlue  = color.NRGBA{B: 255, A: 255}
+	tiledGreen = color.NRGBA{G: 255, A: 255}
+)
+
+// testTiledOpener serves a tileset image of 3 tiles of 2x2 pixels with a 1 pixel spacing: red, blue and a
+// green tile with a red top left pixel, and an external tileset using it
+func testTiledOpener(t *testing.T) TiledOpener {
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 2))
+	for x := 0; x < 8; x++ {
+		for y := 0; y < 2; y++ {
+			switch x / 3 {
+			case 0:
+				img.Set(x, y, tiledRed)
+			case 1:
+				img.Set(x, y, tiledBlue)
+			default:
+				img.Set(x, y, tiledGreen)
+			}
+		}
+	}
+	img.Set(6, 0, tiledRed)
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(t, png.Encode(buffer, img))
+	files := map[string][]byte{
+		"tiles/tiles.png":  buffer.Bytes(),
+		"tiles/tiles.tsx":  []byte(`<tileset tilewidth="2" tileheight="2" spacing="1" tilecount="3" columns="3"><image source="tiles.png"/></tileset>`),
+		"tiles/tiles.json": []byte(`{"image":"tiles.png","tilewidth":2,"tileheight":2,"spacing":1,"tilecount":3,"columns":3}`),
+	}
+	return func(name string) (io.ReadCloser, error) {
+		data, ok := files[name]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+}
+
+func tiledBase64Zlib(gids ...uint32) string {
+	raw := new(bytes.Buffer)
+	for _, gid := range gids {
+		binary.Write(raw, binary.LittleEndian, gid)
+	}
+	compressed := new(bytes.Buffer)
+	writer := zlib.NewWriter(compressed)
+	writer.Write(raw.Bytes())
+	writer.Close()
+	return base64.StdEncoding.EncodeToString(compressed.Bytes())
+}
+
+func TestFromTMX(t *testing.T) {
+	tmx := `<?xml version="1.0" encoding="UTF-8"?>
+<map orientation="orthogonal" width="3" height="2" tilewidth="2" tileheight="2" infinite="0">
+ <tileset firstgid="1" source="tiles/tiles.tsx"/>
+ <layer name="ground" width="3" height="2">
+  <data encoding="csv">1,1,1,
+2,2,2</data>
+ </layer>
+ <layer name="hidden" visible="0"><data encoding="csv">2,2,2,2,2,2</data></layer>
+ <group opacity="0.5">
+  <layer name="top"><data encoding="base64" compression="zlib">` + tiledBase64Zlib(0, 3, 3|tiledFlipHorizontal, 0, 0, 0) + `</data></layer>
+ </group>
+ <layer name="xml"><data><tile/><tile/><tile/><tile/><tile/><tile gid="3"/></data></layer>
+</map>`
+
+	tiledMap, err := FromTMX(strings.NewReader(tmx), testTiledOpener(t))
+	assert.Nil(t, err)
+	assert.Equal(t, tiledMap.Rows, 2)
+	assert.Equal(t, tiledMap.Columns, 3)
+	assert.Equal(t, len(tiledMap.Layers), 3)
+	assert.Equal(t, tiledMap.Layers[1].Opacity, 0.5)
+	assert.Equal(t, tiledMap.Layers[2].GIDs, []uint32{0, 0, 0, 0, 0, 3})
+
+	g, err := tiledMap.Render(ImageConfig{Width: 60, Height: 40}, GridConfig{LineColor: color.Transparent, BorderColor: color.Transparent})
+	assert.Nil(t, err)
+
+	img := g.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(5, 5)), tiledRed)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(5, 35)), tiledBlue)
+	// half transparent green over red, the red pixel of the flipped tile is in its top right
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(35, 15)), color.NRGBA{R: 127, G: 128, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(25, 5)), tiledRed)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(55, 5)), tiledRed)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(45, 5)), color.NRGBA{R: 127, G: 128, A: 255})
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(55, 25)), tiledGreen)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(45, 35)), tiledGreen)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(45, 25)), tiledRed)
+
+	g, err = tiledMap.Render(ImageConfig{}, GridConfig{MarginWidth: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, g.image().Bounds(), image.Rect(0, 0, 8, 6))
+}
+
+func TestTiledFS(t *testing.T) {
+	fsys := fstest.MapFS{"tiles.json": {Data: []byte(`{"tilewidth":2,"tileheight":2,"tilecount":1,"columns":1,"image":"tiles.png"}`)}}
+	open := TiledFS(fsys)
+
+	file, err := open("tiles.json")
+	assert.Nil(t, err)
+	data, err := ioutil.ReadAll(file)
+	assert.Nil(t, err)
+	assert.Nil(t, file.Close())
+	assert.Equal(t, data, fsys["tiles.json"].Data)
+
+	_, err = open("missing.json")
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestFromTiledJSON(t *testing.T) {
+	source := `{"orientation":"orthogonal","width":2,"height":1,"tilewidth":2,"tileheight":2,
+		"tilesets":[{"firstgid":1,"image":"tiles/tiles.png","tilewidth":2,"tileheight":2,"spacing":1,"tilecount":3,"columns":3},
+			{"firstgid":10,"source":"tiles/tiles.json"},{"firstgid":20,"source":"tiles/tiles.tsx"}],
+		"layers":[{"type":"tilelayer","name":"a","data":[1,11]},
+			{"type":"group","opacity":0.5,"layers":[{"type":"tilelayer","name":"b","opacity":0.5,"encoding":"base64","compression":"zlib","data":"` + tiledBase64Zlib(21, 0) + `"}]},
+			{"type":"tilelayer","name":"c","visible":false,"data":[1,1]},
+			{"type":"objectgroup","name":"objects"}]}`
+
+	tiledMap, err := FromTiledJSON(strings.NewReader(source), testTiledOpener(t))
+	assert.Nil(t, err)
+	assert.Equal(t, len(tiledMap.Tilesets), 3)
+	assert.Equal(t, len(tiledMap.Layers), 2)
+	assert.Equal(t, tiledMap.Layers[1].Opacity, 0.25)
+	assert.Equal(t, tiledMap.Layers[1].GIDs, []uint32{21, 0})
+
+	g, err := tiledMap.Render(ImageConfig{Width: 40, Height: 20}, GridConfig{LineColor: color.Transparent, BorderColor: color.Transparent})
+	assert.Nil(t, err)
+	assert.Equal(t, color.NRGBAModel.Convert(g.image().At(30, 10)), tiledBlue)
+}
+
+func TestTiledErrors(t *testing.T) {
+	open := testTiledOpener(t)
+	for _, tmx := range []string{
+		`<map`,
+		`<map orientation="isometric" width="1" height="1" tilewidth="2" tileheight="2"/>`,
+		`<map infinite="1" width="1" height="1" tilewidth="2" tileheight="2"/>`,
+		`<map width="0" height="1" tilewidth="2" tileheight="2"/>`,
+		`<map width="1" height="1" tilewidth="2" tileheight="2"><tileset firstgid="1" source="missing.tsx"/></map>`,
+		`<map width="1" height="1" tilewidth="2" tileheight="2"><tileset firstgid="1" tilewidth="2" tileheight="2" tilecount="1" columns="1"/></map>`,
+		`<map width="1" height="1" tilewidth="2" tileheight="2"><layer opacity="2"><data encoding="csv">0</data></layer></map>`,
+		`<map width="1" height="1" tilewidth="2" tileheight="2"><layer><data encoding="csv">x</data></layer></map>`,
+		`<map width="1" height="1" tilewidth="2" tileheight="2"><layer><data encoding="hex">0</data></layer></map>`,
+		`<map width="1" height="1" tilewidth="2" tileheight="2"><layer><data encoding="base64" compression="zstd">AAAAAA==</data></layer></map>`,
+		`<map width="1" height="1" tilewidth="2" tileheight="2"><layer><data encoding="base64">AAA=</data></layer></map>`,
+		`<map width="1" height="1" tilewidth="2" tileheight="2"><group opacity="x"/></map>`,
+	} {
+		_, err := FromTMX(strings.NewReader(tmx), open)
+		assert.NotNil(t, err, tmx)
+	}
+
+	_, err := FromTMX(strings.NewReader(`<map width="1" height="1" tilewidth="2" tileheight="2"><tileset firstgid="1" source="a.tsx"/></map>`), nil)
+	assert.True(t, errors.Is(err, errInvalidTiledMap))
+
+	for _, source := range []string{
+		`{`,
+		`{"infinite":true}`,
+		`{"width":1,"height":1,"tilewidth":2,"tileheight":2,"tilesets":[{"firstgid":1,"source":"missing.json"}]}`,
+		`{"width":1,"height":1,"tilewidth":2,"tileheight":2,"tilesets":[{"firstgid":1,"image":"tiles/tiles.png"}]}`,
+		`{"width":1,"height":1,"tilewidth":2,"tileheight":2,"layers":[{"type":"tilelayer","data":"x"}]}`,
+		`{"width":1,"height":1,"tilewidth":2,"tileheight":2,"layers":[{"type":"group","layers":[{"type":"tilelayer","encoding":"base64","data":"!"}]}]}`,
+	} {
+		_, err := FromTiledJSON(strings.NewReader(source), open)
+		assert.NotNil(t, err, source)
+	}
+
+	tiledMap := &TiledMap{Rows: 1, Columns: 1, TileWidth: 2, TileHeight: 2, Layers: []TiledLayer{{Opacity: 1, GIDs: []uint32{5}}}}
+	_, err = tiledMap.Render(ImageConfig{}, GridConfig{})
+	assert.True(t, errors.Is(err, errInvalidTiledMap))
+	tiledMap.Layers[0].GIDs = nil
+	_, err = tiledMap.Render(ImageConfig{}, GridConfig{})
+	assert.True(t, errors.Is(err, errInvalidTiledMap))
+}
+
+func TestTransposeImage(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.SetRGBA(1, 0, color.RGBA{R: 255, A: 255})
+	dst := transposeImage(src)
+	assert.Equal(t, dst.Bounds(), image.Rect(0, 0, 1, 2))
+	assert.Equal(t, dst.RGBAAt(0, 1), color.RGBA{R: 255, A: 255})
+}
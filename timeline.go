@@ -0,0 +1,181 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"time"
+)
+
+// Keyframe is the value of a property at a point of a timeline. The easing shapes the transition from the
+// previous keyframe, a nil easing transitions at a constant speed
+type Keyframe struct {
+	Time   time.Duration
+	Value  float64
+	Easing Easing
+}
+
+// Track animates a numeric property through keyframes sorted by time. Apply is called on every frame with the
+// interpolated value and a transparent frame layer that shares the grid geometry and entities
+type Track struct {
+	Keyframes []Keyframe
+	Apply     func(layer *Gridder, value float64) error
+}
+
+// Timeline is a set of tracks that are played together
+type Timeline struct {
+	Tracks []Track
+}
+
+// AddTrack adds a track to the timeline
+func (t *Timeline) AddTrack(track Track) {
+	t.Tracks = append(t.Tracks, track)
+}
+
+// Duration gets the time of the last keyframe of the timeline
+func (t *Timeline) Duration() time.Duration {
+	var duration time.Duration
+	for _, track := range t.Tracks {
+		if len(track.Keyframes) == 0 {
+			continue
+		}
+		if last := track.Keyframes[len(track.Keyframes)-1].Time; last > duration {
+			duration = last
+		}
+	}
+	return duration
+}
+
+// ValueAt gets the value of the track at a time, holding the first and last values outside of the keyframes
+func (t *Track) ValueAt(at time.Duration) float64 {
+	if len(t.Keyframes) == 0 {
+		return 0
+	}
+
+	if at <= t.Keyframes[0].Time {
+		return t.Keyframes[0].Value
+	}
+
+	for i := 1; i < len(t.Keyframes); i++ {
+		previous, next := t.Keyframes[i-1], t.Keyframes[i]
+		if at > next.Time {
+			continue
+		}
+
+		easing := next.Easing
+		if easing == nil {
+			easing = EaseLinear
+		}
+		progress := easing(float64(at-previous.Time) / float64(next.Time-previous.Time))
+		return previous.Value + (next.Value-previous.Value)*progress
+	}
+	return t.Keyframes[len(t.Keyframes)-1].Value
+}
+
+// CellColorTrack animates the color of a cell through a colormap
+func CellColorTrack(row int, column int, colormap func(value float64) color.Color, keyframes ...Keyframe) Track {
+	return Track{Keyframes: keyframes, Apply: func(layer *Gridder, value float64) error {
+		return layer.PaintCell(row, column, colormap(value))
+	}}
+}
+
+// CellOpacityTrack animates the opacity of a cell painted with a color, values range from 0 to 1
+func CellOpacityTrack(row int, column int, c color.Color, keyframes ...Keyframe) Track {
+	return Track{Keyframes: keyframes, Apply: func(layer *Gridder, value float64) error {
+		return layer.PaintCell(row, column, fade(c, value))
+	}}
+}
+
+// CircleRadiusTrack animates the radius of a circle drawn in a cell
+func CircleRadiusTrack(row int, column int, circleConfig CircleConfig, keyframes ...Keyframe) Track {
+	return Track{Keyframes: keyframes, Apply: func(layer *Gridder, value float64) error {
+		circleConfig.Radius = value
+		return layer.DrawCircle(row, column, circleConfig)
+	}}
+}
+
+// EntityRowTrack animates the row of an entity, values between rows place it between cells
+func EntityRowTrack(id string, keyframes ...Keyframe) Track {
+	return Track{Keyframes: keyframes, Apply: func(layer *Gridder, value float64) error {
+		position, err := layer.getEntity(id)
+		if err != nil {
+			return err
+		}
+		return layer.setEntityPosition(id, value, position.column)
+	}}
+}
+
+// EntityColumnTrack animates the column of an entity, values between columns place it between cells
+func EntityColumnTrack(id string, keyframes ...Keyframe) Track {
+	return Track{Keyframes: keyframes, Apply: func(layer *Gridder, value float64) error {
+		position, err := layer.getEntity(id)
+		if err != nil {
+			return err
+		}
+		return layer.setEntityPosition(id, position.row, value)
+	}}
+}
+
+// RenderTimeline renders a frame of the timeline at every step of a frame rate, from the start to its duration.
+// Tracks draw into a layer per frame, so nothing they draw is kept on the grid, while entities keep the
+// position of the last frame
+func (g *Gridder) RenderTimeline(timeline Timeline, fps float64) ([]image.Image, error) {
+	if math.IsNaN(fps) || math.IsInf(fps, 0) || fps <= 0 {
+		return nil, fmt.Errorf("%w: fps %v", errInvalidValue, fps)
+	}
+
+	for _, track := range timeline.Tracks {
+		for i := 1; i < len(track.Keyframes); i++ {
+			if track.Keyframes[i].Time <= track.Keyframes[i-1].Time {
+				return nil, fmt.Errorf("%w: keyframe time %v", errInvalidValue, track.Keyframes[i].Time)
+			}
+		}
+	}
+
+	frames := int(timeline.Duration().Seconds()*fps) + 1
+	images := make([]image.Image, 0, frames)
+	for frame := 0; frame < frames; frame++ {
+		at := time.Duration(float64(frame) / fps * float64(time.Second))
+		layer := g.frameLayer()
+		for _, track := range timeline.Tracks {
+			err := track.Apply(layer, track.ValueAt(at))
+			if err != nil {
+				return nil, err
+			}
+		}
+		images = append(images, g.composeImage(layer.ctx.Image()))
+	}
+	return images, nil
+}
+
+// frameLayer creates a transparent layer sharing the grid geometry, cell styles and entities
+func (g *Gridder) frameLayer() *Gridder {
+	if g.parent != nil {
+		return g.parent.frameLayer()
+	}
+
+	layer := Gridder{
+		imageConfig: g.imageConfig,
+		gridConfig:  g.gridConfig,
+		ctx:         newContext(g.imageConfig, g.gridConfig),
+		parent:      g,
+		cellStyles:  g.cellStyles,
+		locale:      g.locale,
+	}
+	layer.paintBackground()
+	return &layer
+}
+
+// fade scales the alpha of a color by an opacity between 0 and 1
+func fade(c color.Color, opacity float64) color.Color {
+	if opacity < 0 {
+		opacity = 0
+	} else if opacity > 1 {
+		opacity = 1
+	}
+
+	nrgba := color.NRGBAModel.Convert(c).(color.NRGBA)
+	nrgba.A = uint8(float64(nrgba.A)*opacity + 0.5)
+	return nrgba
+}
@@ -0,0 +1,80 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrackValueAt(t *testing.T) {
+	track := Track{Keyframes: []Keyframe{
+		{Time: time.Second, Value: 10},
+		{Time: 2 * time.Second, Value: 20},
+		{Time: 4 * time.Second, Value: 0, Easing: EaseIn},
+	}}
+	assert.Equal(t, track.ValueAt(0), 10.0)
+	assert.Equal(t, track.ValueAt(1500*time.Millisecond), 15.0)
+	assert.Equal(t, track.ValueAt(3*time.Second), 15.0)
+	assert.Equal(t, track.ValueAt(5*time.Second), 0.0)
+
+	empty := Track{}
+	assert.Equal(t, empty.ValueAt(time.Second), 0.0)
+}
+
+func TestTimelineDuration(t *testing.T) {
+	timeline := Timeline{}
+	assert.Equal(t, timeline.Duration(), time.Duration(0))
+
+	timeline.AddTrack(Track{Keyframes: []Keyframe{{Time: time.Second}}})
+	timeline.AddTrack(Track{})
+	timeline.AddTrack(Track{Keyframes: []Keyframe{{Time: 0}, {Time: 3 * time.Second}}})
+	assert.Equal(t, timeline.Duration(), 3*time.Second)
+}
+
+func TestRenderTimeline(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.RegisterEntity("piece", Entity{Circle: &CircleConfig{Radius: 5, Color: color.Black}}))
+	assert.Nil(t, gridder.SetEntityCell("piece", 1, 0))
+
+	colormap := func(value float64) color.Color { return color.Gray{Y: uint8(value)} }
+	timeline := Timeline{}
+	timeline.AddTrack(CellColorTrack(0, 0, colormap, Keyframe{Time: 0, Value: 255}, Keyframe{Time: time.Second, Value: 0}))
+	timeline.AddTrack(CellOpacityTrack(0, 1, color.Black, Keyframe{Time: 0, Value: 0}, Keyframe{Time: time.Second, Value: 1}))
+	timeline.AddTrack(CircleRadiusTrack(1, 1, CircleConfig{Color: color.Black}, Keyframe{Time: 0, Value: 1}, Keyframe{Time: time.Second, Value: 20}))
+	timeline.AddTrack(EntityColumnTrack("piece", Keyframe{Time: 0, Value: 0}, Keyframe{Time: time.Second, Value: 1}))
+	timeline.AddTrack(EntityRowTrack("piece", Keyframe{Time: 0, Value: 1}))
+
+	_, err = gridder.RenderTimeline(timeline, 0)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	frames, err := gridder.RenderTimeline(timeline, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, len(frames), 3)
+
+	assert.Equal(t, color.GrayModel.Convert(frames[0].At(25, 25)), color.Gray{Y: 255})
+	assert.Equal(t, color.GrayModel.Convert(frames[2].At(25, 25)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(frames[0].At(75, 25)), color.Gray{Y: 255})
+	assert.Equal(t, color.GrayModel.Convert(frames[2].At(75, 25)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(frames[0].At(87, 75)), color.Gray{Y: 255})
+	assert.Equal(t, color.GrayModel.Convert(frames[2].At(87, 75)), color.Gray{})
+	assert.Equal(t, color.GrayModel.Convert(frames[1].At(50, 75)), color.Gray{})
+
+	assert.Equal(t, gridder.operations.len(), 0)
+	cell, err := gridder.GetEntityCell("piece")
+	assert.Nil(t, err)
+	assert.Equal(t, cell, Cell{Row: 1, Column: 1})
+
+	timeline.AddTrack(Track{Keyframes: []Keyframe{{Time: time.Second}, {Time: time.Second}}})
+	_, err = gridder.RenderTimeline(timeline, 2)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestFade(t *testing.T) {
+	assert.Equal(t, fade(color.Black, 0.5), color.NRGBA{A: 128})
+	assert.Equal(t, fade(color.White, 2), color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	assert.Equal(t, fade(color.White, -1), color.NRGBA{R: 255, G: 255, B: 255})
+}
@@ -0,0 +1,223 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fogleman/gg"
+	"golang.org/x/image/font"
+)
+
+// TimetableEvent is an event of a weekly timetable, on a day column counted from 0 and between times since midnight
+type TimetableEvent struct {
+	Day   int
+	Start time.Duration
+	End   time.Duration
+	Title string
+	// Color defaults to a color of the default palette, picked by the index of the event
+	Color color.Color
+}
+
+// Timetable is a weekly schedule of events
+type Timetable struct {
+	Events []TimetableEvent
+}
+
+// Render creates the timetable image with a column per day and a row per time slot, below a header of day names and
+// right of a column of slot times. Events are drawn as blocks spanning their times, and overlapping events of a day
+// are laid out side by side. Every event gets a tooltip with its times on its first cell. Texts are drawn when a
+// font is configured, titles wrapped to the width of their block
+func (t Timetable) Render(imageConfig ImageConfig, timetableConfigs ...TimetableConfig) (*Gridder, error) {
+	timetableConfig := getFirstTimetableConfig(timetableConfigs...)
+	days := timetableConfig.GetDays()
+	start, end, slot := timetableConfig.GetStartTime(), timetableConfig.GetEndTime(), timetableConfig.GetSlotDuration()
+	if end <= start {
+		return nil, fmt.Errorf("%w: day from %s to %s", errInvalidValue, formatTimeOfDay(start), formatTimeOfDay(end))
+	}
+
+	for i, event := range t.Events {
+		if event.Day < 0 || event.Day >= len(days) {
+			return nil, fmt.Errorf("%w: day %d of event %d", errOutOfBounds, event.Day, i)
+		}
+		if event.End <= event.Start {
+			return nil, fmt.Errorf("%w: event %d from %s to %s", errInvalidValue, i, formatTimeOfDay(event.Start), formatTimeOfDay(event.End))
+		}
+		if event.Start < start || event.End > end {
+			return nil, fmt.Errorf("%w: event %d from %s to %s", errOutOfBounds, i, formatTimeOfDay(event.Start), formatTimeOfDay(event.End))
+		}
+	}
+
+	slots := int((end - start + slot - 1) / slot)
+	rows, columns := slots+1, len(days)+1
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultTimetableColumnWidth
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = rows * defaultTimetableRowHeight
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:              rows,
+		Columns:           columns,
+		LineStrokeWidth:   1,
+		BorderStrokeWidth: 1,
+		LineColor:         timetableConfig.GetLineColor(),
+		BorderColor:       timetableConfig.GetLineColor(),
+		BackgroundColor:   timetableConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if timetableConfig.Font != nil {
+		fontFace = g.FontFace(timetableConfig.Font, timetableConfig.GetFontFraction())
+	}
+
+	lanes, laneCounts := timetableLanes(t.Events)
+	timeY := func(day int, at time.Duration) float64 {
+		fraction := float64(at-start) / float64(slot)
+		row := minInt(int(fraction), slots-1)
+		center := g.getCellCenter(row+1, day+1)
+		_, height := g.getCellDimensions(row+1, day+1)
+		return center.Y - height/2 + (fraction-float64(row))*height
+	}
+
+	err = g.retain(func() error {
+		for i, event := range t.Events {
+			center := g.getCellCenter(1, event.Day+1)
+			width, _ := g.getCellDimensions(1, event.Day+1)
+			laneWidth := width / float64(laneCounts[i])
+			x := center.X - width/2 + float64(lanes[i])*laneWidth
+			fill := event.Color
+			if fill == nil {
+				fill = defaultChartColors[i%len(defaultChartColors)]
+			}
+			g.drawTimetableEvent(x+1, timeY(event.Day, event.Start)+1, laneWidth-2, timeY(event.Day, event.End)-timeY(event.Day, event.Start)-2, event.Title, fill, fontFace)
+		}
+
+		if fontFace == nil {
+			return nil
+		}
+
+		stringConfig := StringConfig{Color: timetableConfig.GetTextColor()}
+		for day, name := range days {
+			err := g.drawString(0, day+1, name, fontFace, stringConfig)
+			if err != nil {
+				return err
+			}
+		}
+		for row := 0; row < slots; row++ {
+			err := g.drawString(row+1, 0, formatTimeOfDay(start+time.Duration(row)*slot), fontFace, stringConfig)
+			if err != nil {
+				return err
+			}
+		}
+		for _, event := range t.Events {
+			g.addLabel(int((event.Start-start)/slot)+1, event.Day+1, event.Title)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tooltips := make(map[Cell][]string)
+	for _, event := range t.Events {
+		cell := Cell{Row: int((event.Start-start)/slot) + 1, Column: event.Day + 1}
+		tooltips[cell] = append(tooltips[cell], fmt.Sprintf("%s %s–%s", event.Title, formatTimeOfDay(event.Start), formatTimeOfDay(event.End)))
+	}
+	for cell, texts := range tooltips {
+		err = g.SetTooltip(cell.Row, cell.Column, strings.Join(texts, "; "))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// drawTimetableEvent fills the block of an event and writes its title wrapped inside it
+func (g *Gridder) drawTimetableEvent(x float64, y float64, width float64, height float64, title string, fill color.Color, fontFace font.Face) {
+	g.ctx.Push()
+	defer g.ctx.Pop()
+	g.ctx.DrawRoundedRectangle(x, y, width, height, math.Min(defaultTimetablePadding, math.Min(width, height)/2))
+	g.ctx.SetColor(fill)
+	g.ctx.Fill()
+
+	if fontFace == nil || title == "" {
+		return
+	}
+	g.ctx.DrawRectangle(x, y, width, height)
+	g.ctx.Clip()
+	g.ctx.SetFontFace(fontFace)
+	g.ctx.SetColor(contrastColor(fill))
+	g.ctx.DrawStringWrapped(title, x+defaultTimetablePadding, y+defaultTimetablePadding, 0, 0, width-2*defaultTimetablePadding, 1.1, gg.AlignLeft)
+	g.ctx.ResetClip()
+}
+
+// timetableLanes lays out overlapping events of a day side by side. It gets the lane of every event and the number
+// of lanes of the group of events overlapping with it, directly or through other events
+func timetableLanes(events []TimetableEvent) ([]int, []int) {
+	order := make([]int, len(events))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		x, y := events[order[a]], events[order[b]]
+		if x.Day != y.Day {
+			return x.Day < y.Day
+		}
+		if x.Start != y.Start {
+			return x.Start < y.Start
+		}
+		return x.End < y.End
+	})
+
+	lanes := make([]int, len(events))
+	laneCounts := make([]int, len(events))
+	var group []int
+	var laneEnds []time.Duration
+	var groupEnd time.Duration
+	closeGroup := func() {
+		for _, i := range group {
+			laneCounts[i] = len(laneEnds)
+		}
+		group, laneEnds = nil, nil
+	}
+
+	for n, i := range order {
+		event := events[i]
+		if n > 0 && (event.Day != events[order[n-1]].Day || event.Start >= groupEnd) {
+			closeGroup()
+		}
+		if len(group) == 0 || event.End > groupEnd {
+			groupEnd = event.End
+		}
+
+		lane := len(laneEnds)
+		for l, laneEnd := range laneEnds {
+			if laneEnd <= event.Start {
+				lane = l
+				break
+			}
+		}
+		if lane == len(laneEnds) {
+			laneEnds = append(laneEnds, event.End)
+		} else {
+			laneEnds[lane] = event.End
+		}
+		lanes[i] = lane
+		group = append(group, i)
+	}
+	closeGroup()
+	return lanes, laneCounts
+}
+
+// formatTimeOfDay formats a time since midnight as hours and minutes
+func formatTimeOfDay(at time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(at.Hours()), int(at.Minutes())%60)
+}
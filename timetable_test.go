@@ -0,0 +1,89 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+var testTimetable = Timetable{Events: []TimetableEvent{
+	{Day: 0, Start: 9 * time.Hour, End: 10*time.Hour + 30*time.Minute, Title: "Math"},
+	{Day: 0, Start: 10 * time.Hour, End: 11 * time.Hour, Title: "Art"},
+	{Day: 1, Start: 8 * time.Hour, End: 9 * time.Hour, Title: "Music", Color: color.NRGBA{R: 255, A: 255}},
+}}
+
+func TestTimetableRender(t *testing.T) {
+	gridder, err := testTimetable.Render(ImageConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 720)
+	assert.Equal(t, gridder.ctx.Height(), 440)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 100)), defaultChartColors[0])
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 135)), defaultChartColors[0])
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 150)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(210, 150)), defaultChartColors[1])
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(210, 100)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(300, 60)), color.NRGBA{R: 255, A: 255})
+
+	tooltip, err := gridder.GetTooltip(2, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, tooltip, "Math 09:00–10:30")
+	tooltip, _ = gridder.GetTooltip(3, 1)
+	assert.Equal(t, tooltip, "Art 10:00–11:00")
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestTimetableRenderLabels(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	gridder, err := testTimetable.Render(ImageConfig{}, TimetableConfig{Days: []string{"Sat", "Sun"}, EndTime: 12 * time.Hour, SlotDuration: 4 * time.Hour, Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 360)
+	assert.Equal(t, gridder.ctx.Height(), 160)
+
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 1}], []string{"Sat"})
+	assert.Equal(t, gridder.labels[Cell{Row: 1, Column: 0}], []string{"00:00"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 0}], []string{"08:00"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 1}], []string{"Math", "Art"})
+	assert.Equal(t, gridder.labels[Cell{Row: 3, Column: 2}], []string{"Music"})
+
+	tooltip, _ := gridder.GetTooltip(3, 1)
+	assert.Equal(t, tooltip, "Math 09:00–10:30; Art 10:00–11:00")
+}
+
+func TestTimetableLanes(t *testing.T) {
+	events := []TimetableEvent{
+		{Day: 0, Start: 9 * time.Hour, End: 11 * time.Hour},
+		{Day: 0, Start: 10 * time.Hour, End: 12 * time.Hour},
+		{Day: 0, Start: 11 * time.Hour, End: 13 * time.Hour},
+		{Day: 0, Start: 13 * time.Hour, End: 14 * time.Hour},
+		{Day: 1, Start: 9 * time.Hour, End: 10 * time.Hour},
+	}
+	lanes, laneCounts := timetableLanes(events)
+	assert.Equal(t, lanes, []int{0, 1, 0, 0, 0})
+	assert.Equal(t, laneCounts, []int{2, 2, 2, 1, 1})
+}
+
+func TestTimetableRenderErrors(t *testing.T) {
+	_, err := Timetable{}.Render(ImageConfig{}, TimetableConfig{StartTime: 10 * time.Hour, EndTime: 9 * time.Hour})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = Timetable{Events: []TimetableEvent{{Day: 5, Start: 9 * time.Hour, End: 10 * time.Hour}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errOutOfBounds))
+
+	_, err = Timetable{Events: []TimetableEvent{{Start: 10 * time.Hour, End: 10 * time.Hour}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = Timetable{Events: []TimetableEvent{{Start: 7 * time.Hour, End: 9 * time.Hour}}}.Render(ImageConfig{})
+	assert.True(t, errors.Is(err, errOutOfBounds))
+}
+
+func TestFormatTimeOfDay(t *testing.T) {
+	assert.Equal(t, formatTimeOfDay(9*time.Hour+5*time.Minute), "09:05")
+	assert.Equal(t, formatTimeOfDay(0), "00:00")
+}
@@ -0,0 +1,87 @@
+package gridder
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// tooltipRegion is a tooltip and its area in the rendered image, as exported
+type tooltipRegion struct {
+	Row    int     `json:"row"`
+	Column int     `json:"column"`
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Text   string  `json:"text"`
+}
+
+// SetTooltip sets the hover text of a cell, shown over the cell in SVG output and listed by ExportTooltips. An empty
+// text clears it
+func (g *Gridder) SetTooltip(row int, column int, text string) error {
+	if g.parent != nil {
+		return g.parent.SetTooltip(row, column, text)
+	}
+
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return err
+	}
+
+	cell := Cell{Row: row, Column: column}
+	if text == "" {
+		delete(g.tooltips, cell)
+		return nil
+	}
+
+	if g.tooltips == nil {
+		g.tooltips = make(map[Cell]string)
+	}
+	g.tooltips[cell] = text
+	return nil
+}
+
+// GetTooltip gets the hover text of a cell
+func (g *Gridder) GetTooltip(row int, column int) (string, error) {
+	if g.parent != nil {
+		return g.parent.GetTooltip(row, column)
+	}
+
+	err := g.verifyInBounds(row, column)
+	if err != nil {
+		return "", err
+	}
+	return g.tooltips[Cell{Row: row, Column: column}], nil
+}
+
+// ClearTooltips clears the hover texts of all cells
+func (g *Gridder) ClearTooltips() {
+	if g.parent != nil {
+		g.parent.ClearTooltips()
+		return
+	}
+	g.tooltips = nil
+}
+
+// ExportTooltips writes the hover texts of the cells to w as a JSON array in row and column order, with the area of
+// every cell in the rendered image, for image maps and interactive viewers
+func (g *Gridder) ExportTooltips(w io.Writer) error {
+	if g.parent != nil {
+		return g.parent.ExportTooltips(w)
+	}
+
+	regions := g.cellRegions(g.tooltips)
+	tooltips := make([]tooltipRegion, len(regions))
+	for i, region := range regions {
+		tooltips[i] = tooltipRegion{
+			Row:    region.cell.Row,
+			Column: region.cell.Column,
+			X:      region.x,
+			Y:      region.y,
+			Width:  region.width,
+			Height: region.height,
+			Text:   region.text,
+		}
+	}
+	return json.NewEncoder(w).Encode(tooltips)
+}
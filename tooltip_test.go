@@ -0,0 +1,58 @@
+package gridder
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTooltip(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetTooltip(1, 2, "a < b"))
+
+	tooltip, err := gridder.GetTooltip(1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, tooltip, "a < b")
+
+	assert.True(t, errors.Is(gridder.SetTooltip(2, 0, "x"), errOutOfBounds))
+	_, err = gridder.GetTooltip(0, 4)
+	assert.True(t, errors.Is(err, errOutOfBounds))
+
+	assert.Nil(t, gridder.MoveCell(1, 2, 0, 0))
+	tooltip, _ = gridder.GetTooltip(0, 0)
+	assert.Equal(t, tooltip, "a < b")
+	tooltip, _ = gridder.GetTooltip(1, 2)
+	assert.Equal(t, tooltip, "")
+
+	assert.Nil(t, gridder.SetTooltip(0, 0, ""))
+	tooltip, _ = gridder.GetTooltip(0, 0)
+	assert.Equal(t, tooltip, "")
+
+	assert.Nil(t, gridder.SetTooltip(0, 1, "x"))
+	gridder.ClearTooltips()
+	tooltip, _ = gridder.GetTooltip(0, 1)
+	assert.Equal(t, tooltip, "")
+}
+
+func TestExportTooltips(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 2, Columns: 4})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.SetTooltip(1, 0, "b"))
+	assert.Nil(t, gridder.SetTooltip(0, 3, "a"))
+
+	var buffer bytes.Buffer
+	assert.Nil(t, gridder.ExportTooltips(&buffer))
+	assert.Equal(t, buffer.String(), `[{"row":0,"column":3,"x":150,"y":0,"width":50,"height":50,"text":"a"},{"row":1,"column":0,"x":0,"y":50,"width":50,"height":50,"text":"b"}]`+"\n")
+
+	buffer.Reset()
+	assert.Nil(t, gridder.EncodeSVG(&buffer))
+	assert.True(t, bytes.Contains(buffer.Bytes(), []byte(`<rect x="150" y="0" width="50" height="50" fill="transparent"><title>a</title></rect>`)))
+
+	gridder.ClearTooltips()
+	buffer.Reset()
+	assert.Nil(t, gridder.ExportTooltips(&buffer))
+	assert.Equal(t, buffer.String(), "[]\n")
+}
@@ -0,0 +1,211 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	"golang.org/x/image/font"
+)
+
+// TreemapItem is a leaf of a treemap
+type TreemapItem struct {
+	Name  string
+	Value float64
+	// Color overrides the color mapped from the value
+	Color color.Color
+}
+
+// RenderTreemap creates a squarified treemap on the configured grid, giving every item a rectangular block of cells
+// with an area in proportion to its value. Blocks are colored by value relative to the value range of the items,
+// and named when a font is configured and the name fits the block. Items too small for a single cell are left out
+func RenderTreemap(items []TreemapItem, treemapConfigs ...TreemapConfig) (*Gridder, error) {
+	treemapConfig := getFirstTreemapConfig(treemapConfigs...)
+	if len(items) == 0 {
+		return nil, errNoRows
+	}
+
+	order := make([]int, len(items))
+	minValue, maxValue := math.Inf(1), math.Inf(-1)
+	for i, item := range items {
+		err := nonNegative("treemap value", item.Value)
+		if err != nil {
+			return nil, err
+		}
+		order[i] = i
+		minValue, maxValue = math.Min(minValue, item.Value), math.Max(maxValue, item.Value)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return items[order[i]].Value > items[order[j]].Value
+	})
+
+	values := make([]float64, len(order))
+	for i, index := range order {
+		values[i] = items[index].Value
+	}
+
+	rows, columns := treemapConfig.GetRows(), treemapConfig.GetColumns()
+	blocks := squarifyCells(values, image.Rect(0, 0, columns, rows))
+
+	imageConfig := treemapConfig.ImageConfig
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultTreemapCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = rows * defaultTreemapCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            rows,
+		Columns:         columns,
+		LineColor:       color.Transparent,
+		BorderColor:     color.Transparent,
+		BackgroundColor: treemapConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if treemapConfig.Font != nil {
+		fontFace = g.FontFace(treemapConfig.Font, treemapConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		for i, block := range blocks {
+			if block.Empty() {
+				continue
+			}
+
+			item := items[order[i]]
+			fill := item.Color
+			if fill == nil {
+				fraction := 1.0
+				if maxValue > minValue {
+					fraction = (item.Value - minValue) / (maxValue - minValue)
+				}
+				fill = treemapConfig.GetColor(fraction)
+			}
+			g.drawTreemapBlock(block, item.Name, fill, fontFace)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// drawTreemapBlock fills a block of cells, with columns along X and rows along Y, inset by the gap between blocks,
+// and centers the name in it when it fits
+func (g *Gridder) drawTreemapBlock(block image.Rectangle, name string, fill color.Color, fontFace font.Face) {
+	topLeft := g.getCellCenter(block.Min.Y, block.Min.X)
+	width, height := g.getCellDimensions(block.Min.Y, block.Min.X)
+	x0, y0 := topLeft.X-width/2+defaultTreemapGap/2, topLeft.Y-height/2+defaultTreemapGap/2
+
+	bottomRight := g.getCellCenter(block.Max.Y-1, block.Max.X-1)
+	width, height = g.getCellDimensions(block.Max.Y-1, block.Max.X-1)
+	x1, y1 := bottomRight.X+width/2-defaultTreemapGap/2, bottomRight.Y+height/2-defaultTreemapGap/2
+
+	g.ctx.Push()
+	defer g.ctx.Pop()
+	g.ctx.DrawRectangle(x0, y0, x1-x0, y1-y0)
+	g.ctx.SetColor(fill)
+	g.ctx.Fill()
+
+	if fontFace == nil || name == "" {
+		return
+	}
+	g.ctx.SetFontFace(fontFace)
+	textWidth, _ := g.ctx.MeasureString(name)
+	if textWidth > x1-x0 {
+		return
+	}
+	g.ctx.SetColor(contrastColor(fill))
+	g.ctx.DrawStringAnchored(name, (x0+x1)/2, (y0+y1)/2, 0.5, 0.35)
+	g.addLabel(block.Min.Y, block.Min.X, name)
+}
+
+// squarifyCells lays out values sorted from the largest as blocks of cells of an area. Strips of blocks are laid
+// along the shorter side of the remaining area, growing while that improves their worst aspect ratio, with
+// lengths rounded to whole cells. Blocks without a cell are empty
+func squarifyCells(values []float64, area image.Rectangle) []image.Rectangle {
+	blocks := make([]image.Rectangle, len(values))
+	for start := 0; start < len(values) && !area.Empty(); {
+		var total float64
+		for _, value := range values[start:] {
+			total += value
+		}
+		if total <= 0 {
+			break
+		}
+
+		// a wide area takes a strip at its left, a tall one a strip at its top
+		wide := area.Dx() >= area.Dy()
+		long, short := area.Dy(), area.Dx()
+		if wide {
+			long, short = area.Dx(), area.Dy()
+		}
+
+		end := start + 1
+		worst := stripWorstAspect(values[start:end], total, long, short)
+		for end < len(values) {
+			next := stripWorstAspect(values[start:end+1], total, long, short)
+			if next > worst {
+				break
+			}
+			worst = next
+			end++
+		}
+
+		thickness := long
+		if end < len(values) {
+			var stripTotal float64
+			for _, value := range values[start:end] {
+				stripTotal += value
+			}
+			thickness = minInt(maxInt(int(math.Round(stripTotal/total*float64(long))), 1), long)
+		}
+
+		offset := 0
+		for i, length := range largestRemainder(values[start:end], short) {
+			if wide {
+				blocks[start+i] = image.Rect(area.Min.X, area.Min.Y+offset, area.Min.X+thickness, area.Min.Y+offset+length)
+			} else {
+				blocks[start+i] = image.Rect(area.Min.X+offset, area.Min.Y, area.Min.X+offset+length, area.Min.Y+thickness)
+			}
+			offset += length
+		}
+
+		if wide {
+			area.Min.X += thickness
+		} else {
+			area.Min.Y += thickness
+		}
+		start = end
+	}
+	return blocks
+}
+
+// stripWorstAspect gets the worst aspect ratio of the blocks of a strip of values across the short side of an area
+func stripWorstAspect(values []float64, total float64, long int, short int) float64 {
+	var stripTotal float64
+	for _, value := range values {
+		stripTotal += value
+	}
+	if stripTotal <= 0 {
+		return math.Inf(1)
+	}
+
+	thickness := stripTotal / total * float64(long)
+	worst := 0.0
+	for _, value := range values {
+		length := value / stripTotal * float64(short)
+		if length <= 0 {
+			return math.Inf(1)
+		}
+		worst = math.Max(worst, math.Max(thickness/length, length/thickness))
+	}
+	return worst
+}
@@ -0,0 +1,59 @@
+package gridder
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestSquarifyCells(t *testing.T) {
+	assert.Equal(t, squarifyCells([]float64{5, 3, 2}, image.Rect(0, 0, 10, 10)), []image.Rectangle{
+		image.Rect(0, 0, 5, 10),
+		image.Rect(5, 0, 10, 6),
+		image.Rect(5, 6, 10, 10),
+	})
+	assert.Equal(t, squarifyCells([]float64{6, 6, 4, 3, 2, 2, 1}, image.Rect(0, 0, 6, 4))[:3], []image.Rectangle{
+		image.Rect(0, 0, 3, 2),
+		image.Rect(0, 2, 3, 4),
+		image.Rect(3, 0, 5, 2),
+	})
+	assert.Equal(t, squarifyCells([]float64{50, 1, 0}, image.Rect(0, 0, 2, 2)), []image.Rectangle{image.Rect(0, 0, 2, 2), {}, {}})
+}
+
+func TestRenderTreemap(t *testing.T) {
+	red := color.NRGBA{R: 255, A: 255}
+	gridder, err := RenderTreemap([]TreemapItem{{Name: "small", Value: 2}, {Name: "large", Value: 5, Color: red}, {Name: "medium", Value: 3}})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 300)
+	assert.Equal(t, gridder.ctx.Height(), 300)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(75, 150)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(225, 90)), LinearColormap(defaultTreemapLowColor, defaultTreemapHighColor)(1.0/3))
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(225, 240)), color.NRGBAModel.Convert(defaultTreemapLowColor))
+
+	// the gap between blocks shows the background
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(150, 150)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestRenderTreemapLabels(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	gridder, err := RenderTreemap([]TreemapItem{{Name: "large", Value: 90}, {Name: "a much too long name", Value: 10}}, TreemapConfig{Rows: 4, Columns: 4, Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.labels[Cell{Row: 0, Column: 0}], []string{"large"})
+	assert.Equal(t, len(gridder.labels), 1)
+}
+
+func TestRenderTreemapErrors(t *testing.T) {
+	_, err := RenderTreemap(nil)
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = RenderTreemap([]TreemapItem{{Value: -1}})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
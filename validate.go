@@ -0,0 +1,284 @@
+package gridder
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// validateValues returns the first error of a list of validations
+func validateValues(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func finite(name string, value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("%w: %s %v", errInvalidValue, name, value)
+	}
+	return nil
+}
+
+func nonNegative(name string, value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+		return fmt.Errorf("%w: %s %v", errInvalidValue, name, value)
+	}
+	return nil
+}
+
+// strokeWidth validates the stroke width a getter resolved, as getters replace negative widths with their default
+func strokeWidth(value float64, max float64) error {
+	if math.IsNaN(value) || value < 0 || value > max {
+		return fmt.Errorf("%w: stroke width %v", errInvalidValue, value)
+	}
+	return nil
+}
+
+// maxStrokeWidth is the widest stroke accepted, anything wider than the image cannot produce meaningful output
+func (g *Gridder) maxStrokeWidth() float64 {
+	return float64(maxInt(g.imageConfig.GetWidth(), g.imageConfig.GetHeight()))
+}
+
+func (g *GridConfig) validate(imageWidth int, imageHeight int) error {
+	if g.GetWidth(imageWidth) <= 0 || g.GetHeight(imageHeight) <= 0 {
+		return fmt.Errorf("%w: margin width %d", errInvalidValue, g.MarginWidth)
+	}
+
+	maxStroke := float64(maxInt(imageWidth, imageHeight))
+	err := validateValues(
+		nonNegative("line dashes", g.LineDashes),
+		nonNegative("border dashes", g.BorderDashes),
+		nonNegative("jitter", g.Jitter),
+		nonNegative("minimum cell size", g.MinCellSize),
+		strokeWidth(g.GetLineStrokeWidth(), maxStroke),
+		strokeWidth(g.GetBorderStrokeWidth(), maxStroke),
+	)
+	if err != nil {
+		return err
+	}
+
+	gridWidth, gridHeight := float64(g.GetWidth(imageWidth)), float64(g.GetHeight(imageHeight))
+	for _, v := range g.RowsHeightOffset {
+		err = finite("row offset", v.Offset)
+		if err != nil {
+			return err
+		}
+		if v.Row < 0 || v.Row >= g.GetRows() || v.Offset >= gridHeight {
+			return errOutOfBounds
+		}
+	}
+	for _, v := range g.ColumnsWidthOffset {
+		err = finite("column offset", v.Offset)
+		if err != nil {
+			return err
+		}
+		if v.Column < 0 || v.Column >= g.GetColumns() || v.Offset >= gridWidth {
+			return errOutOfBounds
+		}
+	}
+
+	err = g.validateCellSize(imageWidth, imageHeight)
+	if err != nil {
+		return err
+	}
+	if g.OuterLines < OuterLinesDraw || g.OuterLines > OuterLinesBorder {
+		return fmt.Errorf("%w: outer line mode %d", errInvalidValue, g.OuterLines)
+	}
+
+	if g.CellMask == nil {
+		return nil
+	}
+	if len(g.CellMask) != g.GetRows() {
+		return fmt.Errorf("%w: cell mask of %d rows", errMatrixDimensions, len(g.CellMask))
+	}
+	for row := range g.CellMask {
+		if len(g.CellMask[row]) != g.GetColumns() {
+			return fmt.Errorf("%w: cell mask row %d of %d columns", errMatrixDimensions, row, len(g.CellMask[row]))
+		}
+	}
+	return nil
+}
+
+func (g *RectangleConfig) validate(maxStroke float64) error {
+	return validateValues(
+		nonNegative("width", g.Width),
+		nonNegative("height", g.Height),
+		finite("rotate", g.Rotate),
+		nonNegative("dashes", g.Dashes),
+		strokeWidth(g.GetStrokeWidth(), maxStroke),
+		g.Span.validate(),
+	)
+}
+
+func (g *CircleConfig) validate(maxStroke float64) error {
+	return validateValues(
+		nonNegative("radius", g.Radius),
+		nonNegative("dashes", g.Dashes),
+		strokeWidth(g.GetStrokeWidth(), maxStroke),
+	)
+}
+
+func (g *PathConfig) validate(maxStroke float64) error {
+	return validateValues(
+		nonNegative("dashes", g.Dashes),
+		strokeWidth(g.GetStrokeWidth(), maxStroke),
+		g.StartPort.validate(),
+		g.EndPort.validate(),
+	)
+}
+
+func (g *LineConfig) validate(maxStroke float64) error {
+	return validateValues(
+		nonNegative("length", g.Length),
+		finite("rotate", g.Rotate),
+		nonNegative("dashes", g.Dashes),
+		strokeWidth(g.GetStrokeWidth(), maxStroke),
+	)
+}
+
+func (g *StringConfig) validate() error {
+	if g.HorizontalAlign < AlignCenter || g.HorizontalAlign > AlignRight {
+		return fmt.Errorf("%w: horizontal align %d", errInvalidValue, g.HorizontalAlign)
+	}
+	if g.VerticalAlign < AlignMiddle || g.VerticalAlign > AlignBottom {
+		return fmt.Errorf("%w: vertical align %d", errInvalidValue, g.VerticalAlign)
+	}
+	return validateValues(
+		finite("rotate", g.Rotate),
+		nonNegative("line spacing", g.LineSpacing),
+		nonNegative("padding", g.Padding),
+		g.Span.validate(),
+	)
+}
+
+func (s Span) validate() error {
+	return validateValues(
+		nonNegative("span rows", float64(s.Rows)),
+		nonNegative("span columns", float64(s.Columns)),
+	)
+}
+
+func (g *PathRoutingConfig) validate() error {
+	return validateValues(
+		nonNegative("path spacing", g.Spacing),
+		nonNegative("jump radius", g.JumpRadius),
+	)
+}
+
+func (g *EdgeConfig) validate(maxStroke float64) error {
+	return validateValues(
+		nonNegative("dashes", g.Dashes),
+		strokeWidth(g.GetStrokeWidth(), maxStroke),
+	)
+}
+
+func (g *BorderConfig) validate(maxStroke float64) error {
+	for _, side := range g.Sides {
+		if side < SideTop || side > SideLeft {
+			return fmt.Errorf("%w: border side %d", errInvalidValue, side)
+		}
+	}
+	for _, sideConfig := range []*EdgeConfig{g.Top, g.Right, g.Bottom, g.Left} {
+		if sideConfig == nil {
+			continue
+		}
+		err := sideConfig.validate(maxStroke)
+		if err != nil {
+			return err
+		}
+	}
+	return validateValues(
+		nonNegative("dashes", g.Dashes),
+		strokeWidth(g.GetStrokeWidth(), maxStroke),
+	)
+}
+
+func (g *CornerConfig) validate() error {
+	return nonNegative("corner size", g.Size)
+}
+
+func (g *ContourConfig) validate(maxStroke float64) error {
+	return validateValues(
+		nonNegative("dashes", g.Dashes),
+		strokeWidth(g.GetStrokeWidth(), maxStroke),
+	)
+}
+
+func (g *VectorFieldConfig) validate(maxStroke float64) error {
+	return validateValues(
+		nonNegative("scale", g.Scale),
+		nonNegative("head size", g.HeadSize),
+		strokeWidth(g.GetStrokeWidth(), maxStroke),
+	)
+}
+
+func (g *GraphConfig) validate(maxStroke float64) error {
+	return validateValues(
+		nonNegative("node scale", g.NodeScale),
+		strokeWidth(g.GetEdgeStrokeWidth(), maxStroke),
+	)
+}
+
+// verifyFinite verifies that every value of a matrix is a finite number
+func verifyFinite(name string, values [][]float64) error {
+	for row := range values {
+		for _, value := range values[row] {
+			err := finite(name, value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *ImageDrawConfig) validate() error {
+	if g.Fit < FitContain || g.Fit > FitStretch {
+		return fmt.Errorf("%w: image fit %d", errInvalidValue, g.Fit)
+	}
+	err := validateValues(finite("rotate", g.Rotate), nonNegative("opacity", g.Opacity))
+	if err != nil {
+		return err
+	}
+	if g.Opacity > 1 {
+		return fmt.Errorf("%w: opacity %v", errInvalidValue, g.Opacity)
+	}
+	return nil
+}
+
+func (g *NineSliceConfig) validate(bounds image.Rectangle) error {
+	err := validateValues(
+		nonNegative("left inset", float64(g.Left)),
+		nonNegative("top inset", float64(g.Top)),
+		nonNegative("right inset", float64(g.Right)),
+		nonNegative("bottom inset", float64(g.Bottom)),
+		finite("scale", g.Scale),
+	)
+	if err != nil {
+		return err
+	}
+
+	if g.Left+g.Right > bounds.Dx() || g.Top+g.Bottom > bounds.Dy() {
+		return fmt.Errorf("%w: insets %d,%d,%d,%d of a %dx%d image", errInvalidValue, g.Left, g.Top, g.Right, g.Bottom, bounds.Dx(), bounds.Dy())
+	}
+	return nil
+}
+
+func (g *ColorbarConfig) validate() error {
+	err := validateValues(
+		finite("thickness", g.Thickness),
+		finite("tick length", g.TickLength),
+	)
+	if err != nil {
+		return err
+	}
+
+	if g.Thickness > 1 {
+		return fmt.Errorf("%w: thickness %v", errInvalidValue, g.Thickness)
+	}
+	return nil
+}
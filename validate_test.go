@@ -0,0 +1,89 @@
+package gridder
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestValidateNew(t *testing.T) {
+	_, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1, MarginWidth: 50})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1, LineStrokeWidth: math.NaN()})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 1, Columns: 1, BorderStrokeWidth: 1e9})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = New(ImageConfig{Width: 100, Height: 100}, GridConfig{
+		Rows: 1, Columns: 1, RowsHeightOffset: []*RowHeightOffset{{Row: 0, Offset: math.Inf(1)}},
+	})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestValidateNegativeStrokeWidth(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, LineStrokeWidth: -1, BorderStrokeWidth: -1})
+	assert.Nil(t, err)
+
+	assert.Nil(t, gridder.DrawRectangle(0, 0, RectangleConfig{StrokeWidth: -1, Stroke: true}))
+	assert.Nil(t, gridder.DrawCircle(0, 1, CircleConfig{StrokeWidth: -1, Stroke: true}))
+	assert.Nil(t, gridder.DrawPath(0, 0, 1, 1, PathConfig{StrokeWidth: -1}))
+	assert.Nil(t, gridder.DrawLine(1, 0, LineConfig{StrokeWidth: -1}))
+
+	err = gridder.DrawCircle(1, 1, CircleConfig{StrokeWidth: math.NaN()})
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestValidateDraw(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.DrawCircle(0, 0, CircleConfig{Radius: -1})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawCircle(0, 0, CircleConfig{Radius: math.NaN()})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawRectangle(0, 0, RectangleConfig{Rotate: math.Inf(-1)})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawLine(0, 0, LineConfig{StrokeWidth: 1e12})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawPath(0, 0, 1, 1, PathConfig{Dashes: math.NaN()})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 24})
+	err = gridder.DrawString(0, 0, "a", fontFace, StringConfig{Rotate: math.NaN()})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawStrings([][]string{{"a"}}, fontFace, StringConfig{Rotate: math.Inf(1)})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawContours([][]float64{{0, 1}, {math.NaN(), 1}}, []float64{0.5})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawVectorField([][]float64{{0, 1}, {1, 1}}, [][]float64{{0, math.Inf(1)}, {1, 1}})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawVectorField([][]float64{{0, 1}, {1, 1}}, [][]float64{{0, 1}, {1, 1}}, VectorFieldConfig{Scale: -1})
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	err = gridder.DrawCircle(0, 0, CircleConfig{Radius: 5, StrokeWidth: 2})
+	assert.Nil(t, err)
+}
+
+func TestValidateValues(t *testing.T) {
+	assert.Nil(t, validateValues())
+	assert.Nil(t, validateValues(finite("a", 1), nonNegative("b", 0), strokeWidth(1, 1)))
+	assert.NotNil(t, validateValues(finite("a", 1), nonNegative("b", -1)))
+	assert.NotNil(t, strokeWidth(2, 1))
+	assert.Nil(t, verifyFinite("value", [][]float64{{1, 2}, {3}}))
+	assert.NotNil(t, verifyFinite("value", [][]float64{{1, 2}, {math.NaN()}}))
+}
@@ -0,0 +1,86 @@
+package gridder
+
+import (
+	"math"
+
+	"github.com/fogleman/gg"
+)
+
+const arrowHeadAngle = 25.0
+
+// DrawVectorField draws an arrow per cell pointing in the direction of the vector (u, v), where v points up.
+// Arrow lengths are scaled so that the largest magnitude fills the cell according to the configured scale
+func (g *Gridder) DrawVectorField(u [][]float64, v [][]float64, vectorFieldConfigs ...VectorFieldConfig) error {
+	return g.retain(func() error {
+		return g.drawVectorField(u, v, vectorFieldConfigs...)
+	})
+}
+
+func (g *Gridder) drawVectorField(u [][]float64, v [][]float64, vectorFieldConfigs ...VectorFieldConfig) error {
+	err := g.verifyMatrix(len(u), func(row int) int { return len(u[row]) })
+	if err != nil {
+		return err
+	}
+
+	err = g.verifyMatrix(len(v), func(row int) int { return len(v[row]) })
+	if err != nil {
+		return err
+	}
+
+	err = validateValues(verifyFinite("u", u), verifyFinite("v", v))
+	if err != nil {
+		return err
+	}
+
+	vectorFieldConfig := getFirstVectorFieldConfig(vectorFieldConfigs...).withStyle(g.gridConfig.GetCellStyle())
+	err = vectorFieldConfig.validate(g.maxStrokeWidth())
+	if err != nil {
+		return err
+	}
+
+	var maxMagnitude float64
+	for row := range u {
+		for column := range u[row] {
+			maxMagnitude = math.Max(maxMagnitude, math.Hypot(u[row][column], v[row][column]))
+		}
+	}
+	if maxMagnitude == 0 {
+		return nil
+	}
+
+	g.ctx.Push()
+	g.ctx.SetDash()
+	for row := range u {
+		for column := range u[row] {
+			magnitude := math.Hypot(u[row][column], v[row][column])
+			if magnitude == 0 {
+				continue
+			}
+
+			center := g.getCellCenter(row, column)
+			cellWidth, cellHeight := g.getCellDimensions(row, column)
+			length := math.Min(cellWidth, cellHeight) * vectorFieldConfig.GetScale() * magnitude / maxMagnitude
+			dx := u[row][column] / magnitude * length / 2
+			dy := -v[row][column] / magnitude * length / 2
+
+			g.ctx.SetColor(vectorFieldConfig.GetColor(magnitude / maxMagnitude))
+			g.stroke(vectorFieldConfig.GetStrokeWidth(), func() {
+				addArrow(g.ctx, center.X-dx, center.Y-dy, center.X+dx, center.Y+dy, length*vectorFieldConfig.GetHeadSize())
+			})
+		}
+	}
+	g.ctx.Pop()
+	return nil
+}
+
+func addArrow(ctx *gg.Context, x1 float64, y1 float64, x2 float64, y2 float64, headLength float64) {
+	ctx.MoveTo(x1, y1)
+	ctx.LineTo(x2, y2)
+
+	angle := math.Atan2(y2-y1, x2-x1)
+	for _, side := range []float64{-1, 1} {
+		headAngle := angle + math.Pi - side*gg.Radians(arrowHeadAngle)
+		ctx.MoveTo(x2, y2)
+		ctx.LineTo(x2+headLength*math.Cos(headAngle), y2+headLength*math.Sin(headAngle))
+	}
+}
@@ -0,0 +1,25 @@
+package gridder
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawVectorField(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 200, Height: 100}, GridConfig{Rows: 1, Columns: 2})
+	assert.Nil(t, err)
+
+	err = gridder.DrawVectorField([][]float64{{1, 0}}, [][]float64{{0}})
+	assert.NotNil(t, err)
+
+	err = gridder.DrawVectorField([][]float64{{0, 0}}, [][]float64{{0, 0}})
+	assert.Nil(t, err)
+
+	err = gridder.DrawVectorField([][]float64{{1, 0}}, [][]float64{{0, 1}}, VectorFieldConfig{StrokeWidth: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(80, 50)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(150, 20)), color.RGBAModel.Convert(color.Black))
+	assert.Equal(t, color.RGBAModel.Convert(gridder.ctx.Image().At(50, 20)), color.RGBAModel.Convert(color.White))
+}
@@ -0,0 +1,171 @@
+package gridder
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+
+	"golang.org/x/image/font"
+)
+
+// waffleShare is the number of cells of a part
+type waffleShare struct {
+	name  string
+	value float64
+	cells int
+}
+
+// RenderWaffle creates a waffle chart, sharing out the configured number of cells between the parts in proportion
+// to their values and filling them row by row from the top left, largest part first. Parts take the colors of the
+// palette in that order, the default palette when it is empty. A legend with the share of every part follows the
+// chart when a font is configured, and the image is sized for square cells unless its dimensions are set
+func RenderWaffle(parts map[string]float64, columns int, palette []color.Color, waffleConfigs ...WaffleConfig) (*Gridder, error) {
+	waffleConfig := getFirstWaffleConfig(waffleConfigs...)
+	if len(parts) == 0 {
+		return nil, errNoRows
+	}
+	if columns <= 0 {
+		return nil, errNoColumns
+	}
+	if len(palette) == 0 {
+		palette = defaultChartColors
+	}
+
+	shares, err := waffleShares(parts, waffleConfig.GetCells())
+	if err != nil {
+		return nil, err
+	}
+
+	// the chart, and a spacing row and a legend row per part when texts are drawn
+	cells := waffleConfig.GetCells()
+	chartRows := (cells + columns - 1) / columns
+	rows := chartRows
+	if waffleConfig.Font != nil {
+		rows += 1 + len(shares)
+	}
+
+	imageConfig := waffleConfig.ImageConfig
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = columns * defaultWaffleCellSize
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = rows * defaultWaffleCellSize
+	}
+
+	g, err := New(imageConfig, GridConfig{
+		Rows:            rows,
+		Columns:         columns,
+		LineStrokeWidth: defaultWaffleGap,
+		LineColor:       waffleConfig.GetBackgroundColor(),
+		BorderColor:     waffleConfig.GetBackgroundColor(),
+		BackgroundColor: waffleConfig.GetBackgroundColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var fontFace font.Face
+	if waffleConfig.Font != nil {
+		fontFace = g.FontFace(waffleConfig.Font, waffleConfig.GetFontFraction())
+	}
+
+	err = g.retain(func() error {
+		var cell int
+		for i, share := range shares {
+			for n := 0; n < share.cells; n++ {
+				err := g.paintCell(cell/columns, cell%columns, palette[i%len(palette)])
+				if err != nil {
+					return err
+				}
+				cell++
+			}
+		}
+
+		if fontFace == nil {
+			return nil
+		}
+
+		g.ctx.Push()
+		defer g.ctx.Pop()
+		g.ctx.SetFontFace(fontFace)
+		g.ctx.SetColor(waffleConfig.GetTextColor())
+		numberFormat := NumberFormat{Precision: -1}
+		for i, share := range shares {
+			percent := g.formatNumber(numberFormat, float64(share.cells)*100/float64(cells))
+			g.drawLegend(chartRows+1+i, 0, []string{fmt.Sprintf("%s %s%%", share.name, percent)}, []color.Color{palette[i%len(palette)]})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// waffleShares shares out cells between the parts by the largest remainder method, so that the shares add up to
+// the cells, and orders the parts from the largest, then by name
+func waffleShares(parts map[string]float64, cells int) ([]waffleShare, error) {
+	var total float64
+	shares := make([]waffleShare, 0, len(parts))
+	for name, value := range parts {
+		err := nonNegative("part "+name, value)
+		if err != nil {
+			return nil, err
+		}
+		total += value
+		shares = append(shares, waffleShare{name: name, value: value})
+	}
+	if total <= 0 || math.IsInf(total, 0) {
+		return nil, fmt.Errorf("%w: total of parts %v", errInvalidValue, total)
+	}
+
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].value != shares[j].value {
+			return shares[i].value > shares[j].value
+		}
+		return shares[i].name < shares[j].name
+	})
+
+	values := make([]float64, len(shares))
+	for i, share := range shares {
+		values[i] = share.value
+	}
+	for i, count := range largestRemainder(values, cells) {
+		shares[i].cells = count
+	}
+	return shares, nil
+}
+
+// largestRemainder shares out a count in proportion to the values, giving the floor of every exact share and the
+// rest one by one to the largest remainders, earlier values first on ties. Nothing is shared out of a zero total
+func largestRemainder(values []float64, count int) []int {
+	var total float64
+	for _, value := range values {
+		total += value
+	}
+
+	shares := make([]int, len(values))
+	if total <= 0 {
+		return shares
+	}
+
+	remainders := make([]int, len(values))
+	remaining := count
+	for i, value := range values {
+		exact := value / total * float64(count)
+		shares[i] = int(exact)
+		remaining -= shares[i]
+		remainders[i] = i
+	}
+	remainder := func(i int) float64 {
+		return values[i]/total*float64(count) - float64(shares[i])
+	}
+	sort.SliceStable(remainders, func(i, j int) bool {
+		return remainder(remainders[i]) > remainder(remainders[j])
+	})
+	for i := 0; i < remaining; i++ {
+		shares[remainders[i]]++
+	}
+	return shares
+}
@@ -0,0 +1,71 @@
+package gridder
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestWaffleShares(t *testing.T) {
+	shares, err := waffleShares(map[string]float64{"a": 1, "b": 1, "c": 1}, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, shares, []waffleShare{{name: "a", value: 1, cells: 34}, {name: "b", value: 1, cells: 33}, {name: "c", value: 1, cells: 33}})
+
+	shares, err = waffleShares(map[string]float64{"small": 1, "large": 3, "none": 0}, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, shares, []waffleShare{{name: "large", value: 3, cells: 8}, {name: "small", value: 1, cells: 2}, {name: "none", value: 0, cells: 0}})
+
+	_, err = waffleShares(map[string]float64{"a": 0}, 10)
+	assert.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = waffleShares(map[string]float64{"a": math.Inf(1)}, 10)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestLargestRemainder(t *testing.T) {
+	assert.Equal(t, largestRemainder([]float64{1, 1, 1}, 10), []int{4, 3, 3})
+	assert.Equal(t, largestRemainder([]float64{1, 2}, 6), []int{2, 4})
+	assert.Equal(t, largestRemainder([]float64{0, 0}, 6), []int{0, 0})
+}
+
+func TestRenderWaffle(t *testing.T) {
+	red, blue := color.NRGBA{R: 255, A: 255}, color.NRGBA{B: 255, A: 255}
+	gridder, err := RenderWaffle(map[string]float64{"yes": 7, "no": 3}, 4, []color.Color{red, blue}, WaffleConfig{Cells: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Width(), 80)
+	assert.Equal(t, gridder.ctx.Height(), 60)
+
+	img := gridder.image()
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(10, 10)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 30)), red)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(70, 30)), blue)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(30, 50)), blue)
+	assert.Equal(t, color.NRGBAModel.Convert(img.At(50, 50)), color.NRGBAModel.Convert(color.White))
+	assert.Equal(t, len(gridder.labels), 0)
+}
+
+func TestRenderWaffleLegend(t *testing.T) {
+	ttf, _ := truetype.Parse(goregular.TTF)
+	gridder, err := RenderWaffle(map[string]float64{"yes": 7, "no": 3}, 10, nil, WaffleConfig{Font: ttf})
+	assert.Nil(t, err)
+	assert.Equal(t, gridder.ctx.Height(), 13*20)
+	assert.Equal(t, color.NRGBAModel.Convert(gridder.image().At(10, 10)), defaultChartColors[0])
+	assert.Equal(t, gridder.labels[Cell{Row: 11, Column: 0}], []string{"yes 70%"})
+	assert.Equal(t, gridder.labels[Cell{Row: 12, Column: 0}], []string{"no 30%"})
+}
+
+func TestRenderWaffleErrors(t *testing.T) {
+	_, err := RenderWaffle(nil, 10, nil)
+	assert.True(t, errors.Is(err, errNoRows))
+
+	_, err = RenderWaffle(map[string]float64{"a": 1}, 0, nil)
+	assert.True(t, errors.Is(err, errNoColumns))
+
+	_, err = RenderWaffle(map[string]float64{"a": -1}, 10, nil)
+	assert.True(t, errors.Is(err, errInvalidValue))
+}
@@ -0,0 +1,133 @@
+package gridder
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"math"
+	"sort"
+
+	"github.com/fogleman/gg"
+)
+
+// Widget adapts a Gridder to GUI toolkits without depending on them. Paint matches the raster callback of
+// Fyne's canvas.NewRaster and its result can be handed to Gio's paint.NewImageOp, Tap maps pointer events
+// back to cells. The grid is scaled to fit the widget, keeping its aspect ratio, and centered
+type Widget struct {
+	gridder *Gridder
+
+	// OnCellTapped is called by Tap with the cell under the pointer
+	OnCellTapped func(cell Cell)
+}
+
+// NewWidget creates a widget adapter for a Gridder
+func NewWidget(gridder *Gridder) *Widget {
+	return &Widget{gridder: gridder}
+}
+
+// Paint renders the grid scaled to fit a widget of the given size
+func (w *Widget) Paint(width int, height int) image.Image {
+	scale, x, y := w.fit(width, height)
+	if scale == 0 {
+		return image.NewRGBA(image.Rect(0, 0, 0, 0))
+	}
+
+	ctx := gg.NewContext(width, height)
+	ctx.Translate(x, y)
+	ctx.Scale(scale, scale)
+	ctx.DrawImage(w.gridder.image(), 0, 0)
+	return ctx.Image()
+}
+
+// Tap maps a point of a widget of the given size to a cell and calls OnCellTapped with it.
+// It returns false without calling OnCellTapped when the point is outside of the grid
+func (w *Widget) Tap(x float64, y float64, width int, height int) (Cell, bool) {
+	scale, offsetX, offsetY := w.fit(width, height)
+	if scale == 0 {
+		return Cell{}, false
+	}
+
+	cell, ok := w.gridder.CellAt((x-offsetX)/scale, (y-offsetY)/scale)
+	if ok && w.OnCellTapped != nil {
+		w.OnCellTapped(cell)
+	}
+	return cell, ok
+}
+
+// fit gets the scale and the offset that fit the image into a widget of the given size
+func (w *Widget) fit(width int, height int) (float64, float64, float64) {
+	imageWidth, imageHeight := float64(w.gridder.imageConfig.GetWidth()), float64(w.gridder.imageConfig.GetHeight())
+	if width <= 0 || height <= 0 || imageWidth == 0 || imageHeight == 0 {
+		return 0, 0, 0
+	}
+
+	scale := math.Min(float64(width)/imageWidth, float64(height)/imageHeight)
+	return scale, (float64(width) - imageWidth*scale) / 2, (float64(height) - imageHeight*scale) / 2
+}
+
+// CellAt gets the cell under a point of the rendered image, taking the margin and flips into account.
+// It returns false when the point is outside of the grid or in a masked cell
+func (g *Gridder) CellAt(x float64, y float64) (Cell, bool) {
+	if g.parent != nil {
+		return g.parent.CellAt(x, y)
+	}
+
+	if g.flipHorizontal {
+		x = float64(g.imageConfig.GetWidth()) - x
+	}
+	if g.flipVertical {
+		y = float64(g.imageConfig.GetHeight()) - y
+	}
+
+	margin := float64(g.gridConfig.GetMarginWidth())
+	x, y = x-margin, y-margin
+	if x < 0 || y < 0 {
+		return Cell{}, false
+	}
+
+	layout := g.getLayout()
+	column := sort.Search(len(layout.columnEdges), func(i int) bool { return layout.columnEdges[i] > x })
+	row := sort.Search(len(layout.rowEdges), func(i int) bool { return layout.rowEdges[i] > y })
+	if column == len(layout.columnEdges) || row == len(layout.rowEdges) || g.gridConfig.IsMasked(row, column) {
+		return Cell{}, false
+	}
+	return Cell{Row: row, Column: column}, true
+}
+
+// UpdateRGBA copies the rendered grid into dst and returns the bounds of the pixels that changed, so game loops
+// can upload only the changed region of a board every frame. A dst of another size than the image is redrawn whole
+func (g *Gridder) UpdateRGBA(dst *image.RGBA) image.Rectangle {
+	img := g.image()
+	bounds := img.Bounds()
+	if dst.Bounds().Size() != bounds.Size() {
+		draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+		return dst.Bounds()
+	}
+
+	src, ok := img.(*image.RGBA)
+	if !ok {
+		src = image.NewRGBA(bounds)
+		draw.Draw(src, bounds, img, bounds.Min, draw.Src)
+	}
+
+	changed := image.Rectangle{}
+	rowBytes := bounds.Dx() * 4
+	for y := 0; y < bounds.Dy(); y++ {
+		srcRow := src.Pix[y*src.Stride : y*src.Stride+rowBytes]
+		dstRow := dst.Pix[y*dst.Stride : y*dst.Stride+rowBytes]
+		if bytes.Equal(srcRow, dstRow) {
+			continue
+		}
+
+		left, right := 0, rowBytes
+		for srcRow[left] == dstRow[left] {
+			left++
+		}
+		for srcRow[right-1] == dstRow[right-1] {
+			right--
+		}
+		copy(dstRow, srcRow)
+		changed = changed.Union(image.Rect(left/4, y, (right+3)/4, y+1))
+	}
+	return changed.Add(dst.Bounds().Min)
+}
@@ -0,0 +1,93 @@
+package gridder
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCellAt(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{
+		Rows: 2, Columns: 4, MarginWidth: 10,
+		ColumnsWidthOffset: []*ColumnWidthOffset{{Column: 0, Offset: 20}},
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		x, y float64
+		cell Cell
+		ok   bool
+	}{
+		{x: 5, y: 50, ok: false},
+		{x: 11, y: 11, cell: Cell{Row: 0, Column: 0}, ok: true},
+		{x: 44, y: 11, cell: Cell{Row: 0, Column: 0}, ok: true},
+		{x: 55, y: 60, cell: Cell{Row: 1, Column: 1}, ok: true},
+		{x: 89, y: 89, cell: Cell{Row: 1, Column: 3}, ok: true},
+		{x: 91, y: 50, ok: false},
+	}
+	for _, test := range tests {
+		cell, ok := gridder.CellAt(test.x, test.y)
+		assert.Equal(t, ok, test.ok)
+		assert.Equal(t, cell, test.cell)
+	}
+
+	gridder.FlipHorizontal()
+	cell, ok := gridder.Overlay().CellAt(85, 11)
+	assert.True(t, ok)
+	assert.Equal(t, cell, Cell{Row: 0, Column: 0})
+}
+
+func TestWidget(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 50}, GridConfig{Rows: 1, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, err)
+	assert.Nil(t, gridder.PaintCell(0, 1, color.Black))
+
+	var tapped []Cell
+	widget := NewWidget(gridder)
+	widget.OnCellTapped = func(cell Cell) { tapped = append(tapped, cell) }
+
+	img := widget.Paint(200, 200)
+	assert.Equal(t, img.Bounds().Dx(), 200)
+	assert.Equal(t, color.GrayModel.Convert(img.At(50, 100)), color.Gray{Y: 255})
+	assert.Equal(t, color.GrayModel.Convert(img.At(150, 100)), color.Gray{})
+	assert.Equal(t, img.At(100, 10), color.RGBA{})
+
+	cell, ok := widget.Tap(150, 100, 200, 200)
+	assert.True(t, ok)
+	assert.Equal(t, cell, Cell{Row: 0, Column: 1})
+	_, ok = widget.Tap(150, 10, 200, 200)
+	assert.False(t, ok)
+	_, ok = widget.Tap(150, 100, 0, 200)
+	assert.False(t, ok)
+	assert.Equal(t, tapped, []Cell{{Row: 0, Column: 1}})
+	assert.Equal(t, widget.Paint(0, 0).Bounds().Empty(), true)
+}
+
+func TestUpdateRGBA(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 2, Columns: 2, BackgroundColor: color.White})
+	assert.Nil(t, err)
+
+	dst := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	assert.Equal(t, gridder.UpdateRGBA(dst), image.Rect(0, 0, 100, 100))
+	assert.Equal(t, gridder.UpdateRGBA(dst), image.Rectangle{})
+
+	assert.Nil(t, gridder.PaintCell(1, 1, color.Black))
+	changed := gridder.UpdateRGBA(dst)
+	assert.Equal(t, changed, image.Rect(50, 50, 100, 100))
+	assertSamePixels(t, dst, gridder.image())
+
+	small := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	assert.Equal(t, gridder.UpdateRGBA(small), small.Bounds())
+}
+
+func TestUpdateRGBAWithLines(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 100, Height: 100}, GridConfig{Rows: 3, Columns: 3, LineStrokeWidth: 2, BorderStrokeWidth: 2})
+	assert.Nil(t, err)
+
+	dst := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	assert.Equal(t, gridder.UpdateRGBA(dst), image.Rect(0, 0, 100, 100))
+	assert.Equal(t, gridder.UpdateRGBA(dst), image.Rectangle{})
+	assert.Equal(t, gridder.UpdateRGBA(dst), image.Rectangle{})
+}
@@ -0,0 +1,560 @@
+package gridder
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"golang.org/x/image/font"
+)
+
+// maxSheetCells bounds the cells of a sheet read without a MaxCells limit, as a single cell reference can span a
+// sheet of billions of cells
+const maxSheetCells = 1 << 22
+
+const (
+	xlsxPixelsPerCharacter = 7.0
+	xlsxPointsPerPixel     = 0.75
+	xlsxCellSampleOffset   = 0.15
+)
+
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+</Types>`
+
+const xlsxRootRelationships = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+
+const xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Grid" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`
+
+const xlsxWorkbookRelationships = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+</Relationships>`
+
+var xlsxStyles = template.Must(template.New("styles").Funcs(template.FuncMap{"add": add}).Parse(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>
+<fills count="{{len .Fills | add 2}}"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill>
+{{- range .Fills}}<fill><patternFill patternType="solid"><fgColor rgb="{{.}}"/><bgColor indexed="64"/></patternFill></fill>{{end}}</fills>
+<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>
+<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+<cellXfs count="{{len .Fills | add 1}}"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>
+{{- range $i, $fill := .Fills}}<xf numFmtId="0" fontId="0" fillId="{{add 2 $i}}" borderId="0" xfId="0" applyFill="1"/>{{end}}</cellXfs>
+</styleSheet>`))
+
+// ExportXLSX writes the grid model to a spreadsheet. Cell values are the drawn labels, or the cell state when
+// there are no labels. Column widths, row heights and fill colors approximate the rendered grid
+func (g *Gridder) ExportXLSX(w io.Writer) error {
+	rows, columns := g.gridConfig.GetRows(), g.gridConfig.GetColumns()
+	background := FormatColor(g.gridConfig.GetBackgroundColor())
+
+	var fills []string
+	fillStyles := make(map[string]int)
+	var sheet strings.Builder
+	sheet.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><cols>`)
+	for column := 0; column < columns; column++ {
+		cellWidth, _ := g.getCellDimensions(0, column)
+		fmt.Fprintf(&sheet, `<col min="%d" max="%d" width="%.2f" customWidth="1"/>`, column+1, column+1, cellWidth/xlsxPixelsPerCharacter)
+	}
+	sheet.WriteString(`</cols><sheetData>`)
+
+	for row := 0; row < rows; row++ {
+		_, cellHeight := g.getCellDimensions(row, 0)
+		fmt.Fprintf(&sheet, `<row r="%d" ht="%.2f" customHeight="1">`, row+1, cellHeight*xlsxPointsPerPixel)
+		for column := 0; column < columns; column++ {
+			var style int
+			fill := FormatColor(g.sampleCellColor(row, column))
+			if fill != background {
+				argb := strings.ToUpper(fill[7:9] + fill[1:7])
+				if _, ok := fillStyles[argb]; !ok {
+					fills = append(fills, argb)
+					fillStyles[argb] = len(fills)
+				}
+				style = fillStyles[argb]
+			}
+
+			reference := cellReference(row, column)
+			value := g.cellValue(Cell{Row: row, Column: column})
+			switch {
+			case value == "":
+				fmt.Fprintf(&sheet, `<c r="%s" s="%d"/>`, reference, style)
+			case isNumeric(value):
+				fmt.Fprintf(&sheet, `<c r="%s" s="%d"><v>%s</v></c>`, reference, style, value)
+			default:
+				fmt.Fprintf(&sheet, `<c r="%s" s="%d" t="inlineStr"><is><t>`, reference, style)
+				err := xml.EscapeText(&sheet, []byte(value))
+				if err != nil {
+					return err
+				}
+				sheet.WriteString(`</t></is></c>`)
+			}
+		}
+		sheet.WriteString(`</row>`)
+	}
+	sheet.WriteString(`</sheetData></worksheet>`)
+
+	var styles strings.Builder
+	err := xlsxStyles.Execute(&styles, struct{ Fills []string }{Fills: fills})
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{name: "[Content_Types].xml", content: xlsxContentTypes},
+		{name: "_rels/.rels", content: xlsxRootRelationships},
+		{name: "xl/workbook.xml", content: xlsxWorkbook},
+		{name: "xl/_rels/workbook.xml.rels", content: xlsxWorkbookRelationships},
+		{name: "xl/styles.xml", content: styles.String()},
+		{name: "xl/worksheets/sheet1.xml", content: sheet.String()},
+	}
+	for _, part := range parts {
+		file, err := archive.Create(part.name)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(file, part.content)
+		if err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+func (g *Gridder) cellValue(cell Cell) string {
+	if labels := g.labels[cell]; len(labels) > 0 {
+		return strings.Join(labels, " ")
+	}
+	return g.states[cell]
+}
+
+// sampleCellColor samples the rendered color near the top left corner of a cell, away from centered content
+func (g *Gridder) sampleCellColor(row int, column int) color.Color {
+	center := g.getCellCenter(row, column)
+	cellWidth, cellHeight := g.getCellDimensions(row, column)
+	x, y := g.ctx.TransformPoint(center.X-cellWidth*(0.5-xlsxCellSampleOffset), center.Y-cellHeight*(0.5-xlsxCellSampleOffset))
+	return g.ctx.Image().At(int(x), int(y))
+}
+
+func cellReference(row int, column int) string {
+	return columnLetters(column) + strconv.Itoa(row+1)
+}
+
+// columnLetters names a column in the A to Z, AA to ZZ sequence of spreadsheets
+func columnLetters(column int) string {
+	var letters []byte
+	for column++; column > 0; column = (column - 1) / 26 {
+		letters = append([]byte{byte('A' + (column-1)%26)}, letters...)
+	}
+	return string(letters)
+}
+
+func isNumeric(value string) bool {
+	number, err := strconv.ParseFloat(value, 64)
+	return err == nil && !math.IsNaN(number) && !math.IsInf(number, 0)
+}
+
+func add(a int, b int) int {
+	return a + b
+}
+
+const (
+	xlsxDefaultColumnWidth = 8.43
+	xlsxDefaultRowHeight   = 15.0
+)
+
+// SheetGrid holds the values, fills and dimensions read from a spreadsheet sheet
+type SheetGrid struct {
+	Rows         int
+	Columns      int
+	Values       [][]string
+	Fills        [][]color.Color
+	ColumnWidths []float64
+	RowHeights   []float64
+}
+
+type xlsxWorkbookPart struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationshipsPart struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStringsPart struct {
+	Items []struct {
+		Text string `xml:"t"`
+		Runs []struct {
+			Text string `xml:"t"`
+		} `xml:"r"`
+	} `xml:"si"`
+}
+
+type xlsxStylesPart struct {
+	Fills []struct {
+		Pattern struct {
+			Type       string `xml:"patternType,attr"`
+			Foreground struct {
+				RGB string `xml:"rgb,attr"`
+			} `xml:"fgColor"`
+		} `xml:"patternFill"`
+	} `xml:"fills>fill"`
+	CellFormats []struct {
+		FillID int `xml:"fillId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type xlsxSheetPart struct {
+	Columns []struct {
+		Min   int     `xml:"min,attr"`
+		Max   int     `xml:"max,attr"`
+		Width float64 `xml:"width,attr"`
+	} `xml:"cols>col"`
+	Rows []struct {
+		Index  int     `xml:"r,attr"`
+		Height float64 `xml:"ht,attr"`
+		Cells  []struct {
+			Reference string `xml:"r,attr"`
+			Style     int    `xml:"s,attr"`
+			Type      string `xml:"t,attr"`
+			Value     string `xml:"v"`
+			Inline    string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// FromXLSX reads the values, column widths, row heights and solid fill colors of a spreadsheet sheet.
+// An empty sheet name selects the first sheet. Sheets of more cells than the MaxCells limit, or than
+// maxSheetCells without a limit, fail before their cells are allocated
+func FromXLSX(r io.Reader, sheet string, limits ...RenderLimits) (*SheetGrid, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+
+	var workbook xlsxWorkbookPart
+	err = decodeXLSXPart(files, "xl/workbook.xml", &workbook)
+	if err != nil {
+		return nil, err
+	}
+
+	var relationships xlsxRelationshipsPart
+	err = decodeXLSXPart(files, "xl/_rels/workbook.xml.rels", &relationships)
+	if err != nil {
+		return nil, err
+	}
+
+	var sheetPath string
+	for _, workbookSheet := range workbook.Sheets {
+		if sheet != "" && workbookSheet.Name != sheet {
+			continue
+		}
+		for _, relationship := range relationships.Relationships {
+			if relationship.ID == workbookSheet.ID {
+				sheetPath = resolveXLSXTarget(relationship.Target)
+			}
+		}
+		break
+	}
+	if sheetPath == "" {
+		return nil, fmt.Errorf("%w: %q", errSheetNotFound, sheet)
+	}
+
+	var sharedStrings xlsxSharedStringsPart
+	if _, ok := files["xl/sharedStrings.xml"]; ok {
+		err = decodeXLSXPart(files, "xl/sharedStrings.xml", &sharedStrings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var styles xlsxStylesPart
+	if _, ok := files["xl/styles.xml"]; ok {
+		err = decodeXLSXPart(files, "xl/styles.xml", &styles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sheetPart xlsxSheetPart
+	err = decodeXLSXPart(files, sheetPath, &sheetPart)
+	if err != nil {
+		return nil, err
+	}
+
+	renderLimits := getFirstRenderLimits(limits...)
+	maxCells := renderLimits.GetMaxCells()
+	if maxCells == 0 {
+		maxCells = maxSheetCells
+	}
+	return newSheetGrid(sheetPart, sharedStrings, styles, maxCells)
+}
+
+// Render creates a gridder shaped like the sheet, paints the fills and draws the values.
+// The image defaults to the sheet size in pixels when no width or height is configured
+func (s *SheetGrid) Render(imageConfig ImageConfig, gridConfig GridConfig, fontFace font.Face) (*Gridder, error) {
+	var sheetWidth, sheetHeight float64
+	for _, width := range s.ColumnWidths {
+		sheetWidth += width
+	}
+	for _, height := range s.RowHeights {
+		sheetHeight += height
+	}
+
+	margin := gridConfig.GetMarginWidth() * 2
+	if imageConfig.Width <= 0 {
+		imageConfig.Width = int(math.Ceil(sheetWidth)) + margin
+	}
+	if imageConfig.Height <= 0 {
+		imageConfig.Height = int(math.Ceil(sheetHeight)) + margin
+	}
+
+	gridConfig.Rows, gridConfig.Columns = s.Rows, s.Columns
+	gridWidth := float64(gridConfig.GetWidth(imageConfig.GetWidth()))
+	gridHeight := float64(gridConfig.GetHeight(imageConfig.GetHeight()))
+	gridConfig.ColumnsWidthOffset = nil
+	for column, width := range s.ColumnWidths {
+		offset := width/sheetWidth*gridWidth - gridWidth/float64(s.Columns)
+		gridConfig.ColumnsWidthOffset = append(gridConfig.ColumnsWidthOffset, &ColumnWidthOffset{Column: column, Offset: offset})
+	}
+	gridConfig.RowsHeightOffset = nil
+	for row, height := range s.RowHeights {
+		offset := height/sheetHeight*gridHeight - gridHeight/float64(s.Rows)
+		gridConfig.RowsHeightOffset = append(gridConfig.RowsHeightOffset, &RowHeightOffset{Row: row, Offset: offset})
+	}
+
+	g, err := New(imageConfig, gridConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for row := range s.Fills {
+		for column, fill := range s.Fills[row] {
+			if fill == nil {
+				continue
+			}
+
+			err = g.PaintCell(row, column, fill)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = g.DrawStrings(s.Values, fontFace)
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func newSheetGrid(sheetPart xlsxSheetPart, sharedStrings xlsxSharedStringsPart, styles xlsxStylesPart, maxCells int) (*SheetGrid, error) {
+	type sheetCell struct {
+		value string
+		fill  color.Color
+	}
+
+	cells := make(map[Cell]sheetCell)
+	rowHeights := make(map[int]float64)
+	var rows, columns int
+	for rowIndex, sheetRow := range sheetPart.Rows {
+		row := rowIndex
+		if sheetRow.Index > 0 {
+			row = sheetRow.Index - 1
+		}
+		if sheetRow.Height > 0 {
+			rowHeights[row] = sheetRow.Height
+		}
+
+		for columnIndex, sheetCellPart := range sheetRow.Cells {
+			column := columnIndex
+			if sheetCellPart.Reference != "" {
+				referenceRow, referenceColumn, err := parseCellReference(sheetCellPart.Reference)
+				if err != nil {
+					return nil, err
+				}
+				row, column = referenceRow, referenceColumn
+			}
+
+			value := sheetCellPart.Value
+			switch sheetCellPart.Type {
+			case "s":
+				index, err := strconv.Atoi(value)
+				if err != nil || index < 0 || index >= len(sharedStrings.Items) {
+					return nil, fmt.Errorf("%w: shared string %q", errInvalidSpreadsheet, value)
+				}
+				item := sharedStrings.Items[index]
+				value = item.Text
+				for _, run := range item.Runs {
+					value += run.Text
+				}
+			case "inlineStr":
+				value = sheetCellPart.Inline
+			}
+
+			cell := sheetCell{value: value, fill: styles.fill(sheetCellPart.Style)}
+			if cell.value == "" && cell.fill == nil {
+				continue
+			}
+
+			cells[Cell{Row: row, Column: column}] = cell
+			rows, columns = maxInt(rows, row+1), maxInt(columns, column+1)
+		}
+	}
+
+	if rows == 0 || columns == 0 {
+		return nil, fmt.Errorf("%w: empty sheet", errInvalidSpreadsheet)
+	}
+	err := verifyCells(rows, columns, maxCells)
+	if err != nil {
+		return nil, err
+	}
+
+	sheetGrid := SheetGrid{
+		Rows:         rows,
+		Columns:      columns,
+		Values:       make([][]string, rows),
+		Fills:        make([][]color.Color, rows),
+		ColumnWidths: make([]float64, columns),
+		RowHeights:   make([]float64, rows),
+	}
+	for row := 0; row < rows; row++ {
+		sheetGrid.Values[row] = make([]string, columns)
+		sheetGrid.Fills[row] = make([]color.Color, columns)
+		for column := 0; column < columns; column++ {
+			cell := cells[Cell{Row: row, Column: column}]
+			sheetGrid.Values[row][column] = cell.value
+			sheetGrid.Fills[row][column] = cell.fill
+		}
+
+		height, ok := rowHeights[row]
+		if !ok {
+			height = xlsxDefaultRowHeight
+		}
+		sheetGrid.RowHeights[row] = height / xlsxPointsPerPixel
+	}
+
+	for column := 0; column < columns; column++ {
+		width := xlsxDefaultColumnWidth
+		for _, sheetColumn := range sheetPart.Columns {
+			if column+1 >= sheetColumn.Min && column+1 <= sheetColumn.Max && sheetColumn.Width > 0 {
+				width = sheetColumn.Width
+			}
+		}
+		sheetGrid.ColumnWidths[column] = width * xlsxPixelsPerCharacter
+	}
+	return &sheetGrid, nil
+}
+
+func (s *xlsxStylesPart) fill(style int) color.Color {
+	if style <= 0 || style >= len(s.CellFormats) {
+		return nil
+	}
+
+	fillID := s.CellFormats[style].FillID
+	if fillID < 0 || fillID >= len(s.Fills) {
+		return nil
+	}
+
+	pattern := s.Fills[fillID].Pattern
+	argb := pattern.Foreground.RGB
+	if pattern.Type != "solid" || len(argb) != 8 {
+		return nil
+	}
+
+	fill, err := ParseColor("#" + argb[2:] + argb[:2])
+	if err != nil {
+		return nil
+	}
+	return fill
+}
+
+func decodeXLSXPart(files map[string]*zip.File, name string, v interface{}) error {
+	file, ok := files[name]
+	if !ok {
+		return fmt.Errorf("%w: missing %s", errInvalidSpreadsheet, name)
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return xml.NewDecoder(reader).Decode(v)
+}
+
+func resolveXLSXTarget(target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return "xl/" + target
+}
+
+func parseCellReference(reference string) (int, int, error) {
+	var column, index int
+	for index < len(reference) && reference[index] >= 'A' && reference[index] <= 'Z' {
+		column = column*26 + int(reference[index]-'A') + 1
+		index++
+	}
+
+	row, err := strconv.Atoi(reference[index:])
+	if column == 0 || err != nil || row <= 0 {
+		return 0, 0, fmt.Errorf("%w: cell reference %q", errInvalidSpreadsheet, reference)
+	}
+	return row - 1, column - 1, nil
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
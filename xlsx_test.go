@@ -0,0 +1,176 @@
+package gridder
+
+import (
+	"archive/zip"
+	"bytes"
+	"image/color"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestExportXLSX(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 210, Height: 100}, GridConfig{Rows: 2, Columns: 3})
+	assert.Nil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 12})
+
+	assert.Nil(t, gridder.PaintCell(0, 0, color.RGBA{R: 255, A: 255}))
+	assert.Nil(t, gridder.DrawString(0, 0, "A & B", fontFace))
+	assert.Nil(t, gridder.DrawNumbers([][]float64{{0, 42}}, fontFace, NumberFormat{}))
+	assert.Nil(t, gridder.SetState(1, 2, "goal"))
+
+	buffer := new(bytes.Buffer)
+	err = gridder.ExportXLSX(buffer)
+	assert.Nil(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	assert.Nil(t, err)
+
+	parts := make(map[string]string)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		assert.Nil(t, err)
+
+		content, err := ioutil.ReadAll(reader)
+		assert.Nil(t, err)
+		parts[file.Name] = string(content)
+	}
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<col min="1" max="1" width="10.00" customWidth="1"/>`)
+	assert.Contains(t, sheet, `<row r="1" ht="37.50" customHeight="1">`)
+	assert.Contains(t, sheet, `<c r="A1" s="1" t="inlineStr"><is><t>A &amp; B 0</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B1" s="0"><v>42</v></c>`)
+	assert.Contains(t, sheet, `<c r="C2" s="0" t="inlineStr"><is><t>goal</t></is></c>`)
+	assert.Contains(t, parts["xl/styles.xml"], `<fgColor rgb="FFFF0000"/>`)
+	assert.Contains(t, parts["xl/styles.xml"], `<cellXfs count="2">`)
+}
+
+func TestCellReference(t *testing.T) {
+	assert.Equal(t, cellReference(0, 0), "A1")
+	assert.Equal(t, cellReference(9, 25), "Z10")
+	assert.Equal(t, cellReference(0, 26), "AA1")
+	assert.Equal(t, cellReference(0, 701), "ZZ1")
+	assert.Equal(t, cellReference(0, 702), "AAA1")
+}
+
+func TestFromXLSX(t *testing.T) {
+	gridder, err := New(ImageConfig{Width: 210, Height: 100}, GridConfig{Rows: 2, Columns: 3})
+	assert.Nil(t, err)
+
+	font, _ := truetype.Parse(goregular.TTF)
+	fontFace := truetype.NewFace(font, &truetype.Options{Size: 12})
+
+	assert.Nil(t, gridder.PaintCell(0, 0, color.RGBA{R: 255, A: 255}))
+	assert.Nil(t, gridder.DrawString(0, 1, "Start", fontFace))
+	assert.Nil(t, gridder.SetState(1, 2, "goal"))
+
+	buffer := new(bytes.Buffer)
+	err = gridder.ExportXLSX(buffer)
+	assert.Nil(t, err)
+
+	_, err = FromXLSX(bytes.NewReader(buffer.Bytes()), "Missing")
+	assert.NotNil(t, err)
+
+	sheetGrid, err := FromXLSX(bytes.NewReader(buffer.Bytes()), "Grid")
+	assert.Nil(t, err)
+	assert.Equal(t, sheetGrid.Rows, 2)
+	assert.Equal(t, sheetGrid.Columns, 3)
+	assert.Equal(t, sheetGrid.Values, [][]string{{"", "Start", ""}, {"", "", "goal"}})
+	assert.Equal(t, sheetGrid.Fills[0][0], color.NRGBA{R: 255, A: 255})
+	assert.Nil(t, sheetGrid.Fills[0][1])
+	assert.InDelta(t, sheetGrid.ColumnWidths[0], 70, 0.1)
+	assert.InDelta(t, sheetGrid.RowHeights[0], 50, 0.1)
+
+	rendered, err := sheetGrid.Render(ImageConfig{}, GridConfig{}, fontFace)
+	assert.Nil(t, err)
+	assert.Equal(t, rendered.imageConfig.GetWidth(), 210)
+	assert.Equal(t, rendered.imageConfig.GetHeight(), 100)
+	assert.Equal(t, color.RGBAModel.Convert(rendered.ctx.Image().At(35, 25)), color.RGBAModel.Convert(color.RGBA{R: 255, A: 255}))
+}
+
+func TestFromXLSXSharedStrings(t *testing.T) {
+	parts := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="First" r:id="rId1"/><sheet name="Second" r:id="rId2"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships><Relationship Id="rId1" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Target="/xl/worksheets/sheet2.xml"/></Relationships>`,
+		"xl/sharedStrings.xml":     `<sst><si><t>Hello</t></si><si><r><t>Rich </t></r><r><t>Text</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="1"><c r="A1"><v>1</v></c></row></sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<worksheet><cols><col min="2" max="3" width="20"/></cols><sheetData>
+<row r="2" ht="30"><c r="B2" t="s"><v>0</v></c><c r="C2" t="s"><v>1</v></c></row></sheetData></worksheet>`,
+	}
+
+	buffer := new(bytes.Buffer)
+	archive := zip.NewWriter(buffer)
+	for name, content := range parts {
+		file, err := archive.Create(name)
+		assert.Nil(t, err)
+
+		_, err = file.Write([]byte(content))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, archive.Close())
+
+	sheetGrid, err := FromXLSX(bytes.NewReader(buffer.Bytes()), "")
+	assert.Nil(t, err)
+	assert.Equal(t, sheetGrid.Values, [][]string{{"1"}})
+
+	sheetGrid, err = FromXLSX(bytes.NewReader(buffer.Bytes()), "Second")
+	assert.Nil(t, err)
+	assert.Equal(t, sheetGrid.Values, [][]string{{"", "", ""}, {"", "Hello", "Rich Text"}})
+	assert.InDelta(t, sheetGrid.ColumnWidths[0], xlsxDefaultColumnWidth*xlsxPixelsPerCharacter, 0.1)
+	assert.InDelta(t, sheetGrid.ColumnWidths[1], 140, 0.1)
+	assert.InDelta(t, sheetGrid.RowHeights[1], 40, 0.1)
+
+	_, err = FromXLSX(bytes.NewReader([]byte("not a zip")), "")
+	assert.NotNil(t, err)
+}
+
+func TestFromXLSXLimits(t *testing.T) {
+	sheet := func(cells string) []byte {
+		buffer := new(bytes.Buffer)
+		archive := zip.NewWriter(buffer)
+		for name, content := range map[string]string{
+			"xl/workbook.xml":            `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Grid" r:id="rId1"/></sheets></workbook>`,
+			"xl/_rels/workbook.xml.rels": `<Relationships><Relationship Id="rId1" Target="worksheets/sheet1.xml"/></Relationships>`,
+			"xl/worksheets/sheet1.xml":   `<worksheet><sheetData><row>` + cells + `</row></sheetData></worksheet>`,
+		} {
+			file, err := archive.Create(name)
+			assert.Nil(t, err)
+			_, err = file.Write([]byte(content))
+			assert.Nil(t, err)
+		}
+		assert.Nil(t, archive.Close())
+		return buffer.Bytes()
+	}
+
+	_, err := FromXLSX(bytes.NewReader(sheet(`<c r="A1"><v>1</v></c><c r="XFD1048576"><v>2</v></c>`)), "")
+	assert.ErrorIs(t, err, errLimitExceeded)
+
+	small := sheet(`<c r="A1"><v>1</v></c><c r="C1"><v>2</v></c>`)
+	_, err = FromXLSX(bytes.NewReader(small), "", RenderLimits{MaxCells: 2})
+	assert.ErrorIs(t, err, errLimitExceeded)
+
+	sheetGrid, err := FromXLSX(bytes.NewReader(small), "", RenderLimits{MaxCells: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, sheetGrid.Values, [][]string{{"1", "", "2"}})
+}
+
+func TestParseCellReference(t *testing.T) {
+	row, column, err := parseCellReference("AA10")
+	assert.Nil(t, err)
+	assert.Equal(t, row, 9)
+	assert.Equal(t, column, 26)
+
+	_, _, err = parseCellReference("10")
+	assert.NotNil(t, err)
+
+	_, _, err = parseCellReference("A0")
+	assert.NotNil(t, err)
+}